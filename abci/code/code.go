@@ -108,5 +108,30 @@ const (
 	RequestIsNotClosed                        uint32 = 81
 	ChainIsDisabled                           uint32 = 82
 	ChainIsNotInitialized                     uint32 = 83
+	InvalidSignatureScheme                    uint32 = 84
+	NDIDMethodRequiresProposal                uint32 = 85
+	GovernanceIsNotEnabled                    uint32 = 86
+	GovernanceKeyNotFound                     uint32 = 87
+	InvalidGovernanceThreshold                uint32 = 88
+	DuplicateGovernanceKeyID                  uint32 = 89
+	ProposalIDIsAlreadyExisted                uint32 = 90
+	ProposalNotFound                          uint32 = 91
+	ProposalIsNotPending                      uint32 = 92
+	ProposalIsAlreadyApproved                 uint32 = 93
+	MethodCanNotBeProposed                    uint32 = 94
+	MasterKeyRecoveryIsAlreadyPending         uint32 = 95
+	MasterKeyRecoveryNotFound                 uint32 = 96
+	BatchIsEmpty                              uint32 = 97
+	MethodCanNotBeBatched                     uint32 = 98
+	InvalidParamsEncoding                     uint32 = 99
+	InvalidParameter                          uint32 = 100
+	HeightIsPruned                            uint32 = 101
+	HeightIsNotCommitted                      uint32 = 102
+	InvalidUpgradeHeight                      uint32 = 103
+	UnsupportedAppVersion                     uint32 = 104
+	TokenAmountOverflow                       uint32 = 105
+	CanNotTransferTokenToSelf                 uint32 = 106
+	RecipientIsNotInTokenTransferWhitelist    uint32 = 107
+	InvalidRevenueShareRule                   uint32 = 108
 	UnknownError                              uint32 = 999
 )
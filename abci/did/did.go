@@ -23,8 +23,10 @@
 package did
 
 import (
+	"fmt"
 	"os"
 
+	"github.com/ndidplatform/smart-contract/abci/code"
 	didV1 "github.com/ndidplatform/smart-contract/abci/did/v1"
 	// didV2 "github.com/ndidplatform/smart-contract/abci/did2/v2"
 	"github.com/sirupsen/logrus"
@@ -35,10 +37,31 @@ import (
 
 var _ types.Application = (*DIDApplicationInterface)(nil)
 
+// DIDApplicationInterface hands each block to the app version scheduled for its height
 type DIDApplicationInterface struct {
-	appV1 *didV1.DIDApplication
-	// appV2        *didV2.DIDApplication
-	CurrentBlock int64
+	apps           map[string]types.Application
+	configSchedule VersionSchedule
+	schedule       VersionSchedule
+	upgrades       upgradeScheduleReader
+	logger         *logrus.Entry
+	CurrentBlock   int64
+}
+
+// appVersionSetter is an app that runs more than one app version and
+// branches on the version of the block being run
+type appVersionSetter interface {
+	SetAppVersion(version string)
+}
+
+// versionUpgrader converts the state written by an earlier app version in the
+// first block of its own version
+type versionUpgrader interface {
+	Upgrade(from string, to string)
+}
+
+// upgradeScheduleReader reads the app versions of upgrades scheduled on chain by height
+type upgradeScheduleReader interface {
+	UpgradeSchedule() map[int64]string
 }
 
 func NewDIDApplicationInterface() *DIDApplicationInterface {
@@ -48,57 +71,157 @@ func NewDIDApplicationInterface() *DIDApplicationInterface {
 	db := dbm.NewDB(name, "leveldb", dbDir)
 	tree := iavl.NewMutableTree(db, 0)
 	tree.Load()
-	return &DIDApplicationInterface{
-		appV1: didV1.NewDIDApplication(logger, tree),
-		// appV2: didV2.NewDIDApplication(logger, tree),
+	schedule, err := ParseVersionSchedule(getEnv("APP_VERSION_SCHEDULE", ""))
+	if err != nil {
+		logger.Errorf("Invalid APP_VERSION_SCHEDULE: %s", err.Error())
+		panic(err)
+	}
+	return NewDIDApplicationInterfaceWithTree(logger, tree, schedule)
+}
+
+// NewDIDApplicationInterfaceWithTree creates the application over a loaded tree.
+// An empty schedule runs version 1 from genesis.
+func NewDIDApplicationInterfaceWithTree(logger *logrus.Entry, tree *iavl.MutableTree, schedule VersionSchedule) *DIDApplicationInterface {
+	appV1 := didV1.NewDIDApplication(logger, tree)
+	apps := map[string]types.Application{
+		"1": appV1,
+		// Version 2 runs the same app, which stores lists one key per item
+		// from this version on
+		didV1.ListIndexVersion: appV1,
+	}
+	if len(schedule) == 0 {
+		schedule = VersionSchedule{{Height: 0, Version: "1"}}
+	}
+	for _, upgrade := range schedule {
+		if _, ok := apps[upgrade.Version]; !ok {
+			err := fmt.Errorf("unknown app version %s at height %d", upgrade.Version, upgrade.Height)
+			logger.Errorf("Invalid app version schedule: %s", err.Error())
+			panic(err)
+		}
+	}
+	app := &DIDApplicationInterface{
+		apps:           apps,
+		configSchedule: schedule,
+		upgrades:       appV1,
+		logger:         logger,
+	}
+	app.loadSchedule()
+	return app
+}
+
+// loadSchedule merges upgrades scheduled on chain into the configured schedule
+func (app *DIDApplicationInterface) loadSchedule() {
+	schedule := app.configSchedule.With(app.upgrades.UpgradeSchedule())
+	if app.schedule == nil || schedule.String() != app.schedule.String() {
+		app.logger.Infof("App version schedule: %s", schedule.String())
 	}
+	app.schedule = schedule
+}
+
+// appAt returns the app version scheduled for height, nil when this binary does not have it
+func (app *DIDApplicationInterface) appAt(height int64) types.Application {
+	return app.apps[app.schedule.VersionAt(height)]
 }
 
+// checkSupported returns an error when height is scheduled to run an app
+// version this binary does not have
+func (app *DIDApplicationInterface) checkSupported(height int64) error {
+	if app.appAt(height) != nil {
+		return nil
+	}
+	version := app.schedule.VersionAt(height)
+	return fmt.Errorf("app version %s is scheduled at height %d but this binary does not support it. Restart the node with a binary that supports app version %s", version, height, version)
+}
+
+// Info reports the app version that will run the next block in Data
 func (app *DIDApplicationInterface) Info(req types.RequestInfo) types.ResponseInfo {
-	return app.appV1.Info(req)
+	app.loadSchedule()
+	current := app.appAt(app.CurrentBlock)
+	if current == nil {
+		// Every app version reads the same state, so the first one can
+		// report the last block of a node halted at an unsupported version
+		current = app.appAt(0)
+	}
+	res := current.Info(req)
+	app.CurrentBlock = res.LastBlockHeight
+	version := app.schedule.VersionAt(res.LastBlockHeight + 1)
+	if next, ok := app.apps[version]; ok {
+		res = next.Info(req)
+	}
+	res.Data = fmt.Sprintf(`{"app_version":"%s"}`, version)
+	return res
 }
 
 func (app *DIDApplicationInterface) SetOption(req types.RequestSetOption) types.ResponseSetOption {
-	return app.appV1.SetOption(req)
+	return app.appAt(app.CurrentBlock).SetOption(req)
 }
 
+// CheckTx checks tx with the app version of the block it will be included in
 func (app *DIDApplicationInterface) CheckTx(tx []byte) types.ResponseCheckTx {
-	switch {
-	case app.CurrentBlock >= 0:
-		return app.appV1.CheckTx(tx)
-	default:
-		return app.appV1.CheckTx(tx)
+	next := app.appAt(app.CurrentBlock + 1)
+	if next == nil {
+		return types.ResponseCheckTx{
+			Code: code.UnsupportedAppVersion,
+			Log:  fmt.Sprintf("App version %s is not supported by this node", app.schedule.VersionAt(app.CurrentBlock+1)),
+		}
 	}
+	return next.CheckTx(tx)
 }
 
 func (app *DIDApplicationInterface) DeliverTx(tx []byte) types.ResponseDeliverTx {
-	switch {
-	case app.CurrentBlock >= 0:
-		return app.appV1.DeliverTx(tx)
-	default:
-		return app.appV1.DeliverTx(tx)
-	}
+	return app.appAt(app.CurrentBlock).DeliverTx(tx)
 }
 
 func (app *DIDApplicationInterface) Commit() types.ResponseCommit {
-	return app.appV1.Commit()
+	res := app.appAt(app.CurrentBlock).Commit()
+	app.loadSchedule()
+	return res
 }
 
+// Query is answered by the app version of the queried height, the latest committed height by default
 func (app *DIDApplicationInterface) Query(reqQuery types.RequestQuery) types.ResponseQuery {
-	return app.appV1.Query(reqQuery)
+	height := reqQuery.Height
+	if height == 0 {
+		height = app.CurrentBlock
+	}
+	versionApp := app.appAt(height)
+	if versionApp == nil {
+		return types.ResponseQuery{
+			Code: code.UnsupportedAppVersion,
+			Log:  fmt.Sprintf("App version %s is not supported by this node", app.schedule.VersionAt(height)),
+		}
+	}
+	return versionApp.Query(reqQuery)
 }
 
 func (app *DIDApplicationInterface) InitChain(req types.RequestInitChain) types.ResponseInitChain {
-	return app.appV1.InitChain(req)
+	return app.appAt(1).InitChain(req)
 }
 
 func (app *DIDApplicationInterface) BeginBlock(req types.RequestBeginBlock) types.ResponseBeginBlock {
 	app.CurrentBlock = req.Header.Height
-	return app.appV1.BeginBlock(req)
+	err := app.checkSupported(app.CurrentBlock)
+	if err != nil {
+		// Halt before running the block instead of forking from upgraded nodes
+		app.logger.Errorf("Halt: %s", err.Error())
+		panic(err)
+	}
+	versionApp := app.appAt(app.CurrentBlock)
+	from := app.schedule.VersionAt(app.CurrentBlock - 1)
+	to := app.schedule.VersionAt(app.CurrentBlock)
+	if setter, ok := versionApp.(appVersionSetter); ok {
+		setter.SetAppVersion(to)
+	}
+	res := versionApp.BeginBlock(req)
+	if upgrader, ok := versionApp.(versionUpgrader); ok && from != to {
+		app.logger.Infof("Upgrade app version %s to %s at height %d", from, to, app.CurrentBlock)
+		upgrader.Upgrade(from, to)
+	}
+	return res
 }
 
 func (app *DIDApplicationInterface) EndBlock(req types.RequestEndBlock) types.ResponseEndBlock {
-	return app.appV1.EndBlock(req)
+	return app.appAt(app.CurrentBlock).EndBlock(req)
 }
 
 func getEnv(key, defaultValue string) string {
@@ -0,0 +1,163 @@
+/**
+ * Copyright (c) 2018, 2019 National Digital ID COMPANY LIMITED
+ *
+ * This file is part of NDID software.
+ *
+ * NDID is the free software: you can redistribute it and/or modify it under
+ * the terms of the Affero GNU General Public License as published by the
+ * Free Software Foundation, either version 3 of the License, or any later
+ * version.
+ *
+ * NDID is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.
+ * See the Affero GNU General Public License for more details.
+ *
+ * You should have received a copy of the Affero GNU General Public License
+ * along with the NDID source code. If not, see https://www.gnu.org/licenses/agpl.txt.
+ *
+ * Please contact info@ndid.co.th for any further questions
+ *
+ */
+
+// Package didtest runs the DID application in process, without a Tendermint
+// node, for tests. Every broadcast transaction is committed in a block of its
+// own, like broadcast_tx_commit of a node with a single validator.
+package didtest
+
+import (
+	"crypto"
+	"crypto/ed25519"
+	"crypto/rand"
+	"crypto/rsa"
+	"encoding/base64"
+	"strconv"
+
+	"github.com/golang/protobuf/proto"
+	"github.com/ndidplatform/smart-contract/abci/did"
+	protoTm "github.com/ndidplatform/smart-contract/protos/tendermint"
+	"github.com/sirupsen/logrus"
+	"github.com/tendermint/iavl"
+	"github.com/tendermint/tendermint/abci/types"
+	dbm "github.com/tendermint/tendermint/libs/db"
+)
+
+// DefaultChainID is the chain ID of blocks made by a harness from New
+const DefaultChainID = "test-chain-NDID"
+
+// Harness drives an application through the ABCI block life cycle
+type Harness struct {
+	App             types.Application
+	ChainID         string
+	ValidatorPubKey []byte
+	height          int64
+}
+
+// New creates the DID application over an in-memory IAVL tree and starts its
+// chain with a single ed25519 validator
+func New() *Harness {
+	logger := logrus.WithFields(logrus.Fields{"module": "abci-app"})
+	tree := iavl.NewMutableTree(dbm.NewMemDB(), 0)
+	return NewWithApp(did.NewDIDApplicationInterfaceWithTree(logger, tree, nil), DefaultChainID)
+}
+
+// NewWithApp starts the chain of app with a single ed25519 validator
+func NewWithApp(app types.Application, chainID string) *Harness {
+	validatorPubKey, _, err := ed25519.GenerateKey(rand.Reader)
+	if err != nil {
+		panic(err)
+	}
+	app.InitChain(types.RequestInitChain{
+		ChainId:    chainID,
+		Validators: []types.Validator{types.Ed25519Validator(validatorPubKey, 10)},
+	})
+	return &Harness{
+		App:             app,
+		ChainID:         chainID,
+		ValidatorPubKey: validatorPubKey,
+	}
+}
+
+// Height returns the height of the last committed block
+func (h *Harness) Height() int64 {
+	return h.height
+}
+
+// BroadcastTxCommit checks tx and, when it passes, commits it in a new block.
+// The block's EndBlock response is returned along with the transaction's.
+func (h *Harness) BroadcastTxCommit(tx []byte) (types.ResponseCheckTx, types.ResponseDeliverTx, types.ResponseEndBlock) {
+	checkTx := h.App.CheckTx(tx)
+	if checkTx.Code != 0 {
+		return checkTx, types.ResponseDeliverTx{}, types.ResponseEndBlock{}
+	}
+	deliverTxs, endBlock := h.Block(tx)
+	return checkTx, deliverTxs[0], endBlock
+}
+
+// Block commits txs in a new block without checking them first
+func (h *Harness) Block(txs ...[]byte) ([]types.ResponseDeliverTx, types.ResponseEndBlock) {
+	h.height++
+	var beginBlock types.RequestBeginBlock
+	beginBlock.Header.Height = h.height
+	beginBlock.Header.ChainID = h.ChainID
+	h.App.BeginBlock(beginBlock)
+	deliverTxs := make([]types.ResponseDeliverTx, 0, len(txs))
+	for _, tx := range txs {
+		deliverTxs = append(deliverTxs, h.App.DeliverTx(tx))
+	}
+	endBlock := h.App.EndBlock(types.RequestEndBlock{Height: h.height})
+	h.App.Commit()
+	return deliverTxs, endBlock
+}
+
+// Query queries the application, at the latest committed height when height is 0
+func (h *Harness) Query(method string, params []byte, height int64, prove bool) types.ResponseQuery {
+	query := protoTm.Query{
+		Method: method,
+		Params: string(params),
+	}
+	data, err := proto.Marshal(&query)
+	if err != nil {
+		panic(err)
+	}
+	return h.App.Query(types.RequestQuery{Data: data, Height: height, Prove: prove})
+}
+
+// Info returns the application's info
+func (h *Harness) Info() types.ResponseInfo {
+	return h.App.Info(types.RequestInfo{})
+}
+
+// NewTx builds a transaction of method with JSON params signed by key of
+// nodeID (RSA PKCS#1 v1.5 with SHA-256) under a random nonce bound to the
+// last committed block height
+func (h *Harness) NewTx(method string, params []byte, key *rsa.PrivateKey, nodeID string) ([]byte, error) {
+	random := make([]byte, 12)
+	_, err := rand.Read(random)
+	if err != nil {
+		return nil, err
+	}
+	nonce := []byte(strconv.FormatInt(h.height, 10) + ":" + base64.StdEncoding.EncodeToString(random))
+	signature, err := Sign(method, params, nonce, key)
+	if err != nil {
+		return nil, err
+	}
+	tx := protoTm.Tx{
+		Method:    method,
+		Params:    string(params),
+		Nonce:     nonce,
+		Signature: signature,
+		NodeId:    nodeID,
+	}
+	return proto.Marshal(&tx)
+}
+
+// Sign signs method, signed params and nonce the way the application verifies
+// a transaction signed with an RSA PKCS#1 v1.5 node key
+func Sign(method string, signedParams []byte, nonce []byte, key *rsa.PrivateKey) ([]byte, error) {
+	message := append([]byte(method), signedParams...)
+	message = append(message, nonce...)
+	hash := crypto.SHA256.New()
+	hash.Write([]byte(base64.StdEncoding.EncodeToString(message)))
+	return rsa.SignPKCS1v15(rand.Reader, key, crypto.SHA256, hash.Sum(nil))
+}
@@ -57,7 +57,7 @@ func (app *DIDApplication) signData(param string, nodeID string) types.ResponseD
 	}
 
 	// Check IsTimedOut
-	if request.TimedOut {
+	if request.TimedOut || app.isRequestExpired(&request) {
 		return app.ReturnDeliverTxLog(code.RequestIsTimedOut, "Request is timed out", "")
 	}
 
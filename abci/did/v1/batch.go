@@ -0,0 +1,196 @@
+/**
+ * Copyright (c) 2018, 2019 National Digital ID COMPANY LIMITED
+ *
+ * This file is part of NDID software.
+ *
+ * NDID is the free software: you can redistribute it and/or modify it under
+ * the terms of the Affero GNU General Public License as published by the
+ * Free Software Foundation, either version 3 of the License, or any later
+ * version.
+ *
+ * NDID is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.
+ * See the Affero GNU General Public License for more details.
+ *
+ * You should have received a copy of the Affero GNU General Public License
+ * along with the NDID source code. If not, see https://www.gnu.org/licenses/agpl.txt.
+ *
+ * Please contact info@ndid.co.th for any further questions
+ *
+ */
+
+package did
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/ndidplatform/smart-contract/abci/code"
+	"github.com/tendermint/tendermint/abci/types"
+	cmn "github.com/tendermint/tendermint/libs/common"
+)
+
+// writeJournal keeps the value each key had before its first write in a
+// transaction, in write order, so the writes can be rolled back
+// deterministically. A journal started inside another one is merged into its
+// parent when kept, so the outer transaction can still roll it back.
+type writeJournal struct {
+	keys     [][]byte
+	previous map[string][]byte
+	parent   *writeJournal
+}
+
+func (app *DIDApplication) beginJournal() {
+	app.journal = &writeJournal{previous: make(map[string][]byte), parent: app.journal}
+}
+
+func (app *DIDApplication) recordWrite(key []byte) {
+	if app.journal == nil {
+		return
+	}
+	if _, recorded := app.journal.previous[string(key)]; recorded {
+		return
+	}
+	_, value := app.state.db.Get(key)
+	app.journal.keys = append(app.journal.keys, key)
+	app.journal.previous[string(key)] = value
+}
+
+// commitJournal keeps the writes recorded since the last beginJournal
+func (app *DIDApplication) commitJournal() {
+	journal := app.journal
+	app.journal = journal.parent
+	if app.journal == nil {
+		return
+	}
+	for _, key := range journal.keys {
+		if _, recorded := app.journal.previous[string(key)]; recorded {
+			continue
+		}
+		app.journal.keys = append(app.journal.keys, key)
+		app.journal.previous[string(key)] = journal.previous[string(key)]
+	}
+}
+
+// rollbackJournal restores every key written since the last beginJournal
+func (app *DIDApplication) rollbackJournal() {
+	journal := app.journal
+	app.journal = journal.parent
+	for index := len(journal.keys) - 1; index >= 0; index-- {
+		key := journal.keys[index]
+		value := journal.previous[string(key)]
+		if value == nil {
+			app.state.db.Remove(key)
+		} else {
+			app.state.db.Set(key, value)
+		}
+	}
+}
+
+func isBatchableMethod(method string) bool {
+	return IsMethod[method] &&
+		!isNDIDMethod[method] &&
+		!isGovernanceMethod[method] &&
+		!IsMasterKeyMethod[method] &&
+		method != "Batch"
+}
+
+func (app *DIDApplication) checkTxBatch(param string, nodeID string) types.ResponseCheckTx {
+	var funcParam BatchParam
+	err := json.Unmarshal([]byte(param), &funcParam)
+	if err != nil {
+		return ReturnCheckTx(code.UnmarshalError, err.Error())
+	}
+	if len(funcParam.Transactions) == 0 {
+		return ReturnCheckTx(code.BatchIsEmpty, "Batch is empty")
+	}
+	// Requests created by earlier transactions in the batch, which are owned
+	// by the node but not in the state yet
+	createdRequests := make(map[string]bool)
+	for index, tx := range funcParam.Transactions {
+		if !isBatchableMethod(tx.Method) {
+			return ReturnCheckTx(code.MethodCanNotBeBatched, fmt.Sprintf("Transaction %d: method %s can not be batched", index, tx.Method))
+		}
+		checkCode, log := validateParams(tx.Method, tx.Params)
+		if checkCode != code.OK {
+			return ReturnCheckTx(checkCode, fmt.Sprintf("Transaction %d: %s", index, log))
+		}
+		if tx.Method == "RegisterAccessor" || tx.Method == "AddAccessorMethod" {
+			checkCode, log = checkAccessorPubKey(tx.Params)
+			if checkCode != code.OK {
+				return ReturnCheckTx(checkCode, fmt.Sprintf("Transaction %d: %s", index, log))
+			}
+		}
+		var requestParam RequestIDParam
+		err := json.Unmarshal([]byte(tx.Params), &requestParam)
+		if err != nil {
+			return ReturnCheckTx(code.UnmarshalError, fmt.Sprintf("Transaction %d: %s", index, err.Error()))
+		}
+		if IsCheckOwnerRequestMethod[tx.Method] && createdRequests[requestParam.RequestID] {
+			continue
+		}
+		checkResult := app.checkBatchTx(tx.Method, tx.Params, nodeID)
+		if checkResult.Code != code.OK {
+			return ReturnCheckTx(checkResult.Code, fmt.Sprintf("Transaction %d: %s", index, checkResult.Log))
+		}
+		if tx.Method == "CreateRequest" {
+			createdRequests[requestParam.RequestID] = true
+		}
+	}
+	return ReturnCheckTx(code.OK, "")
+}
+
+// checkBatchTx does the method specific checks of a transaction in batch
+// against the state written by previous transactions in the same batch
+func (app *DIDApplication) checkBatchTx(method string, param string, nodeID string) types.ResponseCheckTx {
+	if IsCheckOwnerRequestMethod[method] {
+		return app.checkIsOwnerRequest(param, nodeID)
+	}
+	return app.callCheckTx(method, param, nodeID)
+}
+
+func (app *DIDApplication) batch(param string, nodeID string) types.ResponseDeliverTx {
+	app.logger.Infof("Batch, Parameter: %s", param)
+	var funcParam BatchParam
+	err := json.Unmarshal([]byte(param), &funcParam)
+	if err != nil {
+		return app.ReturnDeliverTxLog(code.UnmarshalError, err.Error(), "")
+	}
+	app.beginJournal()
+	committed := false
+	defer func() {
+		// Roll back every write unless all transactions succeed, including on panic
+		if !committed {
+			app.rollbackJournal()
+		}
+	}()
+	var tags []cmn.KVPair
+	results := make([]string, 0)
+	for index, tx := range funcParam.Transactions {
+		checkResult := app.checkBatchTx(tx.Method, tx.Params, nodeID)
+		if checkResult.Code != code.OK {
+			return app.ReturnDeliverTxLog(checkResult.Code, fmt.Sprintf("Transaction %d (%s): %s", index, tx.Method, checkResult.Log), "")
+		}
+		result := app.callDeliverTx(tx.Method, tx.Params, nodeID)
+		if result.Code != code.OK {
+			return app.ReturnDeliverTxLog(result.Code, fmt.Sprintf("Transaction %d (%s): %s", index, tx.Method, result.Log), "")
+		}
+		for _, tag := range result.Tags {
+			if string(tag.Key) != tagSuccess {
+				tags = append(tags, tag)
+			}
+		}
+		results = append(results, string(result.Data))
+	}
+	// Keep the writes
+	app.commitJournal()
+	committed = true
+	resultsJSON, err := json.Marshal(results)
+	if err != nil {
+		return app.ReturnDeliverTxLog(code.MarshalError, err.Error(), "")
+	}
+	result := app.ReturnDeliverTxLog(code.OK, "success", string(resultsJSON))
+	result.Tags = append(result.Tags, tags...)
+	return result
+}
@@ -23,14 +23,12 @@
 package did
 
 import (
-	"crypto"
-	"crypto/dsa"
-	"crypto/ecdsa"
 	"crypto/rsa"
 	"crypto/x509"
 	"encoding/base64"
 	"encoding/json"
 	"encoding/pem"
+	"errors"
 	"fmt"
 	"strconv"
 	"strings"
@@ -88,6 +86,18 @@ var IsMethod = map[string]bool{
 	"SetInitData":                      true,
 	"EndInit":                          true,
 	"SetLastBlock":                     true,
+	"SetGovernance":                    true,
+	"CreateProposal":                   true,
+	"ApproveProposal":                  true,
+	"InitiateMasterKeyRecovery":        true,
+	"CancelMasterKeyRecovery":          true,
+	"Batch":                            true,
+	"ScheduleUpgrade":                  true,
+	"TransferToken":                    true,
+	"SetTokenTransferWhitelist":        true,
+	"SetPriceRule":                     true,
+	"SetRevenueShareRule":              true,
+	"SetNodeTokenLimit":                true,
 }
 
 func (app *DIDApplication) checkTxInitNDID(param string, nodeID string) types.ResponseCheckTx {
@@ -229,23 +239,25 @@ func (app *DIDApplication) checkIsOwnerRequest(param string, nodeID string) type
 	return ReturnCheckTx(code.OK, "")
 }
 
-func verifySignature(param string, nonce []byte, signature []byte, publicKey string, method string) (result bool, err error) {
+func verifySignature(param string, nonce []byte, signature []byte, publicKey string, method string, signatureScheme string) (result bool, err error) {
 	publicKey = strings.Replace(publicKey, "\t", "", -1)
 	block, _ := pem.Decode([]byte(publicKey))
-	senderPublicKeyInterface, err := x509.ParsePKIXPublicKey(block.Bytes)
-	senderPublicKey := senderPublicKeyInterface.(*rsa.PublicKey)
+	if block == nil {
+		return false, errors.New("Invalid key format. Cannot decode PEM.")
+	}
+	senderPublicKey, err := x509.ParsePKIXPublicKey(block.Bytes)
 	if err != nil {
 		return false, err
 	}
+	kt, ok := getKeyType(senderPublicKey)
+	if !ok {
+		return false, errors.New("Unknown key type")
+	}
 	tempPSSmessage := append([]byte(method), []byte(param)...)
 	tempPSSmessage = append(tempPSSmessage, []byte(nonce)...)
 	PSSmessage := []byte(base64.StdEncoding.EncodeToString(tempPSSmessage))
-	newhash := crypto.SHA256
-	pssh := newhash.New()
-	pssh.Write(PSSmessage)
-	hashed := pssh.Sum(nil)
 
-	err = rsa.VerifyPKCS1v15(senderPublicKey, newhash, hashed, signature)
+	err = kt.verify(senderPublicKey, PSSmessage, signature, signatureScheme)
 	if err != nil {
 		return false, err
 	}
@@ -297,6 +309,20 @@ func (app *DIDApplication) getPublicKeyFromNodeID(nodeID string) string {
 	return nodeDetail.PublicKey
 }
 
+func (app *DIDApplication) getSignatureSchemeFromNodeID(nodeID string) string {
+	key := "NodeID" + "|" + nodeID
+	_, value := app.state.db.Get(prefixKey([]byte(key)))
+	if value == nil {
+		return ""
+	}
+	var nodeDetail data.NodeDetail
+	err := proto.Unmarshal(value, &nodeDetail)
+	if err != nil {
+		return ""
+	}
+	return nodeDetail.SignatureScheme
+}
+
 func (app *DIDApplication) getRoleFromNodeID(nodeID string) string {
 	key := "NodeID" + "|" + nodeID
 	_, value := app.state.db.Get(prefixKey([]byte(key)))
@@ -321,17 +347,25 @@ func checkPubKey(key string) (returnCode uint32, log string) {
 		return code.InvalidKeyFormat, err.Error()
 	}
 
-	switch pubKey := pub.(type) {
-	case *rsa.PublicKey:
-		if pubKey.N.BitLen() < 2048 {
-			return code.RSAKeyLengthTooShort, "RSA key length is too short. Must be at least 2048-bit."
-		}
-	case *dsa.PublicKey, *ecdsa.PublicKey:
-		return code.UnsupportedKeyType, "Unsupported key type. Only RSA is allowed."
-	default:
-		return code.UnknownKeyType, "Unknown key type. Only RSA is allowed."
+	kt, ok := getKeyType(pub)
+	if !ok {
+		return code.UnknownKeyType, "Unknown key type. Only RSA, ECDSA (P-256) and Ed25519 are allowed."
 	}
-	return code.OK, ""
+	return kt.check(pub)
+}
+
+// isRSAPubKey reports whether key is a PEM encoded RSA public key
+func isRSAPubKey(key string) bool {
+	block, _ := pem.Decode([]byte(key))
+	if block == nil {
+		return false
+	}
+	pub, err := x509.ParsePKIXPublicKey(block.Bytes)
+	if err != nil {
+		return false
+	}
+	_, ok := pub.(*rsa.PublicKey)
+	return ok
 }
 
 func checkNodePubKeys(param string) (returnCode uint32, log string) {
@@ -383,7 +417,8 @@ var IsCheckOwnerRequestMethod = map[string]bool{
 }
 
 var IsMasterKeyMethod = map[string]bool{
-	"UpdateNode": true,
+	"UpdateNode":              true,
+	"CancelMasterKeyRecovery": true,
 }
 
 func (app *DIDApplication) checkCanCreateTx() types.ResponseCheckTx {
@@ -427,7 +462,13 @@ func (app *DIDApplication) checkLastBlock() types.ResponseCheckTx {
 }
 
 // CheckTxRouter is Pointer to function
-func (app *DIDApplication) CheckTxRouter(method string, param string, nonce []byte, signature []byte, nodeID string) types.ResponseCheckTx {
+func (app *DIDApplication) CheckTxRouter(method string, param string, signedParam string, nonce []byte, signature []byte, nodeID string) types.ResponseCheckTx {
+
+	// ---- Check params against method's schema ----
+	checkCode, log := validateParams(method, param)
+	if checkCode != code.OK {
+		return ReturnCheckTx(checkCode, log)
+	}
 
 	// ---- Check current block <= last block ----
 	if method != "SetLastBlock" {
@@ -450,13 +491,18 @@ func (app *DIDApplication) CheckTxRouter(method string, param string, nonce []by
 		}
 	}
 
+	// ---- Governance methods are signed by governance keys ----
+	if isGovernanceMethod[method] {
+		return app.checkTxGovernance(method, param, signedParam, nonce, signature, nodeID)
+	}
+
 	var publicKey string
 	if method == "InitNDID" {
 		publicKey = getPublicKeyInitNDID(param)
 		if publicKey == "" {
 			return ReturnCheckTx(code.CannotGetPublicKeyFromParam, "Can not get public key from parameter")
 		}
-	} else if method == "UpdateNode" {
+	} else if IsMasterKeyMethod[method] {
 		publicKey = app.getMasterPublicKeyFromNodeID(nodeID)
 		if publicKey == "" {
 			return ReturnCheckTx(code.CannotGetMasterPublicKeyFromNodeID, "Can not get master public key from node ID")
@@ -515,11 +561,26 @@ func (app *DIDApplication) CheckTxRouter(method string, param string, nonce []by
 		}
 	}
 
-	verifyResult, err := verifySignature(param, nonce, signature, publicKey, method)
+	// Check nonce is not used by this node
+	nonceResult := app.checkNonce(nonce, nonceSigner(method, nodeID))
+	if nonceResult.Code != code.OK {
+		return nonceResult
+	}
+
+	var signatureScheme string
+	if method != "InitNDID" {
+		signatureScheme = app.getSignatureSchemeFromNodeID(nodeID)
+	}
+	verifyResult, err := verifySignature(signedParam, nonce, signature, publicKey, method, signatureScheme)
 	if err != nil || verifyResult == false {
 		return ReturnCheckTx(code.VerifySignatureError, err.Error())
 	}
 
+	// ---- NDID methods must be proposed when governance is enabled ----
+	if isNDIDMethod[method] && app.isGovernanceEnabled() {
+		return ReturnCheckTx(code.NDIDMethodRequiresProposal, "NDID method must be proposed through governance")
+	}
+
 	var result types.ResponseCheckTx
 
 	// special case checkIsOwnerRequest
@@ -534,8 +595,8 @@ func (app *DIDApplication) CheckTxRouter(method string, param string, nonce []by
 	// check token for create Tx
 	if result.Code == code.OK {
 		if !app.checkNDID(param, nodeID) && method != "InitNDID" {
-			needToken := app.getTokenPriceByFunc(method, app.state.db.Version())
-			nodeToken, err := app.getToken(nodeID)
+			needToken := app.getTxTokenPrice(method, param)
+			nodeToken, err := app.getAvailableToken(nodeID)
 			if err != nil {
 				result.Code = code.TokenAccountNotFound
 				result.Log = "token account not found"
@@ -578,7 +639,14 @@ func (app *DIDApplication) callCheckTx(name string, param string, nodeID string)
 		"RemoveNodeFromProxyNode",
 		"SetInitData",
 		"EndInit",
-		"SetLastBlock":
+		"SetLastBlock",
+		"SetGovernance",
+		"InitiateMasterKeyRecovery",
+		"ScheduleUpgrade",
+		"SetTokenTransferWhitelist",
+		"SetPriceRule",
+		"SetRevenueShareRule",
+		"SetNodeTokenLimit":
 		return app.checkIsNDID(param, nodeID)
 	case "RegisterIdentity",
 		"AddAccessorMethod",
@@ -599,6 +667,10 @@ func (app *DIDApplication) callCheckTx(name string, param string, nodeID string)
 		return app.checkIsRPorIdP(param, nodeID)
 	case "SetMqAddresses":
 		return app.checkTxSetMqAddresses(param, nodeID)
+	case "Batch":
+		return app.checkTxBatch(param, nodeID)
+	case "TransferToken":
+		return app.checkTxTransferToken(param, nodeID)
 	default:
 		return types.ResponseCheckTx{Code: code.UnknownMethod, Log: "Unknown method name"}
 	}
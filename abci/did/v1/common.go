@@ -69,29 +69,29 @@ func (app *DIDApplication) getNodeMasterPublicKey(param string, height int64) ty
 	var funcParam GetNodeMasterPublicKeyParam
 	err := json.Unmarshal([]byte(param), &funcParam)
 	if err != nil {
-		return app.ReturnQuery(nil, err.Error(), app.state.db.Version())
+		return app.ReturnQuery(nil, err.Error(), height)
 	}
 	key := "NodeID" + "|" + funcParam.NodeID
-	_, value := app.state.db.GetVersioned(prefixKey([]byte(key)), height)
+	value := app.GetVersionedStateDB([]byte(key), height)
 	var res GetNodeMasterPublicKeyResult
 	if value == nil {
 		valueJSON, err := json.Marshal(res)
 		if err != nil {
-			return app.ReturnQuery(nil, err.Error(), app.state.db.Version())
+			return app.ReturnQuery(nil, err.Error(), height)
 		}
-		return app.ReturnQuery(valueJSON, "not found", app.state.db.Version())
+		return app.ReturnQuery(valueJSON, "not found", height)
 	}
 	var nodeDetail data.NodeDetail
 	err = proto.Unmarshal(value, &nodeDetail)
 	if err != nil {
-		return app.ReturnQuery(nil, err.Error(), app.state.db.Version())
+		return app.ReturnQuery(nil, err.Error(), height)
 	}
 	res.MasterPublicKey = nodeDetail.MasterPublicKey
 	valueJSON, err := json.Marshal(res)
 	if err != nil {
-		return app.ReturnQuery(nil, err.Error(), app.state.db.Version())
+		return app.ReturnQuery(nil, err.Error(), height)
 	}
-	return app.ReturnQuery(valueJSON, "success", app.state.db.Version())
+	return app.ReturnQuery(valueJSON, "success", height)
 
 }
 
@@ -100,29 +100,29 @@ func (app *DIDApplication) getNodePublicKey(param string, height int64) types.Re
 	var funcParam GetNodePublicKeyParam
 	err := json.Unmarshal([]byte(param), &funcParam)
 	if err != nil {
-		return app.ReturnQuery(nil, err.Error(), app.state.db.Version())
+		return app.ReturnQuery(nil, err.Error(), height)
 	}
 	key := "NodeID" + "|" + funcParam.NodeID
-	_, value := app.state.db.GetVersioned(prefixKey([]byte(key)), height)
+	value := app.GetVersionedStateDB([]byte(key), height)
 	var res GetNodePublicKeyResult
 	if value == nil {
 		valueJSON, err := json.Marshal(res)
 		if err != nil {
-			return app.ReturnQuery(nil, err.Error(), app.state.db.Version())
+			return app.ReturnQuery(nil, err.Error(), height)
 		}
-		return app.ReturnQuery(valueJSON, "not found", app.state.db.Version())
+		return app.ReturnQuery(valueJSON, "not found", height)
 	}
 	var nodeDetail data.NodeDetail
 	err = proto.Unmarshal(value, &nodeDetail)
 	if err != nil {
-		return app.ReturnQuery(nil, err.Error(), app.state.db.Version())
+		return app.ReturnQuery(nil, err.Error(), height)
 	}
 	res.PublicKey = nodeDetail.PublicKey
 	valueJSON, err := json.Marshal(res)
 	if err != nil {
-		return app.ReturnQuery(nil, err.Error(), app.state.db.Version())
+		return app.ReturnQuery(nil, err.Error(), height)
 	}
-	return app.ReturnQuery(valueJSON, "success", app.state.db.Version())
+	return app.ReturnQuery(valueJSON, "success", height)
 }
 
 func (app *DIDApplication) getNodeNameByNodeID(nodeID string) string {
@@ -144,112 +144,131 @@ func (app *DIDApplication) getIdpNodes(param string, height int64) types.Respons
 	var funcParam GetIdpNodesParam
 	err := json.Unmarshal([]byte(param), &funcParam)
 	if err != nil {
-		return app.ReturnQuery(nil, err.Error(), app.state.db.Version())
+		return app.ReturnQuery(nil, err.Error(), height)
 	}
 
 	var returnNodes GetIdpNodesResult
 	returnNodes.Node = make([]MsqDestinationNode, 0)
+	pager := newListPager(funcParam.Cursor, funcParam.Limit)
 
 	if funcParam.HashID == "" {
-		idpsKey := "IdPList"
-		_, idpsValue := app.state.db.GetVersioned(prefixKey([]byte(idpsKey)), height)
-		var idpsList data.IdPList
-		if idpsValue != nil {
-			err := proto.Unmarshal(idpsValue, &idpsList)
+		idps := app.newVersionedListIterator(idpListKey, funcParam.Cursor, height, pager.scanSize())
+		for idps.Next() {
+			idp := idps.Item()
+			if pager.skip(idp) {
+				continue
+			}
+			nodeDetailKey := "NodeID" + "|" + idp
+			nodeDetailValue := app.GetVersionedStateDB([]byte(nodeDetailKey), height)
+			if nodeDetailValue == nil {
+				continue
+			}
+			var nodeDetail data.NodeDetail
+			err := proto.Unmarshal(nodeDetailValue, &nodeDetail)
 			if err != nil {
-				return app.ReturnQuery(nil, err.Error(), app.state.db.Version())
+				continue
 			}
-			for _, idp := range idpsList.NodeId {
-				nodeDetailKey := "NodeID" + "|" + idp
-				_, nodeDetailValue := app.state.db.Get(prefixKey([]byte(nodeDetailKey)))
-				if nodeDetailValue == nil {
-					continue
-				}
-				var nodeDetail data.NodeDetail
-				err := proto.Unmarshal(nodeDetailValue, &nodeDetail)
-				if err != nil {
-					continue
-				}
-				// check node is active
-				if !nodeDetail.Active {
-					continue
-				}
-				// check Max IAL && AAL
-				if !(nodeDetail.MaxIal >= funcParam.MinIal &&
-					nodeDetail.MaxAal >= funcParam.MinAal) {
-					continue
-				}
-				var msqDesNode = MsqDestinationNode{
-					idp,
-					nodeDetail.NodeName,
-					nodeDetail.MaxIal,
-					nodeDetail.MaxAal,
-				}
-				returnNodes.Node = append(returnNodes.Node, msqDesNode)
+			// check node is active
+			if !nodeDetail.Active {
+				continue
 			}
+			// check Max IAL && AAL
+			if !(nodeDetail.MaxIal >= funcParam.MinIal &&
+				nodeDetail.MaxAal >= funcParam.MinAal) {
+				continue
+			}
+			if !strings.HasPrefix(nodeDetail.NodeName, funcParam.NamePrefix) {
+				continue
+			}
+			if pager.full() {
+				break
+			}
+			pager.add(idp)
+			var msqDesNode = MsqDestinationNode{
+				idp,
+				nodeDetail.NodeName,
+				nodeDetail.MaxIal,
+				nodeDetail.MaxAal,
+			}
+			returnNodes.Node = append(returnNodes.Node, msqDesNode)
+		}
+		if idps.Err() != nil {
+			return app.ReturnQuery(nil, idps.Err().Error(), height)
 		}
 	} else {
-		key := "MsqDestination" + "|" + funcParam.HashID
-		_, value := app.state.db.GetVersioned(prefixKey([]byte(key)), height)
-
-		if value != nil {
-			var nodes data.MsqDesList
-			err = proto.Unmarshal([]byte(value), &nodes)
+		nodes := app.newVersionedListIterator(msqDestinationList(funcParam.HashID), funcParam.Cursor, height, pager.scanSize())
+		for nodes.Next() {
+			var node data.Node
+			err = proto.Unmarshal(nodes.Value(), &node)
 			if err != nil {
-				return app.ReturnQuery(nil, err.Error(), app.state.db.Version())
+				return app.ReturnQuery(nil, err.Error(), height)
 			}
-
-			for _, node := range nodes.Nodes {
-				// check msq destination is not active
-				if !node.Active {
-					continue
-				}
-				// check Ial > min ial
-				if node.Ial < funcParam.MinIal {
-					continue
-				}
-				// check msq destination is not timed out
-				if node.TimeoutBlock != 0 && app.CurrentBlock > node.TimeoutBlock {
-					continue
-				}
-				nodeDetailKey := "NodeID" + "|" + node.NodeId
-				_, nodeDetailValue := app.state.db.Get(prefixKey([]byte(nodeDetailKey)))
-				if nodeDetailValue == nil {
-					continue
-				}
-				var nodeDetail data.NodeDetail
-				err := proto.Unmarshal(nodeDetailValue, &nodeDetail)
-				if err != nil {
-					continue
-				}
-				// check node is active
-				if !nodeDetail.Active {
-					continue
-				}
-				// check Max IAL && AAL
-				if !(nodeDetail.MaxIal >= funcParam.MinIal &&
-					nodeDetail.MaxAal >= funcParam.MinAal) {
-					continue
-				}
-				var msqDesNode = MsqDestinationNode{
-					node.NodeId,
-					nodeDetail.NodeName,
-					nodeDetail.MaxIal,
-					nodeDetail.MaxAal,
-				}
-				returnNodes.Node = append(returnNodes.Node, msqDesNode)
+			if pager.skip(node.NodeId) {
+				continue
+			}
+			// check msq destination is not active
+			if !node.Active {
+				continue
+			}
+			// check Ial > min ial
+			if node.Ial < funcParam.MinIal {
+				continue
+			}
+			// check msq destination is not timed out
+			if node.TimeoutBlock != 0 && height > node.TimeoutBlock {
+				continue
+			}
+			nodeDetailKey := "NodeID" + "|" + node.NodeId
+			nodeDetailValue := app.GetVersionedStateDB([]byte(nodeDetailKey), height)
+			if nodeDetailValue == nil {
+				continue
+			}
+			var nodeDetail data.NodeDetail
+			err := proto.Unmarshal(nodeDetailValue, &nodeDetail)
+			if err != nil {
+				continue
+			}
+			// check node is active
+			if !nodeDetail.Active {
+				continue
+			}
+			// check Max IAL && AAL
+			if !(nodeDetail.MaxIal >= funcParam.MinIal &&
+				nodeDetail.MaxAal >= funcParam.MinAal) {
+				continue
+			}
+			if !strings.HasPrefix(nodeDetail.NodeName, funcParam.NamePrefix) {
+				continue
+			}
+			if pager.full() {
+				break
 			}
+			pager.add(node.NodeId)
+			var msqDesNode = MsqDestinationNode{
+				node.NodeId,
+				nodeDetail.NodeName,
+				nodeDetail.MaxIal,
+				nodeDetail.MaxAal,
+			}
+			returnNodes.Node = append(returnNodes.Node, msqDesNode)
 		}
+		if nodes.Err() != nil {
+			return app.ReturnQuery(nil, nodes.Err().Error(), height)
+		}
+	}
+	if !pager.validCursor() {
+		return app.ReturnQuery(nil, "Invalid cursor", height)
 	}
+	returnNodes.NextCursor = pager.nextCursor
 
 	value, err := json.Marshal(returnNodes)
 	if err != nil {
-		return app.ReturnQuery(nil, err.Error(), app.state.db.Version())
+		return app.ReturnQuery(nil, err.Error(), height)
 	}
 	if len(returnNodes.Node) == 0 {
-		return app.ReturnQuery(value, "not found", app.state.db.Version())
+		return app.ReturnQuery(value, "not found", height)
 	}
-	return app.ReturnQuery(value, "success", app.state.db.Version())
+	return app.ReturnQuery(value, "success", height)
 }
 
 func (app *DIDApplication) getAsNodesByServiceId(param string, height int64) types.ResponseQuery {
@@ -257,52 +276,52 @@ func (app *DIDApplication) getAsNodesByServiceId(param string, height int64) typ
 	var funcParam GetAsNodesByServiceIdParam
 	err := json.Unmarshal([]byte(param), &funcParam)
 	if err != nil {
-		return app.ReturnQuery(nil, err.Error(), app.state.db.Version())
+		return app.ReturnQuery(nil, err.Error(), height)
 	}
 	key := "ServiceDestination" + "|" + funcParam.ServiceID
-	_, value := app.state.db.GetVersioned(prefixKey([]byte(key)), height)
+	value := app.GetVersionedStateDB([]byte(key), height)
 
 	if value == nil {
 		var result GetAsNodesByServiceIdResult
 		result.Node = make([]ASNode, 0)
 		value, err := json.Marshal(result)
 		if err != nil {
-			return app.ReturnQuery(nil, err.Error(), app.state.db.Version())
+			return app.ReturnQuery(nil, err.Error(), height)
 		}
-		return app.ReturnQuery(value, "not found", app.state.db.Version())
+		return app.ReturnQuery(value, "not found", height)
 	}
 
 	// filter serive is active
 	serviceKey := "Service" + "|" + funcParam.ServiceID
-	_, serviceValue := app.state.db.Get(prefixKey([]byte(serviceKey)))
+	serviceValue := app.GetVersionedStateDB([]byte(serviceKey), height)
 	if serviceValue == nil {
 		var result GetAsNodesByServiceIdResult
 		result.Node = make([]ASNode, 0)
 		value, err := json.Marshal(result)
 		if err != nil {
-			return app.ReturnQuery(nil, err.Error(), app.state.db.Version())
+			return app.ReturnQuery(nil, err.Error(), height)
 		}
-		return app.ReturnQuery(value, "not found", app.state.db.Version())
+		return app.ReturnQuery(value, "not found", height)
 	}
 	var service data.ServiceDetail
 	err = proto.Unmarshal([]byte(serviceValue), &service)
 	if err != nil {
-		return app.ReturnQuery(nil, err.Error(), app.state.db.Version())
+		return app.ReturnQuery(nil, err.Error(), height)
 	}
 	if service.Active == false {
 		var result GetAsNodesByServiceIdResult
 		result.Node = make([]ASNode, 0)
 		value, err := json.Marshal(result)
 		if err != nil {
-			return app.ReturnQuery(nil, err.Error(), app.state.db.Version())
+			return app.ReturnQuery(nil, err.Error(), height)
 		}
-		return app.ReturnQuery(value, "service is not active", app.state.db.Version())
+		return app.ReturnQuery(value, "service is not active", height)
 	}
 
 	var storedData data.ServiceDesList
 	err = proto.Unmarshal([]byte(value), &storedData)
 	if err != nil {
-		return app.ReturnQuery(nil, err.Error(), app.state.db.Version())
+		return app.ReturnQuery(nil, err.Error(), height)
 	}
 
 	var result GetAsNodesByServiceIdWithNameResult
@@ -316,7 +335,7 @@ func (app *DIDApplication) getAsNodesByServiceId(param string, height int64) typ
 
 		// Filter approve from NDID
 		approveServiceKey := "ApproveKey" + "|" + funcParam.ServiceID + "|" + storedData.Node[index].NodeId
-		_, approveServiceJSON := app.state.db.Get(prefixKey([]byte(approveServiceKey)))
+		approveServiceJSON := app.GetVersionedStateDB([]byte(approveServiceKey), height)
 		if approveServiceJSON == nil {
 			continue
 		}
@@ -330,7 +349,7 @@ func (app *DIDApplication) getAsNodesByServiceId(param string, height int64) typ
 		}
 
 		nodeDetailKey := "NodeID" + "|" + storedData.Node[index].NodeId
-		_, nodeDetailValue := app.state.db.Get(prefixKey([]byte(nodeDetailKey)))
+		nodeDetailValue := app.GetVersionedStateDB([]byte(nodeDetailKey), height)
 		if nodeDetailValue == nil {
 			continue
 		}
@@ -354,12 +373,12 @@ func (app *DIDApplication) getAsNodesByServiceId(param string, height int64) typ
 	}
 	resultJSON, err := json.Marshal(result)
 	if err != nil {
-		return app.ReturnQuery(nil, err.Error(), app.state.db.Version())
+		return app.ReturnQuery(nil, err.Error(), height)
 	}
 	if len(result.Node) == 0 {
-		return app.ReturnQuery(resultJSON, "not found", app.state.db.Version())
+		return app.ReturnQuery(resultJSON, "not found", height)
 	}
-	return app.ReturnQuery(resultJSON, "success", app.state.db.Version())
+	return app.ReturnQuery(resultJSON, "success", height)
 }
 
 func (app *DIDApplication) getMqAddresses(param string, height int64) types.ResponseQuery {
@@ -367,18 +386,18 @@ func (app *DIDApplication) getMqAddresses(param string, height int64) types.Resp
 	var funcParam GetMqAddressesParam
 	err := json.Unmarshal([]byte(param), &funcParam)
 	if err != nil {
-		return app.ReturnQuery(nil, err.Error(), app.state.db.Version())
+		return app.ReturnQuery(nil, err.Error(), height)
 	}
 	nodeDetailKey := "NodeID" + "|" + funcParam.NodeID
-	_, value := app.state.db.Get(prefixKey([]byte(nodeDetailKey)))
+	value := app.GetVersionedStateDB([]byte(nodeDetailKey), height)
 	var nodeDetail data.NodeDetail
 	err = proto.Unmarshal(value, &nodeDetail)
 	if err != nil {
-		return app.ReturnQuery(nil, err.Error(), app.state.db.Version())
+		return app.ReturnQuery(nil, err.Error(), height)
 	}
 	if value == nil {
 		value = []byte("[]")
-		return app.ReturnQuery(value, "not found", app.state.db.Version())
+		return app.ReturnQuery(value, "not found", height)
 	}
 	var result GetMqAddressesResult
 	for _, msq := range nodeDetail.Mq {
@@ -389,12 +408,12 @@ func (app *DIDApplication) getMqAddresses(param string, height int64) types.Resp
 	}
 	resultJSON, err := json.Marshal(result)
 	if err != nil {
-		return app.ReturnQuery(nil, err.Error(), app.state.db.Version())
+		return app.ReturnQuery(nil, err.Error(), height)
 	}
 	if len(result) == 0 {
-		return app.ReturnQuery(resultJSON, "not found", app.state.db.Version())
+		return app.ReturnQuery(resultJSON, "not found", height)
 	}
-	return app.ReturnQuery(resultJSON, "success", app.state.db.Version())
+	return app.ReturnQuery(resultJSON, "success", height)
 }
 
 func (app *DIDApplication) getRequest(param string, height int64) types.ResponseQuery {
@@ -402,19 +421,19 @@ func (app *DIDApplication) getRequest(param string, height int64) types.Response
 	var funcParam GetRequestParam
 	err := json.Unmarshal([]byte(param), &funcParam)
 	if err != nil {
-		return app.ReturnQuery(nil, err.Error(), app.state.db.Version())
+		return app.ReturnQuery(nil, err.Error(), height)
 	}
 	key := "Request" + "|" + funcParam.RequestID
-	_, value := app.state.db.GetVersioned(prefixKey([]byte(key)), height)
+	value := app.GetVersionedStateDB([]byte(key), height)
 
 	if value == nil {
 		valueJSON := []byte("{}")
-		return app.ReturnQuery(valueJSON, "not found", app.state.db.Version())
+		return app.ReturnQuery(valueJSON, "not found", height)
 	}
 	var request data.Request
 	err = proto.Unmarshal([]byte(value), &request)
 	if err != nil {
-		return app.ReturnQuery(nil, err.Error(), app.state.db.Version())
+		return app.ReturnQuery(nil, err.Error(), height)
 	}
 
 	var res GetRequestResult
@@ -425,9 +444,9 @@ func (app *DIDApplication) getRequest(param string, height int64) types.Response
 
 	valueJSON, err := json.Marshal(res)
 	if err != nil {
-		return app.ReturnQuery(nil, err.Error(), app.state.db.Version())
+		return app.ReturnQuery(nil, err.Error(), height)
 	}
-	return app.ReturnQuery(valueJSON, "success", app.state.db.Version())
+	return app.ReturnQuery(valueJSON, "success", height)
 }
 
 func (app *DIDApplication) getRequestDetail(param string, height int64) types.ResponseQuery {
@@ -435,15 +454,15 @@ func (app *DIDApplication) getRequestDetail(param string, height int64) types.Re
 	var funcParam GetRequestParam
 	err := json.Unmarshal([]byte(param), &funcParam)
 	if err != nil {
-		return app.ReturnQuery(nil, err.Error(), app.state.db.Version())
+		return app.ReturnQuery(nil, err.Error(), height)
 	}
 
 	key := "Request" + "|" + funcParam.RequestID
-	_, value := app.state.db.GetVersioned(prefixKey([]byte(key)), height)
+	value := app.GetVersionedStateDB([]byte(key), height)
 
 	if value == nil {
 		valueJSON := []byte("{}")
-		return app.ReturnQuery(valueJSON, "not found", app.state.db.Version())
+		return app.ReturnQuery(valueJSON, "not found", height)
 	}
 
 	var result GetRequestDetailResult
@@ -451,7 +470,7 @@ func (app *DIDApplication) getRequestDetail(param string, height int64) types.Re
 	err = proto.Unmarshal([]byte(value), &request)
 	if err != nil {
 		value = []byte("")
-		return app.ReturnQuery(value, err.Error(), app.state.db.Version())
+		return app.ReturnQuery(value, err.Error(), height)
 	}
 
 	result.RequestID = request.RequestId
@@ -542,40 +561,73 @@ func (app *DIDApplication) getRequestDetail(param string, height int64) types.Re
 	// Set creation_chain_id
 	result.CreationChainID = request.ChainId
 
+	// Set expire_block_height
+	result.ExpireBlockHeight = request.ExpireBlockHeight
+
 	resultJSON, err := json.Marshal(result)
 	if err != nil {
 		value = []byte("")
-		return app.ReturnQuery(value, err.Error(), app.state.db.Version())
+		return app.ReturnQuery(value, err.Error(), height)
 	}
-	return app.ReturnQuery(resultJSON, "success", app.state.db.Version())
+	return app.ReturnQuery(resultJSON, "success", height)
 }
 
 func (app *DIDApplication) getNamespaceList(param string, height int64) types.ResponseQuery {
 	app.logger.Infof("GetNamespaceList, Parameter: %s", param)
-	key := "AllNamespace"
-	_, value := app.state.db.GetVersioned(prefixKey([]byte(key)), height)
-	if value == nil {
-		value = []byte("[]")
-		return app.ReturnQuery(value, "not found", app.state.db.Version())
+	var funcParam GetListParam
+	if param != "" {
+		err := json.Unmarshal([]byte(param), &funcParam)
+		if err != nil {
+			return app.ReturnQuery(nil, err.Error(), height)
+		}
 	}
-
-	result := make([]*data.Namespace, 0)
+	var result GetNamespaceListResult
+	result.NamespaceList = make([]*data.Namespace, 0)
 	// filter flag==true
-	var namespaces data.NamespaceList
-	err := proto.Unmarshal([]byte(value), &namespaces)
-	if err != nil {
-		return app.ReturnQuery(nil, err.Error(), app.state.db.Version())
+	pager := newListPager(funcParam.Cursor, funcParam.Limit)
+	namespaces := app.newVersionedListIterator(namespaceListKey, funcParam.Cursor, height, pager.scanSize())
+	listed := false
+	for namespaces.Next() {
+		listed = true
+		var namespace data.Namespace
+		err := proto.Unmarshal(namespaces.Value(), &namespace)
+		if err != nil {
+			return app.ReturnQuery(nil, err.Error(), height)
+		}
+		if pager.skip(namespace.Namespace) {
+			continue
+		}
+		if !namespace.Active && !funcParam.IncludeInactive {
+			continue
+		}
+		if !strings.HasPrefix(namespace.Namespace, funcParam.NamePrefix) {
+			continue
+		}
+		if pager.full() {
+			break
+		}
+		pager.add(namespace.Namespace)
+		result.NamespaceList = append(result.NamespaceList, &namespace)
+	}
+	if namespaces.Err() != nil {
+		return app.ReturnQuery(nil, namespaces.Err().Error(), height)
 	}
-	for _, namespace := range namespaces.Namespaces {
-		if namespace.Active {
-			result = append(result, namespace)
+	if !listed && funcParam.Cursor == "" {
+		value, err := json.Marshal(result)
+		if err != nil {
+			return app.ReturnQuery(nil, err.Error(), height)
 		}
+		return app.ReturnQuery(value, "not found", height)
 	}
+	if !pager.validCursor() {
+		return app.ReturnQuery(nil, "Invalid cursor", height)
+	}
+	result.NextCursor = pager.nextCursor
 	returnValue, err := json.Marshal(result)
 	if err != nil {
-		return app.ReturnQuery(nil, err.Error(), app.state.db.Version())
+		return app.ReturnQuery(nil, err.Error(), height)
 	}
-	return app.ReturnQuery(returnValue, "success", app.state.db.Version())
+	return app.ReturnQuery(returnValue, "success", height)
 }
 
 func (app *DIDApplication) getServiceDetail(param string, height int64) types.ResponseQuery {
@@ -583,24 +635,24 @@ func (app *DIDApplication) getServiceDetail(param string, height int64) types.Re
 	var funcParam GetServiceDetailParam
 	err := json.Unmarshal([]byte(param), &funcParam)
 	if err != nil {
-		return app.ReturnQuery(nil, err.Error(), app.state.db.Version())
+		return app.ReturnQuery(nil, err.Error(), height)
 	}
 	key := "Service" + "|" + funcParam.ServiceID
-	_, value := app.state.db.GetVersioned(prefixKey([]byte(key)), height)
+	value := app.GetVersionedStateDB([]byte(key), height)
 	if value == nil {
 		value = []byte("{}")
-		return app.ReturnQuery(value, "not found", app.state.db.Version())
+		return app.ReturnQuery(value, "not found", height)
 	}
 	var service data.ServiceDetail
 	err = proto.Unmarshal(value, &service)
 	if err != nil {
-		return app.ReturnQuery(nil, err.Error(), app.state.db.Version())
+		return app.ReturnQuery(nil, err.Error(), height)
 	}
 	returnValue, err := json.Marshal(service)
 	if err != nil {
-		return app.ReturnQuery(nil, err.Error(), app.state.db.Version())
+		return app.ReturnQuery(nil, err.Error(), height)
 	}
-	return app.ReturnQuery(returnValue, "success", app.state.db.Version())
+	return app.ReturnQuery(returnValue, "success", height)
 }
 
 func (app *DIDApplication) updateNode(param string, nodeID string) types.ResponseDeliverTx {
@@ -626,8 +678,24 @@ func (app *DIDApplication) updateNode(param string, nodeID string) types.Respons
 	}
 	// update PublicKey
 	if funcParam.PublicKey != "" {
+		err = app.setNodePublicKey(nodeID, funcParam.PublicKey)
+		if err != nil {
+			return app.ReturnDeliverTxLog(code.MarshalError, err.Error(), "")
+		}
 		nodeDetail.PublicKey = funcParam.PublicKey
 	}
+	// update SignatureScheme, which only applies to RSA keys
+	if funcParam.SignatureScheme != "" {
+		if !isSignatureScheme[funcParam.SignatureScheme] {
+			return app.ReturnDeliverTxLog(code.InvalidSignatureScheme, "Invalid signature scheme", "")
+		}
+		if !isRSAPubKey(nodeDetail.PublicKey) {
+			return app.ReturnDeliverTxLog(code.InvalidSignatureScheme, "Signature scheme can only be set for a node with an RSA key", "")
+		}
+		nodeDetail.SignatureScheme = funcParam.SignatureScheme
+	} else if !isRSAPubKey(nodeDetail.PublicKey) {
+		nodeDetail.SignatureScheme = ""
+	}
 	nodeDetailValue, err := utils.ProtoDeterministicMarshal(&nodeDetail)
 	if err != nil {
 		return app.ReturnDeliverTxLog(code.MarshalError, err.Error(), "")
@@ -641,27 +709,17 @@ func (app *DIDApplication) checkExistingIdentity(param string, height int64) typ
 	var funcParam CheckExistingIdentityParam
 	err := json.Unmarshal([]byte(param), &funcParam)
 	if err != nil {
-		return app.ReturnQuery(nil, err.Error(), app.state.db.Version())
+		return app.ReturnQuery(nil, err.Error(), height)
 	}
 	var result CheckExistingIdentityResult
 	result.Exist = false
-	key := "MsqDestination" + "|" + funcParam.HashID
-	_, value := app.state.db.GetVersioned(prefixKey([]byte(key)), height)
-	if value == nil {
-		returnValue, err := json.Marshal(result)
-		if err != nil {
-			return app.ReturnQuery(nil, err.Error(), app.state.db.Version())
-		}
-		return app.ReturnQuery(returnValue, "success", app.state.db.Version())
-	}
-	var nodes data.MsqDesList
-	err = proto.Unmarshal([]byte(value), &nodes)
+	nodes, err := app.getVersionedMsqDestination(funcParam.HashID, height)
 	if err != nil {
-		return app.ReturnQuery(nil, err.Error(), app.state.db.Version())
+		return app.ReturnQuery(nil, err.Error(), height)
 	}
 	msqCount := 0
-	for _, node := range nodes.Nodes {
-		if node.TimeoutBlock == 0 || node.TimeoutBlock > app.CurrentBlock {
+	for _, node := range nodes {
+		if node.TimeoutBlock == 0 || node.TimeoutBlock > height {
 			msqCount++
 		}
 	}
@@ -670,9 +728,9 @@ func (app *DIDApplication) checkExistingIdentity(param string, height int64) typ
 	}
 	returnValue, err := json.Marshal(result)
 	if err != nil {
-		return app.ReturnQuery(nil, err.Error(), app.state.db.Version())
+		return app.ReturnQuery(nil, err.Error(), height)
 	}
-	return app.ReturnQuery(returnValue, "success", app.state.db.Version())
+	return app.ReturnQuery(returnValue, "success", height)
 }
 
 func (app *DIDApplication) getAccessorGroupID(param string, height int64) types.ResponseQuery {
@@ -680,15 +738,15 @@ func (app *DIDApplication) getAccessorGroupID(param string, height int64) types.
 	var funcParam GetAccessorGroupIDParam
 	err := json.Unmarshal([]byte(param), &funcParam)
 	if err != nil {
-		return app.ReturnQuery(nil, err.Error(), app.state.db.Version())
+		return app.ReturnQuery(nil, err.Error(), height)
 	}
 	var result GetAccessorGroupIDResult
 	result.AccessorGroupID = ""
 	key := "Accessor" + "|" + funcParam.AccessorID
-	_, value := app.state.db.GetVersioned(prefixKey([]byte(key)), height)
+	value := app.GetVersionedStateDB([]byte(key), height)
 	// If value == nil set log = "not found"
 	if value == nil {
-		return app.ReturnQuery([]byte("{}"), "not found", app.state.db.Version())
+		return app.ReturnQuery([]byte("{}"), "not found", height)
 	}
 	var accessor data.Accessor
 	err = proto.Unmarshal([]byte(value), &accessor)
@@ -697,9 +755,9 @@ func (app *DIDApplication) getAccessorGroupID(param string, height int64) types.
 	}
 	returnValue, err := json.Marshal(result)
 	if err != nil {
-		return app.ReturnQuery(nil, err.Error(), app.state.db.Version())
+		return app.ReturnQuery(nil, err.Error(), height)
 	}
-	return app.ReturnQuery(returnValue, "success", app.state.db.Version())
+	return app.ReturnQuery(returnValue, "success", height)
 }
 
 func (app *DIDApplication) getAccessorKey(param string, height int64) types.ResponseQuery {
@@ -707,15 +765,15 @@ func (app *DIDApplication) getAccessorKey(param string, height int64) types.Resp
 	var funcParam GetAccessorKeyParam
 	err := json.Unmarshal([]byte(param), &funcParam)
 	if err != nil {
-		return app.ReturnQuery(nil, err.Error(), app.state.db.Version())
+		return app.ReturnQuery(nil, err.Error(), height)
 	}
 	var result GetAccessorKeyResult
 	result.AccessorPublicKey = ""
 	key := "Accessor" + "|" + funcParam.AccessorID
-	_, value := app.state.db.GetVersioned(prefixKey([]byte(key)), height)
+	value := app.GetVersionedStateDB([]byte(key), height)
 	// If value == nil set log = "not found"
 	if value == nil {
-		return app.ReturnQuery([]byte("{}"), "not found", app.state.db.Version())
+		return app.ReturnQuery([]byte("{}"), "not found", height)
 	}
 	var accessor data.Accessor
 	err = proto.Unmarshal([]byte(value), &accessor)
@@ -725,40 +783,67 @@ func (app *DIDApplication) getAccessorKey(param string, height int64) types.Resp
 	}
 	returnValue, err := json.Marshal(result)
 	if err != nil {
-		return app.ReturnQuery(nil, err.Error(), app.state.db.Version())
+		return app.ReturnQuery(nil, err.Error(), height)
 	}
-	return app.ReturnQuery(returnValue, "success", app.state.db.Version())
+	return app.ReturnQuery(returnValue, "success", height)
 }
 
 func (app *DIDApplication) getServiceList(param string, height int64) types.ResponseQuery {
 	app.logger.Infof("GetServiceList, Parameter: %s", param)
-	key := "AllService"
-	_, value := app.state.db.GetVersioned(prefixKey([]byte(key)), height)
-	if value == nil {
-		result := make([]ServiceDetail, 0)
-		value, err := json.Marshal(result)
+	var funcParam GetListParam
+	if param != "" {
+		err := json.Unmarshal([]byte(param), &funcParam)
 		if err != nil {
-			return app.ReturnQuery(nil, err.Error(), app.state.db.Version())
+			return app.ReturnQuery(nil, err.Error(), height)
 		}
-		return app.ReturnQuery(value, "not found", app.state.db.Version())
 	}
-	result := make([]*data.ServiceDetail, 0)
+	var result GetServiceListResult
+	result.ServiceList = make([]*data.ServiceDetail, 0)
 	// filter flag==true
-	var services data.ServiceDetailList
-	err := proto.Unmarshal([]byte(value), &services)
-	if err != nil {
-		return app.ReturnQuery(nil, err.Error(), app.state.db.Version())
+	pager := newListPager(funcParam.Cursor, funcParam.Limit)
+	services := app.newVersionedListIterator(serviceListKey, funcParam.Cursor, height, pager.scanSize())
+	listed := false
+	for services.Next() {
+		listed = true
+		var service data.ServiceDetail
+		err := proto.Unmarshal(services.Value(), &service)
+		if err != nil {
+			return app.ReturnQuery(nil, err.Error(), height)
+		}
+		if pager.skip(service.ServiceId) {
+			continue
+		}
+		if !service.Active && !funcParam.IncludeInactive {
+			continue
+		}
+		if !strings.HasPrefix(service.ServiceName, funcParam.NamePrefix) {
+			continue
+		}
+		if pager.full() {
+			break
+		}
+		pager.add(service.ServiceId)
+		result.ServiceList = append(result.ServiceList, &service)
+	}
+	if services.Err() != nil {
+		return app.ReturnQuery(nil, services.Err().Error(), height)
 	}
-	for _, service := range services.Services {
-		if service.Active {
-			result = append(result, service)
+	if !listed && funcParam.Cursor == "" {
+		value, err := json.Marshal(result)
+		if err != nil {
+			return app.ReturnQuery(nil, err.Error(), height)
 		}
+		return app.ReturnQuery(value, "not found", height)
 	}
+	if !pager.validCursor() {
+		return app.ReturnQuery(nil, "Invalid cursor", height)
+	}
+	result.NextCursor = pager.nextCursor
 	returnValue, err := json.Marshal(result)
 	if err != nil {
-		return app.ReturnQuery(nil, err.Error(), app.state.db.Version())
+		return app.ReturnQuery(nil, err.Error(), height)
 	}
-	return app.ReturnQuery(returnValue, "success", app.state.db.Version())
+	return app.ReturnQuery(returnValue, "success", height)
 }
 
 func (app *DIDApplication) getServiceNameByServiceID(serviceID string) string {
@@ -780,30 +865,30 @@ func (app *DIDApplication) checkExistingAccessorID(param string, height int64) t
 	var funcParam CheckExistingAccessorIDParam
 	err := json.Unmarshal([]byte(param), &funcParam)
 	if err != nil {
-		return app.ReturnQuery(nil, err.Error(), app.state.db.Version())
+		return app.ReturnQuery(nil, err.Error(), height)
 	}
 	var result CheckExistingResult
 	result.Exist = false
 	accessorKey := "Accessor" + "|" + funcParam.AccessorID
-	_, accessorValue := app.state.db.GetVersioned(prefixKey([]byte(accessorKey)), height)
+	accessorValue := app.GetVersionedStateDB([]byte(accessorKey), height)
 	if accessorValue == nil {
 		returnValue, err := json.Marshal(result)
 		if err != nil {
-			return app.ReturnQuery(nil, err.Error(), app.state.db.Version())
+			return app.ReturnQuery(nil, err.Error(), height)
 		}
-		return app.ReturnQuery(returnValue, "success", app.state.db.Version())
+		return app.ReturnQuery(returnValue, "success", height)
 	}
 	var accessor data.Accessor
 	err = proto.Unmarshal([]byte(accessorValue), &accessor)
 	if err != nil {
-		return app.ReturnQuery(nil, err.Error(), app.state.db.Version())
+		return app.ReturnQuery(nil, err.Error(), height)
 	}
 	result.Exist = true
 	returnValue, err := json.Marshal(result)
 	if err != nil {
-		return app.ReturnQuery(nil, err.Error(), app.state.db.Version())
+		return app.ReturnQuery(nil, err.Error(), height)
 	}
-	return app.ReturnQuery(returnValue, "success", app.state.db.Version())
+	return app.ReturnQuery(returnValue, "success", height)
 }
 
 func (app *DIDApplication) checkExistingAccessorGroupID(param string, height int64) types.ResponseQuery {
@@ -811,25 +896,25 @@ func (app *DIDApplication) checkExistingAccessorGroupID(param string, height int
 	var funcParam CheckExistingAccessorGroupIDParam
 	err := json.Unmarshal([]byte(param), &funcParam)
 	if err != nil {
-		return app.ReturnQuery(nil, err.Error(), app.state.db.Version())
+		return app.ReturnQuery(nil, err.Error(), height)
 	}
 	var result CheckExistingResult
 	result.Exist = false
 	accessorGroupKey := "AccessorGroup" + "|" + funcParam.AccessorGroupID
-	_, accessorGroupValue := app.state.db.GetVersioned(prefixKey([]byte(accessorGroupKey)), height)
+	accessorGroupValue := app.GetVersionedStateDB([]byte(accessorGroupKey), height)
 	if accessorGroupValue == nil {
 		returnValue, err := json.Marshal(result)
 		if err != nil {
-			return app.ReturnQuery(nil, err.Error(), app.state.db.Version())
+			return app.ReturnQuery(nil, err.Error(), height)
 		}
-		return app.ReturnQuery(returnValue, "success", app.state.db.Version())
+		return app.ReturnQuery(returnValue, "success", height)
 	}
 	result.Exist = true
 	returnValue, err := json.Marshal(result)
 	if err != nil {
-		return app.ReturnQuery(nil, err.Error(), app.state.db.Version())
+		return app.ReturnQuery(nil, err.Error(), height)
 	}
-	return app.ReturnQuery(returnValue, "success", app.state.db.Version())
+	return app.ReturnQuery(returnValue, "success", height)
 }
 
 func (app *DIDApplication) getNodeInfo(param string, height int64) types.ResponseQuery {
@@ -837,42 +922,42 @@ func (app *DIDApplication) getNodeInfo(param string, height int64) types.Respons
 	var funcParam GetNodeInfoParam
 	err := json.Unmarshal([]byte(param), &funcParam)
 	if err != nil {
-		return app.ReturnQuery(nil, err.Error(), app.state.db.Version())
+		return app.ReturnQuery(nil, err.Error(), height)
 	}
 
 	nodeDetailKey := "NodeID" + "|" + funcParam.NodeID
-	_, nodeDetailValue := app.state.db.GetVersioned(prefixKey([]byte(nodeDetailKey)), height)
+	nodeDetailValue := app.GetVersionedStateDB([]byte(nodeDetailKey), height)
 	if nodeDetailValue == nil {
-		return app.ReturnQuery([]byte("{}"), "not found", app.state.db.Version())
+		return app.ReturnQuery([]byte("{}"), "not found", height)
 	}
 	var nodeDetail data.NodeDetail
 	err = proto.Unmarshal([]byte(nodeDetailValue), &nodeDetail)
 	if err != nil {
-		return app.ReturnQuery(nil, err.Error(), app.state.db.Version())
+		return app.ReturnQuery(nil, err.Error(), height)
 	}
 
 	// If node behind proxy
 	proxyKey := "Proxy" + "|" + funcParam.NodeID
-	_, proxyValue := app.state.db.Get(prefixKey([]byte(proxyKey)))
+	proxyValue := app.GetVersionedStateDB([]byte(proxyKey), height)
 	if proxyValue != nil {
 		// Get proxy node ID
 		var proxy data.Proxy
 		err = proto.Unmarshal([]byte(proxyValue), &proxy)
 		if err != nil {
-			return app.ReturnQuery(nil, err.Error(), app.state.db.Version())
+			return app.ReturnQuery(nil, err.Error(), height)
 		}
 		proxyNodeID := proxy.ProxyNodeId
 
 		// Get proxy node detail
 		proxyNodeDetailKey := "NodeID" + "|" + string(proxyNodeID)
-		_, proxyNodeDetailValue := app.state.db.GetVersioned(prefixKey([]byte(proxyNodeDetailKey)), height)
+		proxyNodeDetailValue := app.GetVersionedStateDB([]byte(proxyNodeDetailKey), height)
 		if proxyNodeDetailValue == nil {
-			return app.ReturnQuery([]byte("{}"), "not found", app.state.db.Version())
+			return app.ReturnQuery([]byte("{}"), "not found", height)
 		}
 		var proxyNode data.NodeDetail
 		err = proto.Unmarshal([]byte(proxyNodeDetailValue), &proxyNode)
 		if err != nil {
-			return app.ReturnQuery(nil, err.Error(), app.state.db.Version())
+			return app.ReturnQuery(nil, err.Error(), height)
 		}
 		if nodeDetail.Role == "IdP" {
 			var result GetNodeInfoResultIdPandASBehindProxy
@@ -897,9 +982,9 @@ func (app *DIDApplication) getNodeInfo(param string, height int64) types.Respons
 			result.Proxy.Config = proxy.Config
 			value, err := json.Marshal(result)
 			if err != nil {
-				return app.ReturnQuery(nil, err.Error(), app.state.db.Version())
+				return app.ReturnQuery(nil, err.Error(), height)
 			}
-			return app.ReturnQuery(value, "success", app.state.db.Version())
+			return app.ReturnQuery(value, "success", height)
 		}
 		var result GetNodeInfoResultRPandASBehindProxy
 		result.PublicKey = nodeDetail.PublicKey
@@ -921,9 +1006,9 @@ func (app *DIDApplication) getNodeInfo(param string, height int64) types.Respons
 		result.Proxy.Config = proxy.Config
 		value, err := json.Marshal(result)
 		if err != nil {
-			return app.ReturnQuery(nil, err.Error(), app.state.db.Version())
+			return app.ReturnQuery(nil, err.Error(), height)
 		}
-		return app.ReturnQuery(value, "success", app.state.db.Version())
+		return app.ReturnQuery(value, "success", height)
 	}
 	if nodeDetail.Role == "IdP" {
 		var result GetNodeInfoIdPResult
@@ -943,9 +1028,9 @@ func (app *DIDApplication) getNodeInfo(param string, height int64) types.Respons
 		}
 		value, err := json.Marshal(result)
 		if err != nil {
-			return app.ReturnQuery(nil, err.Error(), app.state.db.Version())
+			return app.ReturnQuery(nil, err.Error(), height)
 		}
-		return app.ReturnQuery(value, "success", app.state.db.Version())
+		return app.ReturnQuery(value, "success", height)
 	}
 	var result GetNodeInfoResult
 	result.PublicKey = nodeDetail.PublicKey
@@ -962,9 +1047,9 @@ func (app *DIDApplication) getNodeInfo(param string, height int64) types.Respons
 	}
 	value, err := json.Marshal(result)
 	if err != nil {
-		return app.ReturnQuery(nil, err.Error(), app.state.db.Version())
+		return app.ReturnQuery(nil, err.Error(), height)
 	}
-	return app.ReturnQuery(value, "success", app.state.db.Version())
+	return app.ReturnQuery(value, "success", height)
 }
 
 func (app *DIDApplication) getIdentityInfo(param string, height int64) types.ResponseQuery {
@@ -972,33 +1057,30 @@ func (app *DIDApplication) getIdentityInfo(param string, height int64) types.Res
 	var funcParam GetIdentityInfoParam
 	err := json.Unmarshal([]byte(param), &funcParam)
 	if err != nil {
-		return app.ReturnQuery(nil, err.Error(), app.state.db.Version())
+		return app.ReturnQuery(nil, err.Error(), height)
 	}
 	var result GetIdentityInfoResult
-	key := "MsqDestination" + "|" + funcParam.HashID
-	_, chkExists := app.state.db.GetVersioned(prefixKey([]byte(key)), height)
+	chkExists, err := app.getVersionedListItem(msqDestinationList(funcParam.HashID), funcParam.NodeID, height)
+	if err != nil {
+		return app.ReturnQuery(nil, err.Error(), height)
+	}
 	if chkExists == nil {
-		return app.ReturnQuery([]byte("{}"), "not found", app.state.db.Version())
+		return app.ReturnQuery([]byte("{}"), "not found", height)
 	}
-	var nodes data.MsqDesList
-	err = proto.Unmarshal([]byte(chkExists), &nodes)
+	var node data.Node
+	err = proto.Unmarshal([]byte(chkExists), &node)
 	if err != nil {
-		return app.ReturnQuery(nil, err.Error(), app.state.db.Version())
-	}
-	for _, node := range nodes.Nodes {
-		if node.NodeId == funcParam.NodeID {
-			result.Ial = float64(node.Ial)
-			break
-		}
+		return app.ReturnQuery(nil, err.Error(), height)
 	}
+	result.Ial = float64(node.Ial)
 	returnValue, err := json.Marshal(result)
 	if err != nil {
-		return app.ReturnQuery(nil, err.Error(), app.state.db.Version())
+		return app.ReturnQuery(nil, err.Error(), height)
 	}
 	if result.Ial <= 0.0 {
-		return app.ReturnQuery([]byte("{}"), "not found", app.state.db.Version())
+		return app.ReturnQuery([]byte("{}"), "not found", height)
 	}
-	return app.ReturnQuery(returnValue, "success", app.state.db.Version())
+	return app.ReturnQuery(returnValue, "success", height)
 }
 
 func (app *DIDApplication) getDataSignature(param string, height int64) types.ResponseQuery {
@@ -1006,17 +1088,17 @@ func (app *DIDApplication) getDataSignature(param string, height int64) types.Re
 	var funcParam GetDataSignatureParam
 	err := json.Unmarshal([]byte(param), &funcParam)
 	if err != nil {
-		return app.ReturnQuery(nil, err.Error(), app.state.db.Version())
+		return app.ReturnQuery(nil, err.Error(), height)
 	}
 	signDataKey := "SignData" + "|" + funcParam.NodeID + "|" + funcParam.ServiceID + "|" + funcParam.RequestID
-	_, signDataValue := app.state.db.GetVersioned(prefixKey([]byte(signDataKey)), height)
+	signDataValue := app.GetVersionedStateDB([]byte(signDataKey), height)
 	if signDataValue == nil {
-		return app.ReturnQuery([]byte("{}"), "not found", app.state.db.Version())
+		return app.ReturnQuery([]byte("{}"), "not found", height)
 	}
 	var result GetDataSignatureResult
 	result.Signature = string(signDataValue)
 	returnValue, err := json.Marshal(result)
-	return app.ReturnQuery(returnValue, "success", app.state.db.Version())
+	return app.ReturnQuery(returnValue, "success", height)
 }
 
 func (app *DIDApplication) getIdentityProof(param string, height int64) types.ResponseQuery {
@@ -1024,17 +1106,17 @@ func (app *DIDApplication) getIdentityProof(param string, height int64) types.Re
 	var funcParam GetIdentityProofParam
 	err := json.Unmarshal([]byte(param), &funcParam)
 	if err != nil {
-		return app.ReturnQuery(nil, err.Error(), app.state.db.Version())
+		return app.ReturnQuery(nil, err.Error(), height)
 	}
 	identityProofKey := "IdentityProof" + "|" + funcParam.RequestID + "|" + funcParam.IdpID
-	_, identityProofValue := app.state.db.GetVersioned(prefixKey([]byte(identityProofKey)), height)
+	identityProofValue := app.GetVersionedStateDB([]byte(identityProofKey), height)
 	if identityProofValue == nil {
-		return app.ReturnQuery([]byte("{}"), "not found", app.state.db.Version())
+		return app.ReturnQuery([]byte("{}"), "not found", height)
 	}
 	var result GetIdentityProofResult
 	result.IdentityProof = string(identityProofValue)
 	returnValue, err := json.Marshal(result)
-	return app.ReturnQuery(returnValue, "success", app.state.db.Version())
+	return app.ReturnQuery(returnValue, "success", height)
 }
 
 func (app *DIDApplication) getServicesByAsID(param string, height int64) types.ResponseQuery {
@@ -1042,53 +1124,53 @@ func (app *DIDApplication) getServicesByAsID(param string, height int64) types.R
 	var funcParam GetServicesByAsIDParam
 	err := json.Unmarshal([]byte(param), &funcParam)
 	if err != nil {
-		return app.ReturnQuery(nil, err.Error(), app.state.db.Version())
+		return app.ReturnQuery(nil, err.Error(), height)
 	}
 	var result GetServicesByAsIDResult
 	result.Services = make([]Service, 0)
 	provideServiceKey := "ProvideService" + "|" + funcParam.AsID
-	_, provideServiceValue := app.state.db.Get(prefixKey([]byte(provideServiceKey)))
+	provideServiceValue := app.GetVersionedStateDB([]byte(provideServiceKey), height)
 	if provideServiceValue == nil {
 		resultJSON, err := json.Marshal(result)
 		if err != nil {
-			return app.ReturnQuery(nil, err.Error(), app.state.db.Version())
+			return app.ReturnQuery(nil, err.Error(), height)
 		}
-		return app.ReturnQuery(resultJSON, "not found", app.state.db.Version())
+		return app.ReturnQuery(resultJSON, "not found", height)
 	}
 	var services data.ServiceList
 	err = proto.Unmarshal([]byte(provideServiceValue), &services)
 	if err != nil {
-		return app.ReturnQuery(nil, err.Error(), app.state.db.Version())
+		return app.ReturnQuery(nil, err.Error(), height)
 	}
 	nodeDetailKey := "NodeID" + "|" + funcParam.AsID
-	_, nodeDetailValue := app.state.db.Get(prefixKey([]byte(nodeDetailKey)))
+	nodeDetailValue := app.GetVersionedStateDB([]byte(nodeDetailKey), height)
 	if nodeDetailValue == nil {
 		resultJSON, err := json.Marshal(result)
 		if err != nil {
-			return app.ReturnQuery(nil, err.Error(), app.state.db.Version())
+			return app.ReturnQuery(nil, err.Error(), height)
 		}
-		return app.ReturnQuery(resultJSON, "not found", app.state.db.Version())
+		return app.ReturnQuery(resultJSON, "not found", height)
 	}
 	var nodeDetail data.NodeDetail
 	err = proto.Unmarshal([]byte(nodeDetailValue), &nodeDetail)
 	if err != nil {
-		return app.ReturnQuery(nil, err.Error(), app.state.db.Version())
+		return app.ReturnQuery(nil, err.Error(), height)
 	}
 	for index, provideService := range services.Services {
 		serviceKey := "Service" + "|" + provideService.ServiceId
-		_, serviceValue := app.state.db.Get(prefixKey([]byte(serviceKey)))
+		serviceValue := app.GetVersionedStateDB([]byte(serviceKey), height)
 		if serviceValue == nil {
 			continue
 		}
 		var service data.ServiceDetail
 		err = proto.Unmarshal([]byte(serviceValue), &service)
 		if err != nil {
-			return app.ReturnQuery(nil, err.Error(), app.state.db.Version())
+			return app.ReturnQuery(nil, err.Error(), height)
 		}
 		if nodeDetail.Active && service.Active {
 			// Set suspended from NDID
 			approveServiceKey := "ApproveKey" + "|" + provideService.ServiceId + "|" + funcParam.AsID
-			_, approveServiceJSON := app.state.db.Get(prefixKey([]byte(approveServiceKey)))
+			approveServiceJSON := app.GetVersionedStateDB([]byte(approveServiceKey), height)
 			if approveServiceJSON == nil {
 				continue
 			}
@@ -1108,12 +1190,12 @@ func (app *DIDApplication) getServicesByAsID(param string, height int64) types.R
 	}
 	resultJSON, err := json.Marshal(result)
 	if err != nil {
-		return app.ReturnQuery(nil, err.Error(), app.state.db.Version())
+		return app.ReturnQuery(nil, err.Error(), height)
 	}
 	if len(result.Services) == 0 {
-		return app.ReturnQuery(resultJSON, "not found", app.state.db.Version())
+		return app.ReturnQuery(resultJSON, "not found", height)
 	}
-	return app.ReturnQuery(resultJSON, "success", app.state.db.Version())
+	return app.ReturnQuery(resultJSON, "success", height)
 }
 
 func (app *DIDApplication) getIdpNodesInfo(param string, height int64) types.ResponseQuery {
@@ -1121,31 +1203,23 @@ func (app *DIDApplication) getIdpNodesInfo(param string, height int64) types.Res
 	var funcParam GetIdpNodesParam
 	err := json.Unmarshal([]byte(param), &funcParam)
 	if err != nil {
-		return app.ReturnQuery(nil, err.Error(), app.state.db.Version())
+		return app.ReturnQuery(nil, err.Error(), height)
 	}
 	var result GetIdpNodesInfoResult
 	result.Node = make([]interface{}, 0)
+	pager := newListPager(funcParam.Cursor, funcParam.Limit)
 	// Make mapping
 	mapNodeIDList := map[string]bool{}
 	for _, nodeID := range funcParam.NodeIDList {
 		mapNodeIDList[nodeID] = true
 	}
 	if funcParam.HashID == "" {
-		idpsKey := "IdPList"
-		_, idpsValue := app.state.db.GetVersioned(prefixKey([]byte(idpsKey)), height)
-		if idpsValue == nil {
-			value, err := json.Marshal(result)
-			if err != nil {
-				return app.ReturnQuery(nil, err.Error(), app.state.db.Version())
+		idps := app.newVersionedListIterator(idpListKey, funcParam.Cursor, height, pager.scanSize())
+		for idps.Next() {
+			idp := idps.Item()
+			if pager.skip(idp) {
+				continue
 			}
-			return app.ReturnQuery(value, "not found", app.state.db.Version())
-		}
-		var idpsList data.IdPList
-		err := proto.Unmarshal(idpsValue, &idpsList)
-		if err != nil {
-			return app.ReturnQuery(nil, err.Error(), app.state.db.Version())
-		}
-		for _, idp := range idpsList.NodeId {
 			// filter from node_id_list
 			if len(mapNodeIDList) > 0 {
 				if mapNodeIDList[idp] == false {
@@ -1153,7 +1227,7 @@ func (app *DIDApplication) getIdpNodesInfo(param string, height int64) types.Res
 				}
 			}
 			nodeDetailKey := "NodeID" + "|" + idp
-			_, nodeDetailValue := app.state.db.Get(prefixKey([]byte(nodeDetailKey)))
+			nodeDetailValue := app.GetVersionedStateDB([]byte(nodeDetailKey), height)
 			if nodeDetailValue == nil {
 				continue
 			}
@@ -1171,32 +1245,39 @@ func (app *DIDApplication) getIdpNodesInfo(param string, height int64) types.Res
 				nodeDetail.MaxAal >= funcParam.MinAal) {
 				continue
 			}
+			if !strings.HasPrefix(nodeDetail.NodeName, funcParam.NamePrefix) {
+				continue
+			}
 			// If node is behind proxy
 			proxyKey := "Proxy" + "|" + idp
-			_, proxyValue := app.state.db.Get(prefixKey([]byte(proxyKey)))
+			proxyValue := app.GetVersionedStateDB([]byte(proxyKey), height)
 			if proxyValue != nil {
 				// Get proxy node ID
 				var proxy data.Proxy
 				err = proto.Unmarshal([]byte(proxyValue), &proxy)
 				if err != nil {
-					return app.ReturnQuery(nil, err.Error(), app.state.db.Version())
+					return app.ReturnQuery(nil, err.Error(), height)
 				}
 				proxyNodeID := proxy.ProxyNodeId
 				// Get proxy node detail
 				proxyNodeDetailKey := "NodeID" + "|" + string(proxyNodeID)
-				_, proxyNodeDetailValue := app.state.db.GetVersioned(prefixKey([]byte(proxyNodeDetailKey)), height)
+				proxyNodeDetailValue := app.GetVersionedStateDB([]byte(proxyNodeDetailKey), height)
 				if proxyNodeDetailValue == nil {
-					return app.ReturnQuery([]byte("{}"), "not found", app.state.db.Version())
+					return app.ReturnQuery([]byte("{}"), "not found", height)
 				}
 				var proxyNode data.NodeDetail
 				err = proto.Unmarshal([]byte(proxyNodeDetailValue), &proxyNode)
 				if err != nil {
-					return app.ReturnQuery(nil, err.Error(), app.state.db.Version())
+					return app.ReturnQuery(nil, err.Error(), height)
 				}
 				// Check proxy node is active
 				if !proxyNode.Active {
 					continue
 				}
+				if pager.full() {
+					break
+				}
+				pager.add(idp)
 				var msqDesNode IdpNodeBehindProxy
 				msqDesNode.NodeID = idp
 				msqDesNode.Name = nodeDetail.NodeName
@@ -1216,6 +1297,10 @@ func (app *DIDApplication) getIdpNodesInfo(param string, height int64) types.Res
 				msqDesNode.Proxy.Config = proxy.Config
 				result.Node = append(result.Node, msqDesNode)
 			} else {
+				if pager.full() {
+					break
+				}
+				pager.add(idp)
 				var msq []MsqAddress
 				for _, mq := range nodeDetail.Mq {
 					var msqAddress MsqAddress
@@ -1234,22 +1319,20 @@ func (app *DIDApplication) getIdpNodesInfo(param string, height int64) types.Res
 				result.Node = append(result.Node, msqDesNode)
 			}
 		}
+		if idps.Err() != nil {
+			return app.ReturnQuery(nil, idps.Err().Error(), height)
+		}
 	} else {
-		key := "MsqDestination" + "|" + funcParam.HashID
-		_, value := app.state.db.GetVersioned(prefixKey([]byte(key)), height)
-		if value == nil {
-			value, err := json.Marshal(result)
+		nodes := app.newVersionedListIterator(msqDestinationList(funcParam.HashID), funcParam.Cursor, height, pager.scanSize())
+		for nodes.Next() {
+			var node data.Node
+			err = proto.Unmarshal(nodes.Value(), &node)
 			if err != nil {
-				return app.ReturnQuery(nil, err.Error(), app.state.db.Version())
+				return app.ReturnQuery(nil, err.Error(), height)
+			}
+			if pager.skip(node.NodeId) {
+				continue
 			}
-			return app.ReturnQuery(value, "not found", app.state.db.Version())
-		}
-		var nodes data.MsqDesList
-		err = proto.Unmarshal([]byte(value), &nodes)
-		if err != nil {
-			return app.ReturnQuery(nil, err.Error(), app.state.db.Version())
-		}
-		for _, node := range nodes.Nodes {
 			// filter from node_id_list
 			if len(mapNodeIDList) > 0 {
 				if mapNodeIDList[node.NodeId] == false {
@@ -1265,11 +1348,11 @@ func (app *DIDApplication) getIdpNodesInfo(param string, height int64) types.Res
 				continue
 			}
 			// check msq destination is not timed out
-			if node.TimeoutBlock != 0 && app.CurrentBlock > node.TimeoutBlock {
+			if node.TimeoutBlock != 0 && height > node.TimeoutBlock {
 				continue
 			}
 			nodeDetailKey := "NodeID" + "|" + node.NodeId
-			_, nodeDetailValue := app.state.db.Get(prefixKey([]byte(nodeDetailKey)))
+			nodeDetailValue := app.GetVersionedStateDB([]byte(nodeDetailKey), height)
 			if nodeDetailValue == nil {
 				continue
 			}
@@ -1287,32 +1370,39 @@ func (app *DIDApplication) getIdpNodesInfo(param string, height int64) types.Res
 				nodeDetail.MaxAal >= funcParam.MinAal) {
 				continue
 			}
+			if !strings.HasPrefix(nodeDetail.NodeName, funcParam.NamePrefix) {
+				continue
+			}
 			// If node is behind proxy
 			proxyKey := "Proxy" + "|" + node.NodeId
-			_, proxyValue := app.state.db.Get(prefixKey([]byte(proxyKey)))
+			proxyValue := app.GetVersionedStateDB([]byte(proxyKey), height)
 			if proxyValue != nil {
 				// Get proxy node ID
 				var proxy data.Proxy
 				err = proto.Unmarshal([]byte(proxyValue), &proxy)
 				if err != nil {
-					return app.ReturnQuery(nil, err.Error(), app.state.db.Version())
+					return app.ReturnQuery(nil, err.Error(), height)
 				}
 				proxyNodeID := proxy.ProxyNodeId
 				// Get proxy node detail
 				proxyNodeDetailKey := "NodeID" + "|" + string(proxyNodeID)
-				_, proxyNodeDetailValue := app.state.db.GetVersioned(prefixKey([]byte(proxyNodeDetailKey)), height)
+				proxyNodeDetailValue := app.GetVersionedStateDB([]byte(proxyNodeDetailKey), height)
 				if proxyNodeDetailValue == nil {
-					return app.ReturnQuery([]byte("{}"), "not found", app.state.db.Version())
+					return app.ReturnQuery([]byte("{}"), "not found", height)
 				}
 				var proxyNode data.NodeDetail
 				err = proto.Unmarshal([]byte(proxyNodeDetailValue), &proxyNode)
 				if err != nil {
-					return app.ReturnQuery(nil, err.Error(), app.state.db.Version())
+					return app.ReturnQuery(nil, err.Error(), height)
 				}
 				// Check proxy node is active
 				if !proxyNode.Active {
 					continue
 				}
+				if pager.full() {
+					break
+				}
+				pager.add(node.NodeId)
 				var msqDesNode IdpNodeBehindProxy
 				msqDesNode.NodeID = node.NodeId
 				msqDesNode.Name = nodeDetail.NodeName
@@ -1332,6 +1422,10 @@ func (app *DIDApplication) getIdpNodesInfo(param string, height int64) types.Res
 				msqDesNode.Proxy.Config = proxy.Config
 				result.Node = append(result.Node, msqDesNode)
 			} else {
+				if pager.full() {
+					break
+				}
+				pager.add(node.NodeId)
 				var msq []MsqAddress
 				for _, mq := range nodeDetail.Mq {
 					var msqAddress MsqAddress
@@ -1350,15 +1444,22 @@ func (app *DIDApplication) getIdpNodesInfo(param string, height int64) types.Res
 				result.Node = append(result.Node, msqDesNode)
 			}
 		}
+		if nodes.Err() != nil {
+			return app.ReturnQuery(nil, nodes.Err().Error(), height)
+		}
+	}
+	if !pager.validCursor() {
+		return app.ReturnQuery(nil, "Invalid cursor", height)
 	}
+	result.NextCursor = pager.nextCursor
 	value, err := json.Marshal(result)
 	if err != nil {
-		return app.ReturnQuery(nil, err.Error(), app.state.db.Version())
+		return app.ReturnQuery(nil, err.Error(), height)
 	}
 	if len(result.Node) == 0 {
-		return app.ReturnQuery(value, "not found", app.state.db.Version())
+		return app.ReturnQuery(value, "not found", height)
 	}
-	return app.ReturnQuery(value, "success", app.state.db.Version())
+	return app.ReturnQuery(value, "success", height)
 }
 
 func (app *DIDApplication) getAsNodesInfoByServiceId(param string, height int64) types.ResponseQuery {
@@ -1366,49 +1467,49 @@ func (app *DIDApplication) getAsNodesInfoByServiceId(param string, height int64)
 	var funcParam GetAsNodesByServiceIdParam
 	err := json.Unmarshal([]byte(param), &funcParam)
 	if err != nil {
-		return app.ReturnQuery(nil, err.Error(), app.state.db.Version())
+		return app.ReturnQuery(nil, err.Error(), height)
 	}
 	key := "ServiceDestination" + "|" + funcParam.ServiceID
-	_, value := app.state.db.GetVersioned(prefixKey([]byte(key)), height)
+	value := app.GetVersionedStateDB([]byte(key), height)
 	if value == nil {
 		var result GetAsNodesInfoByServiceIdResult
 		result.Node = make([]interface{}, 0)
 		value, err := json.Marshal(result)
 		if err != nil {
-			return app.ReturnQuery(nil, err.Error(), app.state.db.Version())
+			return app.ReturnQuery(nil, err.Error(), height)
 		}
-		return app.ReturnQuery(value, "not found", app.state.db.Version())
+		return app.ReturnQuery(value, "not found", height)
 	}
 	// filter serive is active
 	serviceKey := "Service" + "|" + funcParam.ServiceID
-	_, serviceValue := app.state.db.Get(prefixKey([]byte(serviceKey)))
+	serviceValue := app.GetVersionedStateDB([]byte(serviceKey), height)
 	if serviceValue == nil {
 		var result GetAsNodesByServiceIdResult
 		result.Node = make([]ASNode, 0)
 		value, err := json.Marshal(result)
 		if err != nil {
-			return app.ReturnQuery(nil, err.Error(), app.state.db.Version())
+			return app.ReturnQuery(nil, err.Error(), height)
 		}
-		return app.ReturnQuery(value, "not found", app.state.db.Version())
+		return app.ReturnQuery(value, "not found", height)
 	}
 	var service data.ServiceDetail
 	err = proto.Unmarshal([]byte(serviceValue), &service)
 	if err != nil {
-		return app.ReturnQuery(nil, err.Error(), app.state.db.Version())
+		return app.ReturnQuery(nil, err.Error(), height)
 	}
 	if service.Active == false {
 		var result GetAsNodesByServiceIdResult
 		result.Node = make([]ASNode, 0)
 		value, err := json.Marshal(result)
 		if err != nil {
-			return app.ReturnQuery(nil, err.Error(), app.state.db.Version())
+			return app.ReturnQuery(nil, err.Error(), height)
 		}
-		return app.ReturnQuery(value, "service is not active", app.state.db.Version())
+		return app.ReturnQuery(value, "service is not active", height)
 	}
 	var storedData data.ServiceDesList
 	err = proto.Unmarshal([]byte(value), &storedData)
 	if err != nil {
-		return app.ReturnQuery(nil, err.Error(), app.state.db.Version())
+		return app.ReturnQuery(nil, err.Error(), height)
 	}
 	// Make mapping
 	mapNodeIDList := map[string]bool{}
@@ -1430,7 +1531,7 @@ func (app *DIDApplication) getAsNodesInfoByServiceId(param string, height int64)
 		}
 		// Filter approve from NDID
 		approveServiceKey := "ApproveKey" + "|" + funcParam.ServiceID + "|" + storedData.Node[index].NodeId
-		_, approveServiceJSON := app.state.db.Get(prefixKey([]byte(approveServiceKey)))
+		approveServiceJSON := app.GetVersionedStateDB([]byte(approveServiceKey), height)
 		if approveServiceJSON == nil {
 			continue
 		}
@@ -1443,7 +1544,7 @@ func (app *DIDApplication) getAsNodesInfoByServiceId(param string, height int64)
 			continue
 		}
 		nodeDetailKey := "NodeID" + "|" + storedData.Node[index].NodeId
-		_, nodeDetailValue := app.state.db.Get(prefixKey([]byte(nodeDetailKey)))
+		nodeDetailValue := app.GetVersionedStateDB([]byte(nodeDetailKey), height)
 		if nodeDetailValue == nil {
 			continue
 		}
@@ -1458,25 +1559,25 @@ func (app *DIDApplication) getAsNodesInfoByServiceId(param string, height int64)
 		}
 		// If node is behind proxy
 		proxyKey := "Proxy" + "|" + storedData.Node[index].NodeId
-		_, proxyValue := app.state.db.Get(prefixKey([]byte(proxyKey)))
+		proxyValue := app.GetVersionedStateDB([]byte(proxyKey), height)
 		if proxyValue != nil {
 			// Get proxy node ID
 			var proxy data.Proxy
 			err = proto.Unmarshal([]byte(proxyValue), &proxy)
 			if err != nil {
-				return app.ReturnQuery(nil, err.Error(), app.state.db.Version())
+				return app.ReturnQuery(nil, err.Error(), height)
 			}
 			proxyNodeID := proxy.ProxyNodeId
 			// Get proxy node detail
 			proxyNodeDetailKey := "NodeID" + "|" + string(proxyNodeID)
-			_, proxyNodeDetailValue := app.state.db.GetVersioned(prefixKey([]byte(proxyNodeDetailKey)), height)
+			proxyNodeDetailValue := app.GetVersionedStateDB([]byte(proxyNodeDetailKey), height)
 			if proxyNodeDetailValue == nil {
-				return app.ReturnQuery([]byte("{}"), "not found", app.state.db.Version())
+				return app.ReturnQuery([]byte("{}"), "not found", height)
 			}
 			var proxyNode data.NodeDetail
 			err = proto.Unmarshal([]byte(proxyNodeDetailValue), &proxyNode)
 			if err != nil {
-				return app.ReturnQuery(nil, err.Error(), app.state.db.Version())
+				return app.ReturnQuery(nil, err.Error(), height)
 			}
 			// Check proxy node is active
 			if !proxyNode.Active {
@@ -1521,9 +1622,9 @@ func (app *DIDApplication) getAsNodesInfoByServiceId(param string, height int64)
 	}
 	resultJSON, err := json.Marshal(result)
 	if err != nil {
-		return app.ReturnQuery(nil, err.Error(), app.state.db.Version())
+		return app.ReturnQuery(nil, err.Error(), height)
 	}
-	return app.ReturnQuery(resultJSON, "success", app.state.db.Version())
+	return app.ReturnQuery(resultJSON, "success", height)
 }
 
 func (app *DIDApplication) getNodesBehindProxyNode(param string, height int64) types.ResponseQuery {
@@ -1531,28 +1632,28 @@ func (app *DIDApplication) getNodesBehindProxyNode(param string, height int64) t
 	var funcParam GetNodesBehindProxyNodeParam
 	err := json.Unmarshal([]byte(param), &funcParam)
 	if err != nil {
-		return app.ReturnQuery(nil, err.Error(), app.state.db.Version())
+		return app.ReturnQuery(nil, err.Error(), height)
 	}
 	var result GetNodesBehindProxyNodeResult
 	result.Nodes = make([]interface{}, 0)
 	behindProxyNodeKey := "BehindProxyNode" + "|" + funcParam.ProxyNodeID
-	_, behindProxyNodeValue := app.state.db.Get(prefixKey([]byte(behindProxyNodeKey)))
+	behindProxyNodeValue := app.GetVersionedStateDB([]byte(behindProxyNodeKey), height)
 	if behindProxyNodeValue == nil {
 		resultJSON, err := json.Marshal(result)
 		if err != nil {
-			return app.ReturnQuery(nil, err.Error(), app.state.db.Version())
+			return app.ReturnQuery(nil, err.Error(), height)
 		}
-		return app.ReturnQuery(resultJSON, "not found", app.state.db.Version())
+		return app.ReturnQuery(resultJSON, "not found", height)
 	}
 	var nodes data.BehindNodeList
 	nodes.Nodes = make([]string, 0)
 	err = proto.Unmarshal([]byte(behindProxyNodeValue), &nodes)
 	if err != nil {
-		return app.ReturnQuery(nil, err.Error(), app.state.db.Version())
+		return app.ReturnQuery(nil, err.Error(), height)
 	}
 	for _, node := range nodes.Nodes {
 		nodeDetailKey := "NodeID" + "|" + node
-		_, nodeDetailValue := app.state.db.Get(prefixKey([]byte(nodeDetailKey)))
+		nodeDetailValue := app.GetVersionedStateDB([]byte(nodeDetailKey), height)
 		if nodeDetailValue == nil {
 			continue
 		}
@@ -1564,7 +1665,7 @@ func (app *DIDApplication) getNodesBehindProxyNode(param string, height int64) t
 
 		// Get proxy detail
 		proxyKey := "Proxy" + "|" + node
-		_, proxyValue := app.state.db.Get(prefixKey([]byte(proxyKey)))
+		proxyValue := app.GetVersionedStateDB([]byte(proxyKey), height)
 		if proxyValue == nil {
 			continue
 		}
@@ -1599,12 +1700,12 @@ func (app *DIDApplication) getNodesBehindProxyNode(param string, height int64) t
 	}
 	resultJSON, err := json.Marshal(result)
 	if err != nil {
-		return app.ReturnQuery(nil, err.Error(), app.state.db.Version())
+		return app.ReturnQuery(nil, err.Error(), height)
 	}
 	if len(result.Nodes) == 0 {
-		return app.ReturnQuery(resultJSON, "not found", app.state.db.Version())
+		return app.ReturnQuery(resultJSON, "not found", height)
 	}
-	return app.ReturnQuery(resultJSON, "success", app.state.db.Version())
+	return app.ReturnQuery(resultJSON, "success", height)
 }
 
 func (app *DIDApplication) getNodeIDList(param string, height int64) types.ResponseQuery {
@@ -1612,115 +1713,68 @@ func (app *DIDApplication) getNodeIDList(param string, height int64) types.Respo
 	var funcParam GetNodeIDListParam
 	err := json.Unmarshal([]byte(param), &funcParam)
 	if err != nil {
-		return app.ReturnQuery(nil, err.Error(), app.state.db.Version())
+		return app.ReturnQuery(nil, err.Error(), height)
 	}
 	var result GetNodeIDListResult
 	result.NodeIDList = make([]string, 0)
-	if strings.ToLower(funcParam.Role) == "rp" {
-		var rpsList data.RPList
-		rpsKey := "rpList"
-		_, rpsValue := app.state.db.Get(prefixKey([]byte(rpsKey)))
-		if rpsValue != nil {
-			err := proto.Unmarshal(rpsValue, &rpsList)
-			if err != nil {
-				return app.ReturnQuery(nil, err.Error(), app.state.db.Version())
-			}
-			for _, nodeID := range rpsList.NodeId {
-				nodeDetailKey := "NodeID" + "|" + nodeID
-				_, nodeDetailValue := app.state.db.Get(prefixKey([]byte(nodeDetailKey)))
-				if nodeDetailValue != nil {
-					var nodeDetail data.NodeDetail
-					err := proto.Unmarshal([]byte(nodeDetailValue), &nodeDetail)
-					if err != nil {
-						continue
-					}
-					if nodeDetail.Active {
-						result.NodeIDList = append(result.NodeIDList, nodeID)
-					}
-				}
-			}
+	list := allListKey
+	switch strings.ToLower(funcParam.Role) {
+	case "rp":
+		list = rpListKey
+	case "idp":
+		list = idpListKey
+	case "as":
+		list = asListKey
+	}
+	pager := newListPager(funcParam.Cursor, funcParam.Limit)
+	nodeIDs := app.newVersionedListIterator(list, funcParam.Cursor, height, pager.scanSize())
+	for nodeIDs.Next() {
+		nodeID := nodeIDs.Item()
+		if pager.skip(nodeID) {
+			continue
 		}
-	} else if strings.ToLower(funcParam.Role) == "idp" {
-		var idpsList data.IdPList
-		idpsKey := "IdPList"
-		_, idpsValue := app.state.db.Get(prefixKey([]byte(idpsKey)))
-		if idpsValue != nil {
-			err := proto.Unmarshal(idpsValue, &idpsList)
-			if err != nil {
-				return app.ReturnQuery(nil, err.Error(), app.state.db.Version())
-			}
-			for _, nodeID := range idpsList.NodeId {
-				nodeDetailKey := "NodeID" + "|" + nodeID
-				_, nodeDetailValue := app.state.db.Get(prefixKey([]byte(nodeDetailKey)))
-				if nodeDetailValue != nil {
-					var nodeDetail data.NodeDetail
-					err := proto.Unmarshal([]byte(nodeDetailValue), &nodeDetail)
-					if err != nil {
-						continue
-					}
-					if nodeDetail.Active {
-						result.NodeIDList = append(result.NodeIDList, nodeID)
-					}
-				}
-			}
+		nodeDetailKey := "NodeID" + "|" + nodeID
+		nodeDetailValue := app.GetVersionedStateDB([]byte(nodeDetailKey), height)
+		if nodeDetailValue == nil {
+			continue
 		}
-	} else if strings.ToLower(funcParam.Role) == "as" {
-		var asList data.ASList
-		asKey := "asList"
-		_, asValue := app.state.db.Get(prefixKey([]byte(asKey)))
-		if asValue != nil {
-			err := proto.Unmarshal(asValue, &asList)
-			if err != nil {
-				return app.ReturnQuery(nil, err.Error(), app.state.db.Version())
-			}
-			for _, nodeID := range asList.NodeId {
-				nodeDetailKey := "NodeID" + "|" + nodeID
-				_, nodeDetailValue := app.state.db.Get(prefixKey([]byte(nodeDetailKey)))
-				if nodeDetailValue != nil {
-					var nodeDetail data.NodeDetail
-					err := proto.Unmarshal([]byte(nodeDetailValue), &nodeDetail)
-					if err != nil {
-						continue
-					}
-					if nodeDetail.Active {
-						result.NodeIDList = append(result.NodeIDList, nodeID)
-					}
-				}
-			}
+		var nodeDetail data.NodeDetail
+		err := proto.Unmarshal([]byte(nodeDetailValue), &nodeDetail)
+		if err != nil {
+			continue
 		}
-	} else {
-		var allList data.AllList
-		allKey := "allList"
-		_, allValue := app.state.db.Get(prefixKey([]byte(allKey)))
-		if allValue != nil {
-			err := proto.Unmarshal(allValue, &allList)
-			if err != nil {
-				return app.ReturnQuery(nil, err.Error(), app.state.db.Version())
-			}
-			for _, nodeID := range allList.NodeId {
-				nodeDetailKey := "NodeID" + "|" + nodeID
-				_, nodeDetailValue := app.state.db.Get(prefixKey([]byte(nodeDetailKey)))
-				if nodeDetailValue != nil {
-					var nodeDetail data.NodeDetail
-					err := proto.Unmarshal([]byte(nodeDetailValue), &nodeDetail)
-					if err != nil {
-						continue
-					}
-					if nodeDetail.Active {
-						result.NodeIDList = append(result.NodeIDList, nodeID)
-					}
-				}
-			}
+		if !nodeDetail.Active && !funcParam.IncludeInactive {
+			continue
+		}
+		// check Max IAL && AAL
+		if !(nodeDetail.MaxIal >= funcParam.MinIal &&
+			nodeDetail.MaxAal >= funcParam.MinAal) {
+			continue
+		}
+		if !strings.HasPrefix(nodeDetail.NodeName, funcParam.NamePrefix) {
+			continue
+		}
+		if pager.full() {
+			break
 		}
+		pager.add(nodeID)
+		result.NodeIDList = append(result.NodeIDList, nodeID)
+	}
+	if nodeIDs.Err() != nil {
+		return app.ReturnQuery(nil, nodeIDs.Err().Error(), height)
 	}
+	if !pager.validCursor() {
+		return app.ReturnQuery(nil, "Invalid cursor", height)
+	}
+	result.NextCursor = pager.nextCursor
 	resultJSON, err := json.Marshal(result)
 	if err != nil {
-		return app.ReturnQuery(nil, err.Error(), app.state.db.Version())
+		return app.ReturnQuery(nil, err.Error(), height)
 	}
 	if len(result.NodeIDList) == 0 {
-		return app.ReturnQuery(resultJSON, "not found", app.state.db.Version())
+		return app.ReturnQuery(resultJSON, "not found", height)
 	}
-	return app.ReturnQuery(resultJSON, "success", app.state.db.Version())
+	return app.ReturnQuery(resultJSON, "success", height)
 }
 
 func (app *DIDApplication) getAccessorsInAccessorGroup(param string, height int64) types.ResponseQuery {
@@ -1728,49 +1782,55 @@ func (app *DIDApplication) getAccessorsInAccessorGroup(param string, height int6
 	var funcParam GetAccessorsInAccessorGroupParam
 	err := json.Unmarshal([]byte(param), &funcParam)
 	if err != nil {
-		return app.ReturnQuery(nil, err.Error(), app.state.db.Version())
+		return app.ReturnQuery(nil, err.Error(), height)
 	}
 	var result GetAccessorsInAccessorGroupResult
 	result.AccessorList = make([]string, 0)
 	if funcParam.AccessorGroupID == "" {
 		returnValue, err := json.Marshal(result)
 		if err != nil {
-			return app.ReturnQuery(nil, err.Error(), app.state.db.Version())
+			return app.ReturnQuery(nil, err.Error(), height)
 		}
-		return app.ReturnQuery(returnValue, "not found", app.state.db.Version())
-	}
-	accessorInGroupKey := "AccessorInGroup" + "|" + funcParam.AccessorGroupID
-	_, accessorInGroupKeyValue := app.state.db.GetVersioned(prefixKey([]byte(accessorInGroupKey)), height)
-	var accessors data.AccessorInGroup
-	err = proto.Unmarshal(accessorInGroupKeyValue, &accessors)
-	if err != nil {
-		return app.ReturnQuery(nil, err.Error(), app.state.db.Version())
+		return app.ReturnQuery(returnValue, "not found", height)
 	}
-	// If IdpID == "", return all accessors in group
-	if funcParam.IdpID == "" {
-		for _, accessor := range accessors.Accessors {
-			result.AccessorList = append(result.AccessorList, accessor)
+	pager := newListPager(funcParam.Cursor, funcParam.Limit)
+	accessors := app.newVersionedListIterator(accessorInGroupList(funcParam.AccessorGroupID), funcParam.Cursor, height, pager.scanSize())
+	for accessors.Next() {
+		accessor := accessors.Item()
+		if pager.skip(accessor) {
+			continue
 		}
-	} else {
-		// filter by owner of accessor
-		for _, accessor := range accessors.Accessors {
+		// If IdpID == "", return all accessors in group, otherwise filter by owner of accessor
+		if funcParam.IdpID != "" {
 			accessorKey := "Accessor" + "|" + accessor
-			_, accessorValue := app.state.db.GetVersioned(prefixKey([]byte(accessorKey)), height)
+			accessorValue := app.GetVersionedStateDB([]byte(accessorKey), height)
 			var accessorObj data.Accessor
 			err := proto.Unmarshal(accessorValue, &accessorObj)
 			if err != nil {
-				return app.ReturnQuery(nil, err.Error(), app.state.db.Version())
+				return app.ReturnQuery(nil, err.Error(), height)
 			}
-			if accessorObj.Owner == funcParam.IdpID {
-				result.AccessorList = append(result.AccessorList, accessor)
+			if accessorObj.Owner != funcParam.IdpID {
+				continue
 			}
 		}
+		if pager.full() {
+			break
+		}
+		pager.add(accessor)
+		result.AccessorList = append(result.AccessorList, accessor)
+	}
+	if accessors.Err() != nil {
+		return app.ReturnQuery(nil, accessors.Err().Error(), height)
+	}
+	if !pager.validCursor() {
+		return app.ReturnQuery(nil, "Invalid cursor", height)
 	}
+	result.NextCursor = pager.nextCursor
 	returnValue, err := json.Marshal(result)
 	if len(result.AccessorList) > 0 {
-		return app.ReturnQuery(returnValue, "success", app.state.db.Version())
+		return app.ReturnQuery(returnValue, "success", height)
 	}
-	return app.ReturnQuery(returnValue, "not found", app.state.db.Version())
+	return app.ReturnQuery(returnValue, "not found", height)
 }
 
 func (app *DIDApplication) getAccessorOwner(param string, height int64) types.ResponseQuery {
@@ -1778,15 +1838,15 @@ func (app *DIDApplication) getAccessorOwner(param string, height int64) types.Re
 	var funcParam GetAccessorOwnerParam
 	err := json.Unmarshal([]byte(param), &funcParam)
 	if err != nil {
-		return app.ReturnQuery(nil, err.Error(), app.state.db.Version())
+		return app.ReturnQuery(nil, err.Error(), height)
 	}
 	var result GetAccessorOwnerResult
 	result.NodeID = ""
 	key := "Accessor" + "|" + funcParam.AccessorID
-	_, value := app.state.db.GetVersioned(prefixKey([]byte(key)), height)
+	value := app.GetVersionedStateDB([]byte(key), height)
 	// If value == nil set log = "not found"
 	if value == nil {
-		return app.ReturnQuery([]byte("{}"), "not found", app.state.db.Version())
+		return app.ReturnQuery([]byte("{}"), "not found", height)
 	}
 	var accessor data.Accessor
 	err = proto.Unmarshal([]byte(value), &accessor)
@@ -1795,9 +1855,9 @@ func (app *DIDApplication) getAccessorOwner(param string, height int64) types.Re
 	}
 	returnValue, err := json.Marshal(result)
 	if err != nil {
-		return app.ReturnQuery(nil, err.Error(), app.state.db.Version())
+		return app.ReturnQuery(nil, err.Error(), height)
 	}
-	return app.ReturnQuery(returnValue, "success", app.state.db.Version())
+	return app.ReturnQuery(returnValue, "success", height)
 }
 
 func (app *DIDApplication) isInitEnded(param string, height int64) types.ResponseQuery {
@@ -1805,13 +1865,13 @@ func (app *DIDApplication) isInitEnded(param string, height int64) types.Respons
 	var result IsInitEndedResult
 	result.InitEnded = false
 	initStateKey := "InitState"
-	_, value := app.state.db.Get(prefixKey([]byte(initStateKey)))
+	value := app.GetVersionedStateDB([]byte(initStateKey), height)
 	if string(value) == "false" {
 		result.InitEnded = true
 	}
 	returnValue, err := json.Marshal(result)
 	if err != nil {
-		return app.ReturnQuery(nil, err.Error(), app.state.db.Version())
+		return app.ReturnQuery(nil, err.Error(), height)
 	}
-	return app.ReturnQuery(returnValue, "success", app.state.db.Version())
+	return app.ReturnQuery(returnValue, "success", height)
 }
@@ -22,6 +22,8 @@
 
 package did
 
+import "github.com/ndidplatform/smart-contract/protos/data"
+
 type NodePublicKey struct {
 	NodeID    string `json:"node_id"`
 	PublicKey string `json:"public_key"`
@@ -44,13 +46,13 @@ type GetNodeMasterPublicKeyResult struct {
 }
 
 type User struct {
-	HashID string  `json:"hash_id"`
+	HashID string  `json:"hash_id" validate:"required"`
 	Ial    float64 `json:"ial"`
 	First  bool    `json:"first"`
 }
 
 type RegisterIdentityParam struct {
-	Users []User `json:"users"`
+	Users []User `json:"users" validate:"required"`
 }
 
 type Node struct {
@@ -70,6 +72,9 @@ type GetIdpNodesParam struct {
 	MinIal     float64  `json:"min_ial"`
 	MinAal     float64  `json:"min_aal"`
 	NodeIDList []string `json:"node_id_list"`
+	NamePrefix string   `json:"name_prefix"`
+	Cursor     string   `json:"cursor"`
+	Limit      int      `json:"limit"`
 }
 
 type MsqDestinationNode struct {
@@ -80,7 +85,8 @@ type MsqDestinationNode struct {
 }
 
 type GetIdpNodesResult struct {
-	Node []MsqDestinationNode `json:"node"`
+	Node       []MsqDestinationNode `json:"node"`
+	NextCursor string               `json:"next_cursor,omitempty"`
 }
 
 type GetAccessorMethodParam struct {
@@ -94,7 +100,7 @@ type GetAccessorMethodResult struct {
 }
 
 type DataRequest struct {
-	ServiceID            string   `json:"service_id"`
+	ServiceID            string   `json:"service_id" validate:"required"`
 	As                   []string `json:"as_id_list"`
 	Count                int      `json:"min_as"`
 	RequestParamsHash    string   `json:"request_params_hash"`
@@ -103,16 +109,17 @@ type DataRequest struct {
 }
 
 type Request struct {
-	RequestID       string        `json:"request_id"`
+	RequestID       string        `json:"request_id" validate:"required"`
 	MinIdp          int           `json:"min_idp"`
 	MinAal          float64       `json:"min_aal"`
 	MinIal          float64       `json:"min_ial"`
 	Timeout         int           `json:"request_timeout"`
 	IdPIDList       []string      `json:"idp_id_list"`
 	DataRequestList []DataRequest `json:"data_request_list"`
-	MessageHash     string        `json:"request_message_hash"`
+	MessageHash     string        `json:"request_message_hash" validate:"required"`
 	Purpose         string        `json:"purpose"`
 	Mode            int           `json:"mode"`
+	TimeoutBlock    int64         `json:"request_timeout_block"`
 }
 
 type Response struct {
@@ -129,10 +136,10 @@ type Response struct {
 }
 
 type CreateIdpResponseParam struct {
-	RequestID        string  `json:"request_id"`
+	RequestID        string  `json:"request_id" validate:"required"`
 	Ial              float64 `json:"ial"`
 	Aal              float64 `json:"aal"`
-	Status           string  `json:"status"`
+	Status           string  `json:"status" validate:"required,oneof=accept reject"`
 	Signature        string  `json:"signature"`
 	IdentityProof    string  `json:"identity_proof"`
 	PrivateProofHash string  `json:"private_proof_hash"`
@@ -166,27 +173,28 @@ type GetRequestDetailResult struct {
 	RequesterNodeID     string        `json:"requester_node_id"`
 	CreationBlockHeight int64         `json:"creation_block_height"`
 	CreationChainID     string        `json:"creation_chain_id"`
+	ExpireBlockHeight   int64         `json:"expire_block_height"`
 }
 
 type SignDataParam struct {
-	ServiceID string `json:"service_id"`
-	RequestID string `json:"request_id"`
-	Signature string `json:"signature"`
+	ServiceID string `json:"service_id" validate:"required"`
+	RequestID string `json:"request_id" validate:"required"`
+	Signature string `json:"signature" validate:"required"`
 }
 
 type AddServiceParam struct {
-	ServiceID         string `json:"service_id"`
-	ServiceName       string `json:"service_name"`
+	ServiceID         string `json:"service_id" validate:"required"`
+	ServiceName       string `json:"service_name" validate:"required"`
 	DataSchema        string `json:"data_schema"`
 	DataSchemaVersion string `json:"data_schema_version"`
 }
 
 type DisableServiceParam struct {
-	ServiceID string `json:"service_id"`
+	ServiceID string `json:"service_id" validate:"required"`
 }
 
 type RegisterServiceDestinationParam struct {
-	ServiceID string  `json:"service_id"`
+	ServiceID string  `json:"service_id" validate:"required"`
 	MinIal    float64 `json:"min_ial"`
 	MinAal    float64 `json:"min_aal"`
 }
@@ -225,9 +233,9 @@ type GetAsNodesByServiceIdWithNameResult struct {
 }
 
 type InitNDIDParam struct {
-	NodeID          string `json:"node_id"`
-	PublicKey       string `json:"public_key"`
-	MasterPublicKey string `json:"master_public_key"`
+	NodeID          string `json:"node_id" validate:"required"`
+	PublicKey       string `json:"public_key" validate:"required"`
+	MasterPublicKey string `json:"master_public_key" validate:"required"`
 }
 
 type TransferNDIDParam struct {
@@ -235,11 +243,11 @@ type TransferNDIDParam struct {
 }
 
 type RegisterNode struct {
-	NodeID          string  `json:"node_id"`
-	PublicKey       string  `json:"public_key"`
+	NodeID          string  `json:"node_id" validate:"required"`
+	PublicKey       string  `json:"public_key" validate:"required"`
 	MasterPublicKey string  `json:"master_public_key"`
 	NodeName        string  `json:"node_name"`
-	Role            string  `json:"role"`
+	Role            string  `json:"role" validate:"required"`
 	MaxIal          float64 `json:"max_ial"`
 	MaxAal          float64 `json:"max_aal"`
 }
@@ -258,7 +266,7 @@ type MaxIalAal struct {
 }
 
 type SetMqAddressesParam struct {
-	Addresses []MsqAddress `json:"addresses"`
+	Addresses []MsqAddress `json:"addresses" validate:"required"`
 }
 
 type GetMqAddressesParam struct {
@@ -266,23 +274,23 @@ type GetMqAddressesParam struct {
 }
 
 type MsqAddress struct {
-	IP   string `json:"ip"`
+	IP   string `json:"ip" validate:"required"`
 	Port int64  `json:"port"`
 }
 
 type SetNodeTokenParam struct {
-	NodeID string  `json:"node_id"`
-	Amount float64 `json:"amount"`
+	NodeID string      `json:"node_id" validate:"required"`
+	Amount TokenAmount `json:"amount"`
 }
 
 type AddNodeTokenParam struct {
-	NodeID string  `json:"node_id"`
-	Amount float64 `json:"amount"`
+	NodeID string      `json:"node_id" validate:"required"`
+	Amount TokenAmount `json:"amount"`
 }
 
 type ReduceNodeTokenParam struct {
-	NodeID string  `json:"node_id"`
-	Amount float64 `json:"amount"`
+	NodeID string      `json:"node_id" validate:"required"`
+	Amount TokenAmount `json:"amount"`
 }
 
 type GetNodeTokenParam struct {
@@ -290,12 +298,12 @@ type GetNodeTokenParam struct {
 }
 
 type GetNodeTokenResult struct {
-	Amount float64 `json:"amount"`
+	Amount TokenAmount `json:"amount"`
 }
 
 type SetPriceFuncParam struct {
-	Func  string  `json:"func"`
-	Price float64 `json:"price"`
+	Func  string      `json:"func" validate:"required"`
+	Price TokenAmount `json:"price"`
 }
 
 type GetPriceFuncParam struct {
@@ -303,7 +311,107 @@ type GetPriceFuncParam struct {
 }
 
 type GetPriceFuncResult struct {
-	Price float64 `json:"price"`
+	Price TokenAmount `json:"price"`
+}
+
+type ServicePrice struct {
+	ServiceID string      `json:"service_id" validate:"required"`
+	Price     TokenAmount `json:"price"`
+}
+
+type SetPriceRuleParam struct {
+	Func             string         `json:"func" validate:"required"`
+	PerIdPPrice      TokenAmount    `json:"per_idp_price"`
+	PerASPrice       TokenAmount    `json:"per_as_price"`
+	ServicePriceList []ServicePrice `json:"service_price_list"`
+}
+
+type GetPriceRuleResult struct {
+	Price            TokenAmount    `json:"price"`
+	PerIdPPrice      TokenAmount    `json:"per_idp_price"`
+	PerASPrice       TokenAmount    `json:"per_as_price"`
+	ServicePriceList []ServicePrice `json:"service_price_list"`
+}
+
+type GetTxCostParam struct {
+	Method string `json:"method"`
+	Params string `json:"params"`
+	NodeID string `json:"node_id"`
+}
+
+type GetTxCostResult struct {
+	Cost TokenAmount `json:"cost"`
+}
+
+type SetRevenueShareRuleParam struct {
+	IdPPercent int64 `json:"idp_percent"`
+	ASPercent  int64 `json:"as_percent"`
+}
+
+type GetRevenueShareRuleResult struct {
+	IdPPercent int64 `json:"idp_percent"`
+	ASPercent  int64 `json:"as_percent"`
+}
+
+type GetEarningsParam struct {
+	NodeID     string `json:"node_id"`
+	FromHeight int64  `json:"from_height"`
+	ToHeight   int64  `json:"to_height"`
+}
+
+type GetEarningsResult struct {
+	Amount      TokenAmount `json:"amount"`
+	PayoutCount int64       `json:"payout_count"`
+}
+
+type SetNodeTokenLimitParam struct {
+	NodeID              string       `json:"node_id" validate:"required"`
+	CreditLimit         TokenAmount  `json:"credit_limit"`
+	LowBalanceThreshold *TokenAmount `json:"low_balance_threshold"`
+}
+
+type GetNodeTokenLimitResult struct {
+	CreditLimit         TokenAmount  `json:"credit_limit"`
+	LowBalanceThreshold *TokenAmount `json:"low_balance_threshold"`
+}
+
+type GetTokenLedgerParam struct {
+	NodeID     string `json:"node_id"`
+	FromHeight int64  `json:"from_height"`
+	ToHeight   int64  `json:"to_height"`
+	Cursor     string `json:"cursor"`
+	Limit      int    `json:"limit"`
+}
+
+type TokenLedgerEntry struct {
+	Height    int64       `json:"height"`
+	TxIndex   int64       `json:"tx_index"`
+	Method    string      `json:"method"`
+	Amount    TokenAmount `json:"amount"`
+	RequestID string      `json:"request_id"`
+}
+
+type GetTokenLedgerResult struct {
+	Entries    []TokenLedgerEntry `json:"entries"`
+	NextCursor string             `json:"next_cursor"`
+}
+
+type TransferTokenParam struct {
+	ToNodeID string      `json:"to_node_id" validate:"required"`
+	Amount   TokenAmount `json:"amount"`
+}
+
+type SetTokenTransferWhitelistParam struct {
+	NodeID     string   `json:"node_id" validate:"required"`
+	NodeIDList []string `json:"node_id_list"`
+}
+
+type GetTokenTransferWhitelistParam struct {
+	NodeID string `json:"node_id"`
+}
+
+type GetTokenTransferWhitelistResult struct {
+	NodeIDList []string `json:"node_id_list"`
 }
 
 type Report struct {
@@ -321,25 +429,26 @@ type RequestIDParam struct {
 }
 
 type Namespace struct {
-	Namespace   string `json:"namespace"`
+	Namespace   string `json:"namespace" validate:"required"`
 	Description string `json:"description"`
 	Active      bool   `json:"active"`
 }
 
 type DisableNamespaceParam struct {
-	Namespace string `json:"namespace"`
+	Namespace string `json:"namespace" validate:"required"`
 }
 
 type UpdateNodeParam struct {
 	PublicKey       string `json:"public_key"`
 	MasterPublicKey string `json:"master_public_key"`
+	SignatureScheme string `json:"signature_scheme"`
 }
 
 type RegisterAccessorParam struct {
-	AccessorID        string `json:"accessor_id"`
-	AccessorType      string `json:"accessor_type"`
-	AccessorPublicKey string `json:"accessor_public_key"`
-	AccessorGroupID   string `json:"accessor_group_id"`
+	AccessorID        string `json:"accessor_id" validate:"required"`
+	AccessorType      string `json:"accessor_type" validate:"required"`
+	AccessorPublicKey string `json:"accessor_public_key" validate:"required"`
+	AccessorGroupID   string `json:"accessor_group_id" validate:"required"`
 }
 
 type Accessor struct {
@@ -351,11 +460,11 @@ type Accessor struct {
 }
 
 type AccessorMethod struct {
-	AccessorID        string `json:"accessor_id"`
-	AccessorType      string `json:"accessor_type"`
-	AccessorPublicKey string `json:"accessor_public_key"`
-	AccessorGroupID   string `json:"accessor_group_id"`
-	RequestID         string `json:"request_id"`
+	AccessorID        string `json:"accessor_id" validate:"required"`
+	AccessorType      string `json:"accessor_type" validate:"required"`
+	AccessorPublicKey string `json:"accessor_public_key" validate:"required"`
+	AccessorGroupID   string `json:"accessor_group_id" validate:"required"`
+	RequestID         string `json:"request_id" validate:"required"`
 }
 
 type CheckExistingIdentityParam struct {
@@ -384,14 +493,14 @@ type GetAccessorKeyResult struct {
 }
 
 type SetValidatorParam struct {
-	PublicKey string `json:"public_key"`
+	PublicKey string `json:"public_key" validate:"required"`
 	Power     int64  `json:"power"`
 }
 
 type SetDataReceivedParam struct {
-	RequestID string `json:"request_id"`
-	ServiceID string `json:"service_id"`
-	AsID      string `json:"as_id"`
+	RequestID string `json:"request_id" validate:"required"`
+	ServiceID string `json:"service_id" validate:"required"`
+	AsID      string `json:"as_id" validate:"required"`
 }
 
 type ServiceDetail struct {
@@ -446,29 +555,29 @@ type GetIdentityInfoResult struct {
 }
 
 type UpdateNodeByNDIDParam struct {
-	NodeID   string  `json:"node_id"`
+	NodeID   string  `json:"node_id" validate:"required"`
 	MaxIal   float64 `json:"max_ial"`
 	MaxAal   float64 `json:"max_aal"`
 	NodeName string  `json:"node_name"`
 }
 
 type UpdateIdentityParam struct {
-	HashID string  `json:"hash_id"`
+	HashID string  `json:"hash_id" validate:"required"`
 	Ial    float64 `json:"ial"`
 }
 
 type CloseRequestParam struct {
-	RequestID         string          `json:"request_id"`
+	RequestID         string          `json:"request_id" validate:"required"`
 	ResponseValidList []ResponseValid `json:"response_valid_list"`
 }
 
 type TimeOutRequestParam struct {
-	RequestID         string          `json:"request_id"`
+	RequestID         string          `json:"request_id" validate:"required"`
 	ResponseValidList []ResponseValid `json:"response_valid_list"`
 }
 
 type ResponseValid struct {
-	IdpID          string `json:"idp_id"`
+	IdpID          string `json:"idp_id" validate:"required"`
 	ValidProof     *bool  `json:"valid_proof"`
 	ValidIal       *bool  `json:"valid_ial"`
 	ValidSignature *bool  `json:"valid_signature"`
@@ -485,8 +594,8 @@ type GetDataSignatureResult struct {
 }
 
 type DeclareIdentityProofParam struct {
-	IdentityProof string `json:"identity_proof"`
-	RequestID     string `json:"request_id"`
+	IdentityProof string `json:"identity_proof" validate:"required"`
+	RequestID     string `json:"request_id" validate:"required"`
 }
 
 type GetIdentityProofParam struct {
@@ -499,13 +608,13 @@ type GetIdentityProofResult struct {
 }
 
 type UpdateServiceDestinationParam struct {
-	ServiceID string  `json:"service_id"`
+	ServiceID string  `json:"service_id" validate:"required"`
 	MinIal    float64 `json:"min_ial"`
 	MinAal    float64 `json:"min_aal"`
 }
 
 type UpdateServiceParam struct {
-	ServiceID         string `json:"service_id"`
+	ServiceID         string `json:"service_id" validate:"required"`
 	ServiceName       string `json:"service_name"`
 	DataSchema        string `json:"data_schema"`
 	DataSchemaVersion string `json:"data_schema_version"`
@@ -520,12 +629,12 @@ type DisableAccessorMethodParam struct {
 }
 
 type RegisterServiceDestinationByNDIDParam struct {
-	ServiceID string `json:"service_id"`
-	NodeID    string `json:"node_id"`
+	ServiceID string `json:"service_id" validate:"required"`
+	NodeID    string `json:"node_id" validate:"required"`
 }
 
 type DisableNodeParam struct {
-	NodeID string `json:"node_id"`
+	NodeID string `json:"node_id" validate:"required"`
 }
 
 type Service struct {
@@ -545,8 +654,8 @@ type GetServicesByAsIDResult struct {
 }
 
 type DisableServiceDestinationByNDIDParam struct {
-	ServiceID string `json:"service_id"`
-	NodeID    string `json:"node_id"`
+	ServiceID string `json:"service_id" validate:"required"`
+	NodeID    string `json:"node_id" validate:"required"`
 }
 
 type ApproveService struct {
@@ -554,11 +663,11 @@ type ApproveService struct {
 }
 
 type DisableServiceDestinationParam struct {
-	ServiceID string `json:"service_id"`
+	ServiceID string `json:"service_id" validate:"required"`
 }
 
 type ClearRegisterIdentityTimeoutParam struct {
-	HashID string `json:"hash_id"`
+	HashID string `json:"hash_id" validate:"required"`
 }
 
 type TimeOutBlockRegisterIdentity struct {
@@ -566,7 +675,8 @@ type TimeOutBlockRegisterIdentity struct {
 }
 
 type GetIdpNodesInfoResult struct {
-	Node []interface{} `json:"node"`
+	Node       []interface{} `json:"node"`
+	NextCursor string        `json:"next_cursor,omitempty"`
 }
 
 type IdpNode struct {
@@ -592,8 +702,8 @@ type GetAsNodesInfoByServiceIdResult struct {
 }
 
 type AddNodeToProxyNodeParam struct {
-	NodeID      string `json:"node_id"`
-	ProxyNodeID string `json:"proxy_node_id"`
+	NodeID      string `json:"node_id" validate:"required"`
+	ProxyNodeID string `json:"proxy_node_id" validate:"required"`
 	Config      string `json:"config"`
 }
 
@@ -630,13 +740,13 @@ type GetNodeInfoResultIdPandASBehindProxy struct {
 }
 
 type UpdateNodeProxyNodeParam struct {
-	NodeID      string `json:"node_id"`
+	NodeID      string `json:"node_id" validate:"required"`
 	ProxyNodeID string `json:"proxy_node_id"`
 	Config      string `json:"config"`
 }
 
 type RemoveNodeFromProxyNode struct {
-	NodeID string `json:"node_id"`
+	NodeID string `json:"node_id" validate:"required"`
 }
 
 type IdpNodeBehindProxy struct {
@@ -703,11 +813,18 @@ type Proxy struct {
 // type GetUsedTokenReportResult []Report
 
 type GetNodeIDListParam struct {
-	Role string `json:"role"`
+	Role            string  `json:"role"`
+	MinIal          float64 `json:"min_ial"`
+	MinAal          float64 `json:"min_aal"`
+	NamePrefix      string  `json:"name_prefix"`
+	IncludeInactive bool    `json:"include_inactive"`
+	Cursor          string  `json:"cursor"`
+	Limit           int     `json:"limit"`
 }
 
 type GetNodeIDListResult struct {
 	NodeIDList []string `json:"node_id_list"`
+	NextCursor string   `json:"next_cursor,omitempty"`
 }
 
 type GetMqAddressesResult []MsqAddress
@@ -715,15 +832,36 @@ type GetMqAddressesResult []MsqAddress
 type GetAccessorsInAccessorGroupParam struct {
 	AccessorGroupID string `json:"accessor_group_id"`
 	IdpID           string `json:"idp_id"`
+	Cursor          string `json:"cursor"`
+	Limit           int    `json:"limit"`
 }
 
 type GetAccessorsInAccessorGroupResult struct {
 	AccessorList []string `json:"accessor_list"`
+	NextCursor   string   `json:"next_cursor,omitempty"`
+}
+
+// GetListParam filters and pages GetNamespaceList and GetServiceList
+type GetListParam struct {
+	NamePrefix      string `json:"name_prefix"`
+	IncludeInactive bool   `json:"include_inactive"`
+	Cursor          string `json:"cursor"`
+	Limit           int    `json:"limit"`
+}
+
+type GetNamespaceListResult struct {
+	NamespaceList []*data.Namespace `json:"namespace_list"`
+	NextCursor    string            `json:"next_cursor,omitempty"`
+}
+
+type GetServiceListResult struct {
+	ServiceList []*data.ServiceDetail `json:"service_list"`
+	NextCursor  string                `json:"next_cursor,omitempty"`
 }
 
 type RevokeAccessorMethodParam struct {
-	AccessorIDList []string `json:"accessor_id_list"`
-	RequestID      string   `json:"request_id"`
+	AccessorIDList []string `json:"accessor_id_list" validate:"required"`
+	RequestID      string   `json:"request_id" validate:"required"`
 }
 
 type GetAccessorOwnerParam struct {
@@ -754,3 +892,102 @@ type IsInitEndedParam struct{}
 type IsInitEndedResult struct {
 	InitEnded bool `json:"init_ended"`
 }
+
+type GovernanceKey struct {
+	KeyID     string `json:"key_id" validate:"required"`
+	PublicKey string `json:"public_key" validate:"required"`
+}
+
+type SetGovernanceParam struct {
+	Keys                 []GovernanceKey `json:"keys"`
+	Threshold            int64           `json:"threshold"`
+	ProposalTimeoutBlock int64           `json:"proposal_timeout_block"`
+}
+
+type GetGovernanceResult struct {
+	Keys                 []GovernanceKey `json:"keys"`
+	Threshold            int64           `json:"threshold"`
+	ProposalTimeoutBlock int64           `json:"proposal_timeout_block"`
+}
+
+type CreateProposalParam struct {
+	ProposalID string `json:"proposal_id" validate:"required"`
+	Method     string `json:"method" validate:"required"`
+	Params     string `json:"params"`
+}
+
+type ApproveProposalParam struct {
+	ProposalID string `json:"proposal_id" validate:"required"`
+}
+
+type GetProposalParam struct {
+	ProposalID string `json:"proposal_id"`
+}
+
+type GetProposalResult struct {
+	ProposalID  string   `json:"proposal_id"`
+	Method      string   `json:"method"`
+	Params      string   `json:"params"`
+	Proposer    string   `json:"proposer"`
+	Approvers   []string `json:"approvers"`
+	ExpireBlock int64    `json:"expire_block"`
+	Status      string   `json:"status"`
+	ResultCode  uint32   `json:"result_code"`
+	ResultLog   string   `json:"result_log"`
+}
+
+type GetNodePublicKeyHistoryParam struct {
+	NodeID string `json:"node_id"`
+}
+
+type NodePublicKeyHistory struct {
+	PublicKey       string `json:"public_key"`
+	ActivationBlock int64  `json:"activation_block"`
+	RevocationBlock int64  `json:"revocation_block"`
+}
+
+type GetNodePublicKeyHistoryResult struct {
+	History []NodePublicKeyHistory `json:"history"`
+}
+
+type InitiateMasterKeyRecoveryParam struct {
+	NodeID             string `json:"node_id" validate:"required"`
+	NewMasterPublicKey string `json:"new_master_public_key" validate:"required"`
+}
+
+type CancelMasterKeyRecoveryParam struct{}
+
+type GetMasterKeyRecoveryParam struct {
+	NodeID string `json:"node_id"`
+}
+
+type GetMasterKeyRecoveryResult struct {
+	NewMasterPublicKey string `json:"new_master_public_key"`
+	InitiatedBlock     int64  `json:"initiated_block"`
+	ExecuteBlock       int64  `json:"execute_block"`
+	Status             string `json:"status"`
+}
+
+type BatchTx struct {
+	Method string `json:"method" validate:"required"`
+	Params string `json:"params"`
+}
+
+type BatchParam struct {
+	Transactions []BatchTx `json:"transactions"`
+}
+
+type ScheduleUpgradeParam struct {
+	Version string `json:"version" validate:"required"`
+	Height  int64  `json:"height" validate:"required"`
+}
+
+type ScheduledUpgrade struct {
+	Height         int64  `json:"height"`
+	Version        string `json:"version"`
+	ScheduledBlock int64  `json:"scheduled_block"`
+}
+
+type GetUpgradeScheduleResult struct {
+	Upgrades []ScheduledUpgrade `json:"upgrades"`
+}
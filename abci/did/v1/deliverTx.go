@@ -35,11 +35,11 @@ func (app *DIDApplication) ReturnDeliverTxLog(code uint32, log string, extraData
 	var tags []cmn.KVPair
 	if code == 0 {
 		tags = []cmn.KVPair{
-			{[]byte("success"), []byte("true")},
+			{Key: []byte(tagSuccess), Value: []byte("true")},
 		}
 	} else {
 		tags = []cmn.KVPair{
-			{[]byte("success"), []byte("false")},
+			{Key: []byte(tagSuccess), Value: []byte("false")},
 		}
 	}
 	return types.ResponseDeliverTx{
@@ -51,9 +51,16 @@ func (app *DIDApplication) ReturnDeliverTxLog(code uint32, log string, extraData
 }
 
 // DeliverTxRouter is Pointer to function
-func (app *DIDApplication) DeliverTxRouter(method string, param string, nonce []byte, signature []byte, nodeID string) types.ResponseDeliverTx {
+func (app *DIDApplication) DeliverTxRouter(method string, param string, signedParam string, nonce []byte, signature []byte, nodeID string) (result types.ResponseDeliverTx) {
+	defer func() {
+		result.Tags = append(result.Tags, txTags(method, nodeID)...)
+		if result.Code == code.OK {
+			result.Tags = append(result.Tags, app.tokenLowTags()...)
+		}
+	}()
+
 	// ---- check authorization ----
-	checkTxResult := app.CheckTxRouter(method, param, nonce, signature, nodeID)
+	checkTxResult := app.CheckTxRouter(method, param, signedParam, nonce, signature, nodeID)
 	if checkTxResult.Code != code.OK {
 		if checkTxResult.Log != "" {
 			return app.ReturnDeliverTxLog(checkTxResult.Code, checkTxResult.Log, "")
@@ -61,27 +68,51 @@ func (app *DIDApplication) DeliverTxRouter(method string, param string, nonce []
 		return app.ReturnDeliverTxLog(checkTxResult.Code, "Unauthorized", "")
 	}
 
-	result := app.callDeliverTx(method, param, nodeID)
-	// ---- Burn token ----
-	if result.Code == code.OK {
-		if !app.checkNDID(param, nodeID) && !isNDIDMethod[method] {
-			needToken := app.getTokenPriceByFunc(method, app.state.db.Version())
-			err := app.reduceToken(nodeID, needToken)
-			if err != nil {
-				result.Code = code.TokenAccountNotFound
-				result.Log = err.Error()
-				return result
-			}
-			// FIXME: Need a better way to store report or remove it in later release
-			// Write burn token report
-			// only have result.Data in some method
-			// writeBurnTokenReport(nodeID, method, needToken, string(result.Data), app)
+	// ---- Mark nonce as used ----
+	// Outside the journal below: the nonce stays used when the writes of the
+	// transaction are rolled back, so a failed transaction can not be replayed
+	app.setNonceUsed(nonce, nonceSigner(method, nodeID))
+
+	// ---- Roll back writes of the transaction if its price can not be paid ----
+	app.beginJournal()
+	committed := false
+	defer func() {
+		if !committed {
+			app.rollbackJournal()
 		}
+	}()
+
+	charged := !app.checkNDID(param, nodeID) && !isNDIDMethod[method] && !isGovernanceMethod[method]
+	app.txTokenPrice = 0
+	if charged {
+		app.txTokenPrice = app.getTxTokenPrice(method, param)
 	}
+	result = app.callDeliverTx(method, param, nodeID)
+	// ---- Burn token, price of CreateRequest is held in escrow of the request ----
+	if result.Code == code.OK && charged {
+		needToken := app.txTokenPrice
+		err := app.reduceToken(nodeID, needToken)
+		if err != nil {
+			return app.ReturnDeliverTxLog(code.TokenAccountNotFound, err.Error(), "")
+		}
+		if needToken != 0 {
+			app.writeTokenLedger(nodeID, method, -needToken, paramsRequestID(param))
+		}
+	}
+	app.commitJournal()
+	committed = true
 	return result
 }
 
 func (app *DIDApplication) callDeliverTx(name string, param string, nodeID string) types.ResponseDeliverTx {
+	result := app.routeDeliverTx(name, param, nodeID)
+	if result.Code == code.OK {
+		result.Tags = append(result.Tags, paramsTags(name, param)...)
+	}
+	return result
+}
+
+func (app *DIDApplication) routeDeliverTx(name string, param string, nodeID string) types.ResponseDeliverTx {
 	switch name {
 	case "InitNDID":
 		return app.initNDID(param, nodeID)
@@ -175,6 +206,30 @@ func (app *DIDApplication) callDeliverTx(name string, param string, nodeID strin
 		return app.EndInit(param, nodeID)
 	case "SetLastBlock":
 		return app.setLastBlock(param, nodeID)
+	case "SetGovernance":
+		return app.setGovernance(param, nodeID)
+	case "CreateProposal":
+		return app.createProposal(param, nodeID)
+	case "ApproveProposal":
+		return app.approveProposal(param, nodeID)
+	case "InitiateMasterKeyRecovery":
+		return app.initiateMasterKeyRecovery(param, nodeID)
+	case "CancelMasterKeyRecovery":
+		return app.cancelMasterKeyRecovery(param, nodeID)
+	case "Batch":
+		return app.batch(param, nodeID)
+	case "ScheduleUpgrade":
+		return app.scheduleUpgrade(param, nodeID)
+	case "TransferToken":
+		return app.transferToken(param, nodeID)
+	case "SetTokenTransferWhitelist":
+		return app.setTokenTransferWhitelist(param, nodeID)
+	case "SetPriceRule":
+		return app.setPriceRule(param, nodeID)
+	case "SetRevenueShareRule":
+		return app.setRevenueShareRule(param, nodeID)
+	case "SetNodeTokenLimit":
+		return app.setNodeTokenLimit(param, nodeID)
 	default:
 		return types.ResponseDeliverTx{Code: code.UnknownMethod, Log: "Unknown method name"}
 	}
@@ -60,6 +60,21 @@ type DIDApplication struct {
 	Version      string
 	CurrentBlock int64
 	CurrentChain string
+	journal      *writeJournal
+	proofKeys    [][]byte
+	proofRanges  []keyRange
+	// Position of the transaction being delivered in the current block and
+	// of the next token ledger entry it writes
+	txIndex          int64
+	ledgerEntryIndex int64
+	// Price the sender pays for the transaction being delivered, the sum of
+	// its transactions for Batch
+	txTokenPrice TokenAmount
+	// Nodes whose balance dropped to their low balance threshold in the
+	// transaction being delivered
+	lowTokenNodes []string
+	// App version of the block being run, set by the version router
+	appVersion string
 }
 
 func NewDIDApplication(logger *logrus.Entry, tree *iavl.MutableTree) *DIDApplication {
@@ -74,24 +89,64 @@ func NewDIDApplication(logger *logrus.Entry, tree *iavl.MutableTree) *DIDApplica
 	ABCIversion := "0.11.2" // Hard code set version
 	logger.Infof("Start ABCI version: %s", ABCIversion)
 	return &DIDApplication{
-		state:   state,
-		logger:  logger,
-		Version: ABCIversion,
+		state:      state,
+		logger:     logger,
+		Version:    ABCIversion,
+		appVersion: "1",
 	}
 }
 
+// SetAppVersion sets the app version of the blocks run from now on
+func (app *DIDApplication) SetAppVersion(version string) {
+	app.appVersion = version
+}
+
 func (app *DIDApplication) SetStateDB(key, value []byte) {
+	app.recordWrite(prefixKey(key))
 	app.state.db.Set(prefixKey(key), value)
 }
 
 func (app *DIDApplication) SetStateDBWithOutPrefix(key, value []byte) {
+	app.recordWrite(key)
 	app.state.db.Set(key, value)
 }
 
 func (app *DIDApplication) DeleteStateDB(key []byte) {
+	app.recordWrite(prefixKey(key))
 	app.state.db.Remove(prefixKey(key))
 }
 
+func (app *DIDApplication) GetVersionedStateDB(key []byte, height int64) []byte {
+	if app.proofKeys != nil {
+		app.proofKeys = append(app.proofKeys, prefixKey(key))
+	}
+	_, value := app.state.db.GetVersioned(prefixKey(key), height)
+	return value
+}
+
+// IterateVersionedStateDB calls fn with every key in [start, end) of the
+// state at height in ascending order until fn returns true
+func (app *DIDApplication) IterateVersionedStateDB(start, end []byte, height int64, fn func(key []byte, value []byte) bool) error {
+	tree, err := app.state.db.GetImmutable(height)
+	if err != nil {
+		return err
+	}
+	var lastKey []byte
+	stopped := tree.IterateRange(prefixKey(start), prefixKey(end), true, func(key []byte, value []byte) bool {
+		lastKey = key
+		return fn(key[len(kvPairPrefixKey):], value)
+	})
+	if app.proofRanges != nil {
+		// Prove only the part of the range that has been read
+		provenEnd := prefixKey(end)
+		if stopped {
+			provenEnd = append(append([]byte{}, lastKey...), 0)
+		}
+		app.proofRanges = append(app.proofRanges, keyRange{prefixKey(start), provenEnd})
+	}
+	return nil
+}
+
 func (app *DIDApplication) Info(req types.RequestInfo) (resInfo types.ResponseInfo) {
 	var res types.ResponseInfo
 	res.Version = app.Version
@@ -117,6 +172,7 @@ func (app *DIDApplication) BeginBlock(req types.RequestBeginBlock) types.Respons
 	app.logger.Infof("BeginBlock: %d, Chain ID: %s", req.Header.Height, req.Header.ChainID)
 	app.CurrentBlock = req.Header.Height
 	app.CurrentChain = req.Header.ChainID
+	app.txIndex = 0
 	// reset valset changes
 	app.ValUpdates = make([]types.Validator, 0)
 	return types.ResponseBeginBlock{}
@@ -125,7 +181,12 @@ func (app *DIDApplication) BeginBlock(req types.RequestBeginBlock) types.Respons
 // Update the validator set
 func (app *DIDApplication) EndBlock(req types.RequestEndBlock) types.ResponseEndBlock {
 	app.logger.Infof("EndBlock: %d", req.Height)
-	return types.ResponseEndBlock{ValidatorUpdates: app.ValUpdates}
+	app.ledgerEntryIndex = 0
+	app.removeExpiredNonce(req.Height)
+	tags := app.lapseExpiredProposals(req.Height)
+	tags = append(tags, app.executeMasterKeyRecovery(req.Height)...)
+	tags = append(tags, app.timeOutExpiredRequests(req.Height)...)
+	return types.ResponseEndBlock{ValidatorUpdates: app.ValUpdates, Tags: tags}
 }
 
 func (app *DIDApplication) DeliverTx(tx []byte) (res types.ResponseDeliverTx) {
@@ -135,7 +196,10 @@ func (app *DIDApplication) DeliverTx(tx []byte) (res types.ResponseDeliverTx) {
 			app.logger.Errorf("Recovered in %s, %s", r, identifyPanic())
 			res = app.ReturnDeliverTxLog(code.UnknownError, "Unknown error", "")
 		}
+		app.txIndex++
 	}()
+	app.ledgerEntryIndex = 0
+	app.lowTokenNodes = nil
 
 	var txObj protoTm.Tx
 	err := proto.Unmarshal(tx, &txObj)
@@ -144,7 +208,6 @@ func (app *DIDApplication) DeliverTx(tx []byte) (res types.ResponseDeliverTx) {
 	}
 
 	method := txObj.Method
-	param := txObj.Params
 	nonce := txObj.Nonce
 	signature := txObj.Signature
 	nodeID := txObj.NodeId
@@ -152,7 +215,11 @@ func (app *DIDApplication) DeliverTx(tx []byte) (res types.ResponseDeliverTx) {
 	app.logger.Infof("DeliverTx: %s, NodeID: %s", method, nodeID)
 
 	if method != "" {
-		result := app.DeliverTxRouter(method, param, nonce, signature, nodeID)
+		param, signedParam, decodeCode, decodeLog := decodeTxParams(txObj)
+		if decodeCode != code.OK {
+			return app.ReturnDeliverTxLog(decodeCode, decodeLog, "")
+		}
+		result := app.DeliverTxRouter(method, param, signedParam, nonce, signature, nodeID)
 		app.logger.Infof(`DeliverTx response: {"code":%d,"log":"%s","tags":[{"key":"%s","value":"%s"}]}`, result.Code, result.Log, string(result.Tags[0].Key), string(result.Tags[0].Value))
 		return result
 	}
@@ -175,17 +242,21 @@ func (app *DIDApplication) CheckTx(tx []byte) (res types.ResponseCheckTx) {
 	}
 
 	method := txObj.Method
-	param := txObj.Params
 	nonce := txObj.Nonce
 	signature := txObj.Signature
 	nodeID := txObj.NodeId
 
 	app.logger.Infof("CheckTx: %s, NodeID: %s", method, nodeID)
 
+	param, signedParam, decodeCode, decodeLog := decodeTxParams(txObj)
+	if decodeCode != code.OK {
+		return ReturnCheckTx(decodeCode, decodeLog)
+	}
+
 	if method != "" && param != "" && nonce != nil && signature != nil && nodeID != "" {
 		// Check has function in system
 		if IsMethod[method] {
-			result := app.CheckTxRouter(method, param, nonce, signature, nodeID)
+			result := app.CheckTxRouter(method, param, signedParam, nonce, signature, nodeID)
 			return result
 		}
 		res.Code = code.UnknownMethod
@@ -228,9 +299,29 @@ func (app *DIDApplication) Query(reqQuery types.RequestQuery) (res types.Respons
 	if height == 0 {
 		height = app.state.db.Version()
 	}
+	if height < 0 || height > app.state.db.Version() {
+		return types.ResponseQuery{Code: code.HeightIsNotCommitted, Log: fmt.Sprintf("Height %d is not committed", height), Height: height}
+	}
+	if height > 0 && !app.state.db.VersionExists(height) {
+		return types.ResponseQuery{Code: code.HeightIsPruned, Log: fmt.Sprintf("Height %d has been pruned", height), Height: height}
+	}
 
 	if method != "" {
-		return app.QueryRouter(method, param, height)
+		if !reqQuery.Prove {
+			return app.QueryRouter(method, param, height)
+		}
+		app.proofKeys = make([][]byte, 0)
+		app.proofRanges = make([]keyRange, 0)
+		defer func() {
+			app.proofKeys = nil
+			app.proofRanges = nil
+		}()
+		res = app.QueryRouter(method, param, height)
+		res.Proof, err = app.queryProof(height)
+		if err != nil {
+			return app.ReturnQuery(nil, err.Error(), height)
+		}
+		return res
 	}
 	return app.ReturnQuery(nil, "method can't empty", app.state.db.Version())
 }
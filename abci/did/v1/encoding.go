@@ -0,0 +1,193 @@
+/**
+ * Copyright (c) 2018, 2019 National Digital ID COMPANY LIMITED
+ *
+ * This file is part of NDID software.
+ *
+ * NDID is the free software: you can redistribute it and/or modify it under
+ * the terms of the Affero GNU General Public License as published by the
+ * Free Software Foundation, either version 3 of the License, or any later
+ * version.
+ *
+ * NDID is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.
+ * See the Affero GNU General Public License for more details.
+ *
+ * You should have received a copy of the Affero GNU General Public License
+ * along with the NDID source code. If not, see https://www.gnu.org/licenses/agpl.txt.
+ *
+ * Please contact info@ndid.co.th for any further questions
+ *
+ */
+
+package did
+
+import (
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"strings"
+
+	"github.com/golang/protobuf/proto"
+	"github.com/ndidplatform/smart-contract/abci/code"
+	protoParam "github.com/ndidplatform/smart-contract/protos/param"
+	protoTm "github.com/ndidplatform/smart-contract/protos/tendermint"
+)
+
+// paramsMessages maps each method to the protobuf message of its params
+var paramsMessages = map[string]func() proto.Message{
+	"InitNDID":                         func() proto.Message { return &protoParam.InitNDIDParams{} },
+	"RegisterNode":                     func() proto.Message { return &protoParam.RegisterNodeParams{} },
+	"AddNodeToken":                     func() proto.Message { return &protoParam.NodeTokenParams{} },
+	"ReduceNodeToken":                  func() proto.Message { return &protoParam.NodeTokenParams{} },
+	"SetNodeToken":                     func() proto.Message { return &protoParam.NodeTokenParams{} },
+	"SetPriceFunc":                     func() proto.Message { return &protoParam.SetPriceFuncParams{} },
+	"AddNamespace":                     func() proto.Message { return &protoParam.AddNamespaceParams{} },
+	"SetValidator":                     func() proto.Message { return &protoParam.SetValidatorParams{} },
+	"AddService":                       func() proto.Message { return &protoParam.ServiceParams{} },
+	"UpdateNodeByNDID":                 func() proto.Message { return &protoParam.UpdateNodeByNDIDParams{} },
+	"UpdateService":                    func() proto.Message { return &protoParam.ServiceParams{} },
+	"RegisterServiceDestinationByNDID": func() proto.Message { return &protoParam.ServiceDestinationByNDIDParams{} },
+	"DisableNode":                      func() proto.Message { return &protoParam.NodeIDParams{} },
+	"DisableNamespace":                 func() proto.Message { return &protoParam.NamespaceParams{} },
+	"DisableService":                   func() proto.Message { return &protoParam.ServiceIDParams{} },
+	"DisableServiceDestinationByNDID":  func() proto.Message { return &protoParam.ServiceDestinationByNDIDParams{} },
+	"EnableNode":                       func() proto.Message { return &protoParam.NodeIDParams{} },
+	"EnableServiceDestinationByNDID":   func() proto.Message { return &protoParam.ServiceDestinationByNDIDParams{} },
+	"EnableNamespace":                  func() proto.Message { return &protoParam.NamespaceParams{} },
+	"EnableService":                    func() proto.Message { return &protoParam.ServiceIDParams{} },
+	"RegisterIdentity":                 func() proto.Message { return &protoParam.RegisterIdentityParams{} },
+	"AddAccessorMethod":                func() proto.Message { return &protoParam.AccessorMethodParams{} },
+	"CreateIdpResponse":                func() proto.Message { return &protoParam.CreateIdpResponseParams{} },
+	"RegisterAccessor":                 func() proto.Message { return &protoParam.RegisterAccessorParams{} },
+	"UpdateIdentity":                   func() proto.Message { return &protoParam.UpdateIdentityParams{} },
+	"DeclareIdentityProof":             func() proto.Message { return &protoParam.DeclareIdentityProofParams{} },
+	"SignData":                         func() proto.Message { return &protoParam.SignDataParams{} },
+	"RegisterServiceDestination":       func() proto.Message { return &protoParam.ServiceDestinationParams{} },
+	"UpdateServiceDestination":         func() proto.Message { return &protoParam.ServiceDestinationParams{} },
+	"CreateRequest":                    func() proto.Message { return &protoParam.CreateRequestParams{} },
+	"SetMqAddresses":                   func() proto.Message { return &protoParam.SetMqAddressesParams{} },
+	"UpdateNode":                       func() proto.Message { return &protoParam.UpdateNodeParams{} },
+	"CloseRequest":                     func() proto.Message { return &protoParam.CloseRequestParams{} },
+	"TimeOutRequest":                   func() proto.Message { return &protoParam.CloseRequestParams{} },
+	"SetDataReceived":                  func() proto.Message { return &protoParam.SetDataReceivedParams{} },
+	"DisableServiceDestination":        func() proto.Message { return &protoParam.ServiceIDParams{} },
+	"EnableServiceDestination":         func() proto.Message { return &protoParam.ServiceIDParams{} },
+	"ClearRegisterIdentityTimeout":     func() proto.Message { return &protoParam.HashIDParams{} },
+	"SetTimeOutBlockRegisterIdentity":  func() proto.Message { return &protoParam.SetTimeOutBlockRegisterIdentityParams{} },
+	"AddNodeToProxyNode":               func() proto.Message { return &protoParam.ProxyNodeParams{} },
+	"UpdateNodeProxyNode":              func() proto.Message { return &protoParam.ProxyNodeParams{} },
+	"RemoveNodeFromProxyNode":          func() proto.Message { return &protoParam.NodeIDParams{} },
+	"RevokeAccessorMethod":             func() proto.Message { return &protoParam.RevokeAccessorMethodParams{} },
+	"SetInitData":                      func() proto.Message { return &protoParam.SetInitDataParams{} },
+	"EndInit":                          func() proto.Message { return &protoParam.EmptyParams{} },
+	"SetLastBlock":                     func() proto.Message { return &protoParam.SetLastBlockParams{} },
+	"SetGovernance":                    func() proto.Message { return &protoParam.SetGovernanceParams{} },
+	"CreateProposal":                   func() proto.Message { return &protoParam.CreateProposalParams{} },
+	"ApproveProposal":                  func() proto.Message { return &protoParam.ProposalIDParams{} },
+	"InitiateMasterKeyRecovery":        func() proto.Message { return &protoParam.InitiateMasterKeyRecoveryParams{} },
+	"CancelMasterKeyRecovery":          func() proto.Message { return &protoParam.EmptyParams{} },
+	"Batch":                            func() proto.Message { return &protoParam.BatchParams{} },
+	"ScheduleUpgrade":                  func() proto.Message { return &protoParam.ScheduleUpgradeParams{} },
+	"TransferToken":                    func() proto.Message { return &protoParam.TransferTokenParams{} },
+	"SetTokenTransferWhitelist":        func() proto.Message { return &protoParam.SetTokenTransferWhitelistParams{} },
+	"SetPriceRule":                     func() proto.Message { return &protoParam.SetPriceRuleParams{} },
+	"SetRevenueShareRule":              func() proto.Message { return &protoParam.SetRevenueShareRuleParams{} },
+	"SetNodeTokenLimit":                func() proto.Message { return &protoParam.SetNodeTokenLimitParams{} },
+}
+
+// decodeTxParams returns the JSON params of a transaction and the params
+// covered by its signature. Protobuf params are converted to their JSON form
+// so handlers parse the same params regardless of encoding.
+func decodeTxParams(txObj protoTm.Tx) (param string, signedParam string, returnCode uint32, log string) {
+	switch txObj.Encoding {
+	case protoTm.Encoding_JSON:
+		return txObj.Params, txObj.Params, code.OK, ""
+	case protoTm.Encoding_PROTOBUF:
+		newParams, ok := paramsMessages[txObj.Method]
+		if !ok {
+			return "", "", code.UnknownMethod, "Unknown method name"
+		}
+		if txObj.Params != "" {
+			return "", "", code.InvalidParamsEncoding, "Protobuf encoded transaction must not have JSON params"
+		}
+		params := newParams()
+		err := proto.Unmarshal(txObj.EncodedParams, params)
+		if err != nil {
+			return "", "", code.InvalidParamsEncoding, err.Error()
+		}
+		value, err := paramsValue(reflect.ValueOf(params))
+		if err != nil {
+			return "", "", code.InvalidParameter, "Invalid parameter: " + err.Error()
+		}
+		jsonParams, err := json.Marshal(value)
+		if err != nil {
+			return "", "", code.InvalidParamsEncoding, err.Error()
+		}
+		return string(jsonParams), string(txObj.EncodedParams), code.OK, ""
+	}
+	return "", "", code.InvalidParamsEncoding, "Unknown params encoding"
+}
+
+// paramsValue converts a protobuf params message into a value which
+// marshals to JSON with the original proto field names. As jsonpb does with
+// EmitDefaults off, fields left at their default value are omitted, so
+// validateParams sees them as missing. A message with fields this version
+// does not know is rejected.
+func paramsValue(v reflect.Value) (interface{}, error) {
+	switch v.Kind() {
+	case reflect.Ptr:
+		if v.IsNil() {
+			return nil, nil
+		}
+		unrecognized := v.Elem().FieldByName("XXX_unrecognized")
+		if unrecognized.IsValid() && unrecognized.Len() > 0 {
+			key, _ := proto.DecodeVarint(unrecognized.Bytes())
+			return nil, fmt.Errorf("unknown field number %d", key>>3)
+		}
+		switch message := v.Interface().(type) {
+		case *protoParam.OptionalBool:
+			return message.Value, nil
+		case *protoParam.TokenAmount:
+			return TokenAmount(message.MinorUnits), nil
+		}
+		return paramsValue(v.Elem())
+	case reflect.Struct:
+		fields := make(map[string]interface{})
+		for i := 0; i < v.NumField(); i++ {
+			name := protoFieldName(v.Type().Field(i).Tag.Get("protobuf"))
+			if name == "" || isEmptyField(v.Field(i)) {
+				continue
+			}
+			value, err := paramsValue(v.Field(i))
+			if err != nil {
+				return nil, err
+			}
+			fields[name] = value
+		}
+		return fields, nil
+	case reflect.Slice:
+		if v.Type().Elem().Kind() == reflect.Uint8 {
+			return v.Interface(), nil
+		}
+		items := make([]interface{}, v.Len())
+		for i := 0; i < v.Len(); i++ {
+			item, err := paramsValue(v.Index(i))
+			if err != nil {
+				return nil, err
+			}
+			items[i] = item
+		}
+		return items, nil
+	}
+	return v.Interface(), nil
+}
+
+func protoFieldName(tag string) string {
+	for _, option := range strings.Split(tag, ",") {
+		if strings.HasPrefix(option, "name=") {
+			return strings.TrimPrefix(option, "name=")
+		}
+	}
+	return ""
+}
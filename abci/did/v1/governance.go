@@ -0,0 +1,393 @@
+/**
+ * Copyright (c) 2018, 2019 National Digital ID COMPANY LIMITED
+ *
+ * This file is part of NDID software.
+ *
+ * NDID is the free software: you can redistribute it and/or modify it under
+ * the terms of the Affero GNU General Public License as published by the
+ * Free Software Foundation, either version 3 of the License, or any later
+ * version.
+ *
+ * NDID is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.
+ * See the Affero GNU General Public License for more details.
+ *
+ * You should have received a copy of the Affero GNU General Public License
+ * along with the NDID source code. If not, see https://www.gnu.org/licenses/agpl.txt.
+ *
+ * Please contact info@ndid.co.th for any further questions
+ *
+ */
+
+package did
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/golang/protobuf/proto"
+	"github.com/ndidplatform/smart-contract/abci/code"
+	"github.com/ndidplatform/smart-contract/abci/utils"
+	"github.com/ndidplatform/smart-contract/protos/data"
+	"github.com/tendermint/tendermint/abci/types"
+	cmn "github.com/tendermint/tendermint/libs/common"
+)
+
+// Governance methods are signed by a governance key. Tx node ID is the key ID.
+var isGovernanceMethod = map[string]bool{
+	"CreateProposal":  true,
+	"ApproveProposal": true,
+}
+
+// NDID methods that can not be executed through a proposal
+var isNotProposableMethod = map[string]bool{
+	"InitNDID":    true,
+	"SetInitData": true,
+	"EndInit":     true,
+}
+
+const (
+	proposalStatusPending  = "pending"
+	proposalStatusExecuted = "executed"
+	proposalStatusFailed   = "failed"
+	proposalStatusLapsed   = "lapsed"
+)
+
+func proposalExpireKey(height int64, proposalID string) string {
+	return "ProposalExpire" + "|" + fmt.Sprintf("%020d", height) + "|" + proposalID
+}
+
+func (app *DIDApplication) getGovernance() (governance data.Governance, enabled bool) {
+	key := "Governance"
+	_, value := app.state.db.Get(prefixKey([]byte(key)))
+	if value == nil {
+		return governance, false
+	}
+	err := proto.Unmarshal(value, &governance)
+	if err != nil {
+		return governance, false
+	}
+	return governance, governance.Threshold > 0
+}
+
+func (app *DIDApplication) isGovernanceEnabled() bool {
+	_, enabled := app.getGovernance()
+	return enabled
+}
+
+func getGovernancePublicKey(governance data.Governance, keyID string) string {
+	for _, key := range governance.Keys {
+		if key.KeyId == keyID {
+			return key.PublicKey
+		}
+	}
+	return ""
+}
+
+func (app *DIDApplication) setGovernance(param string, nodeID string) types.ResponseDeliverTx {
+	app.logger.Infof("SetGovernance, Parameter: %s", param)
+	var funcParam SetGovernanceParam
+	err := json.Unmarshal([]byte(param), &funcParam)
+	if err != nil {
+		return app.ReturnDeliverTxLog(code.UnmarshalError, err.Error(), "")
+	}
+	// Threshold 0 disables governance
+	if funcParam.Threshold < 0 || funcParam.Threshold > int64(len(funcParam.Keys)) {
+		return app.ReturnDeliverTxLog(code.InvalidGovernanceThreshold, "Threshold must be between 0 and number of keys", "")
+	}
+	if funcParam.Threshold > 0 && funcParam.ProposalTimeoutBlock <= 0 {
+		return app.ReturnDeliverTxLog(code.TimeOutBlockIsMustGreaterThanZero, "Time out block is must greater than 0", "")
+	}
+	var governance data.Governance
+	keyIDs := make(map[string]bool)
+	for _, key := range funcParam.Keys {
+		if key.KeyID == "" || keyIDs[key.KeyID] {
+			return app.ReturnDeliverTxLog(code.DuplicateGovernanceKeyID, "Governance key ID is empty or duplicated", "")
+		}
+		keyIDs[key.KeyID] = true
+		checkCode, log := checkPubKey(key.PublicKey)
+		if checkCode != code.OK {
+			return app.ReturnDeliverTxLog(checkCode, log, "")
+		}
+		var governanceKey data.GovernanceKey
+		governanceKey.KeyId = key.KeyID
+		governanceKey.PublicKey = key.PublicKey
+		governance.Keys = append(governance.Keys, &governanceKey)
+	}
+	governance.Threshold = funcParam.Threshold
+	governance.ProposalTimeoutBlock = funcParam.ProposalTimeoutBlock
+	value, err := utils.ProtoDeterministicMarshal(&governance)
+	if err != nil {
+		return app.ReturnDeliverTxLog(code.MarshalError, err.Error(), "")
+	}
+	app.SetStateDB([]byte("Governance"), []byte(value))
+	return app.ReturnDeliverTxLog(code.OK, "success", "")
+}
+
+func (app *DIDApplication) checkTxGovernance(method string, param string, signedParam string, nonce []byte, signature []byte, nodeID string) types.ResponseCheckTx {
+	governance, enabled := app.getGovernance()
+	if !enabled {
+		return ReturnCheckTx(code.GovernanceIsNotEnabled, "Governance is not enabled")
+	}
+	publicKey := getGovernancePublicKey(governance, nodeID)
+	if publicKey == "" {
+		return ReturnCheckTx(code.GovernanceKeyNotFound, "Governance key not found")
+	}
+	nonceResult := app.checkNonce(nonce, nonceSigner(method, nodeID))
+	if nonceResult.Code != code.OK {
+		return nonceResult
+	}
+	verifyResult, err := verifySignature(signedParam, nonce, signature, publicKey, method, "")
+	if err != nil || verifyResult == false {
+		return ReturnCheckTx(code.VerifySignatureError, err.Error())
+	}
+	switch method {
+	case "CreateProposal":
+		return app.checkTxCreateProposal(param, nodeID)
+	case "ApproveProposal":
+		return app.checkTxApproveProposal(param, nodeID)
+	default:
+		return ReturnCheckTx(code.UnknownMethod, "Unknown method name")
+	}
+}
+
+func (app *DIDApplication) checkTxCreateProposal(param string, nodeID string) types.ResponseCheckTx {
+	var funcParam CreateProposalParam
+	err := json.Unmarshal([]byte(param), &funcParam)
+	if err != nil {
+		return ReturnCheckTx(code.UnmarshalError, err.Error())
+	}
+	if !isNDIDMethod[funcParam.Method] || isNotProposableMethod[funcParam.Method] {
+		return ReturnCheckTx(code.MethodCanNotBeProposed, "Method can not be proposed")
+	}
+	checkCode, log := validateParams(funcParam.Method, funcParam.Params)
+	if checkCode != code.OK {
+		return ReturnCheckTx(checkCode, log)
+	}
+	key := "Proposal" + "|" + funcParam.ProposalID
+	_, value := app.state.db.Get(prefixKey([]byte(key)))
+	if value != nil {
+		return ReturnCheckTx(code.ProposalIDIsAlreadyExisted, "Proposal ID is already existed")
+	}
+	return ReturnCheckTx(code.OK, "")
+}
+
+func (app *DIDApplication) checkTxApproveProposal(param string, nodeID string) types.ResponseCheckTx {
+	var funcParam ApproveProposalParam
+	err := json.Unmarshal([]byte(param), &funcParam)
+	if err != nil {
+		return ReturnCheckTx(code.UnmarshalError, err.Error())
+	}
+	key := "Proposal" + "|" + funcParam.ProposalID
+	_, value := app.state.db.Get(prefixKey([]byte(key)))
+	if value == nil {
+		return ReturnCheckTx(code.ProposalNotFound, "Proposal not found")
+	}
+	var proposal data.Proposal
+	err = proto.Unmarshal(value, &proposal)
+	if err != nil {
+		return ReturnCheckTx(code.UnmarshalError, err.Error())
+	}
+	if proposal.Status != proposalStatusPending {
+		return ReturnCheckTx(code.ProposalIsNotPending, "Proposal is not pending")
+	}
+	for _, approver := range proposal.Approvers {
+		if approver == nodeID {
+			return ReturnCheckTx(code.ProposalIsAlreadyApproved, "Proposal is already approved by this key")
+		}
+	}
+	return ReturnCheckTx(code.OK, "")
+}
+
+func (app *DIDApplication) createProposal(param string, nodeID string) types.ResponseDeliverTx {
+	app.logger.Infof("CreateProposal, Parameter: %s", param)
+	var funcParam CreateProposalParam
+	err := json.Unmarshal([]byte(param), &funcParam)
+	if err != nil {
+		return app.ReturnDeliverTxLog(code.UnmarshalError, err.Error(), "")
+	}
+	governance, _ := app.getGovernance()
+	var proposal data.Proposal
+	proposal.ProposalId = funcParam.ProposalID
+	proposal.Method = funcParam.Method
+	proposal.Params = funcParam.Params
+	proposal.Proposer = nodeID
+	proposal.Approvers = append(proposal.Approvers, nodeID)
+	proposal.ExpireBlock = app.CurrentBlock + governance.ProposalTimeoutBlock
+	proposal.Status = proposalStatusPending
+	app.SetStateDB([]byte(proposalExpireKey(proposal.ExpireBlock, proposal.ProposalId)), []byte(proposal.ProposalId))
+	return app.saveProposal(&proposal, governance)
+}
+
+func (app *DIDApplication) approveProposal(param string, nodeID string) types.ResponseDeliverTx {
+	app.logger.Infof("ApproveProposal, Parameter: %s", param)
+	var funcParam ApproveProposalParam
+	err := json.Unmarshal([]byte(param), &funcParam)
+	if err != nil {
+		return app.ReturnDeliverTxLog(code.UnmarshalError, err.Error(), "")
+	}
+	governance, _ := app.getGovernance()
+	key := "Proposal" + "|" + funcParam.ProposalID
+	_, value := app.state.db.Get(prefixKey([]byte(key)))
+	var proposal data.Proposal
+	err = proto.Unmarshal(value, &proposal)
+	if err != nil {
+		return app.ReturnDeliverTxLog(code.UnmarshalError, err.Error(), "")
+	}
+	proposal.Approvers = append(proposal.Approvers, nodeID)
+	return app.saveProposal(&proposal, governance)
+}
+
+// saveProposal executes the proposed method once approvals from current governance keys reach the threshold,
+// then stores the proposal
+func (app *DIDApplication) saveProposal(proposal *data.Proposal, governance data.Governance) types.ResponseDeliverTx {
+	var approvalCount int64
+	for _, approver := range proposal.Approvers {
+		if getGovernancePublicKey(governance, approver) != "" {
+			approvalCount++
+		}
+	}
+	if approvalCount >= governance.Threshold {
+		_, ndidNodeID := app.state.db.Get(prefixKey([]byte("MasterNDID")))
+		result := app.executeProposal(proposal.Method, proposal.Params, string(ndidNodeID))
+		if result.Code == code.OK {
+			proposal.Status = proposalStatusExecuted
+		} else {
+			proposal.Status = proposalStatusFailed
+		}
+		proposal.ResultCode = result.Code
+		proposal.ResultLog = result.Log
+		app.DeleteStateDB([]byte(proposalExpireKey(proposal.ExpireBlock, proposal.ProposalId)))
+	}
+	key := "Proposal" + "|" + proposal.ProposalId
+	value, err := utils.ProtoDeterministicMarshal(proposal)
+	if err != nil {
+		return app.ReturnDeliverTxLog(code.MarshalError, err.Error(), "")
+	}
+	app.SetStateDB([]byte(key), []byte(value))
+	result := app.ReturnDeliverTxLog(code.OK, "success", "")
+	result.Tags = append(result.Tags,
+		cmn.KVPair{Key: []byte(tagProposalID), Value: []byte(proposal.ProposalId)},
+		cmn.KVPair{Key: []byte(tagProposalStatus), Value: []byte(proposal.Status)},
+	)
+	return result
+}
+
+// executeProposal checks and delivers the proposed method as NDID. Its
+// writes are rolled back unless it succeeds, while the approval that
+// executed it is still kept.
+func (app *DIDApplication) executeProposal(method string, param string, nodeID string) types.ResponseDeliverTx {
+	checkResult := app.callCheckTx(method, param, nodeID)
+	if checkResult.Code != code.OK {
+		return app.ReturnDeliverTxLog(checkResult.Code, checkResult.Log, "")
+	}
+	app.beginJournal()
+	committed := false
+	defer func() {
+		// Roll back every write of a failed method, including on panic
+		if !committed {
+			app.rollbackJournal()
+		}
+	}()
+	result := app.callDeliverTx(method, param, nodeID)
+	if result.Code != code.OK {
+		return result
+	}
+	app.commitJournal()
+	committed = true
+	return result
+}
+
+// lapseExpiredProposals marks pending proposals which expire at or below height as lapsed
+func (app *DIDApplication) lapseExpiredProposals(height int64) (tags []cmn.KVPair) {
+	startKey := prefixKey([]byte("ProposalExpire" + "|"))
+	endKey := prefixKey([]byte(proposalExpireKey(height+1, "")))
+	var expireKeys [][]byte
+	var proposalIDs []string
+	app.state.db.IterateRange(startKey, endKey, true, func(key []byte, value []byte) bool {
+		expireKeys = append(expireKeys, key)
+		proposalIDs = append(proposalIDs, string(value))
+		return false
+	})
+	for index, proposalID := range proposalIDs {
+		app.state.db.Remove(expireKeys[index])
+		key := "Proposal" + "|" + proposalID
+		_, value := app.state.db.Get(prefixKey([]byte(key)))
+		if value == nil {
+			continue
+		}
+		var proposal data.Proposal
+		err := proto.Unmarshal(value, &proposal)
+		if err != nil || proposal.Status != proposalStatusPending {
+			continue
+		}
+		proposal.Status = proposalStatusLapsed
+		proposalValue, err := utils.ProtoDeterministicMarshal(&proposal)
+		if err != nil {
+			continue
+		}
+		app.SetStateDB([]byte(key), []byte(proposalValue))
+		tags = append(tags, cmn.KVPair{Key: []byte(tagProposalLapsed), Value: []byte(proposalID)})
+	}
+	return tags
+}
+
+func (app *DIDApplication) getGovernanceInfo(param string, height int64) types.ResponseQuery {
+	app.logger.Infof("GetGovernance, Parameter: %s", param)
+	key := "Governance"
+	value := app.GetVersionedStateDB([]byte(key), height)
+	var result GetGovernanceResult
+	result.Keys = make([]GovernanceKey, 0)
+	if value != nil {
+		var governance data.Governance
+		err := proto.Unmarshal(value, &governance)
+		if err != nil {
+			return app.ReturnQuery(nil, err.Error(), height)
+		}
+		for _, key := range governance.Keys {
+			result.Keys = append(result.Keys, GovernanceKey{key.KeyId, key.PublicKey})
+		}
+		result.Threshold = governance.Threshold
+		result.ProposalTimeoutBlock = governance.ProposalTimeoutBlock
+	}
+	returnValue, err := json.Marshal(result)
+	if err != nil {
+		return app.ReturnQuery(nil, err.Error(), height)
+	}
+	return app.ReturnQuery(returnValue, "success", height)
+}
+
+func (app *DIDApplication) getProposal(param string, height int64) types.ResponseQuery {
+	app.logger.Infof("GetProposal, Parameter: %s", param)
+	var funcParam GetProposalParam
+	err := json.Unmarshal([]byte(param), &funcParam)
+	if err != nil {
+		return app.ReturnQuery(nil, err.Error(), height)
+	}
+	key := "Proposal" + "|" + funcParam.ProposalID
+	value := app.GetVersionedStateDB([]byte(key), height)
+	if value == nil {
+		return app.ReturnQuery([]byte("{}"), "not found", height)
+	}
+	var proposal data.Proposal
+	err = proto.Unmarshal(value, &proposal)
+	if err != nil {
+		return app.ReturnQuery(nil, err.Error(), height)
+	}
+	var result GetProposalResult
+	result.ProposalID = proposal.ProposalId
+	result.Method = proposal.Method
+	result.Params = proposal.Params
+	result.Proposer = proposal.Proposer
+	result.Approvers = proposal.Approvers
+	result.ExpireBlock = proposal.ExpireBlock
+	result.Status = proposal.Status
+	result.ResultCode = proposal.ResultCode
+	result.ResultLog = proposal.ResultLog
+	returnValue, err := json.Marshal(result)
+	if err != nil {
+		return app.ReturnQuery(nil, err.Error(), height)
+	}
+	return app.ReturnQuery(returnValue, "success", height)
+}
@@ -62,17 +62,13 @@ func (app *DIDApplication) registerAccessor(param string, nodeID string) types.R
 	if chkAccessorGroupKeyExists != nil {
 		return app.ReturnDeliverTxLog(code.DuplicateAccessorGroupID, "Duplicate Accessor Group ID", "")
 	}
+	app.SetStateDB([]byte(accessorKey), []byte(accessorJSON))
+	app.SetStateDB([]byte(accessorGroupKey), []byte(accessorGroup))
 	// Add relation AccessorGroupID -> AccessorID
-	accessorInGroupKey := "AccessorInGroup" + "|" + funcParam.AccessorGroupID
-	var accessorInGroup data.AccessorInGroup
-	accessorInGroup.Accessors = append(accessorInGroup.Accessors, funcParam.AccessorID)
-	accessorInGroupProtobuf, err := utils.ProtoDeterministicMarshal(&accessorInGroup)
+	err = app.setListItem(accessorInGroupList(funcParam.AccessorGroupID), funcParam.AccessorID, []byte(funcParam.AccessorID))
 	if err != nil {
 		return app.ReturnDeliverTxLog(code.MarshalError, err.Error(), "")
 	}
-	app.SetStateDB([]byte(accessorKey), []byte(accessorJSON))
-	app.SetStateDB([]byte(accessorGroupKey), []byte(accessorGroup))
-	app.SetStateDB([]byte(accessorInGroupKey), []byte(accessorInGroupProtobuf))
 	return app.ReturnDeliverTxLog(code.OK, "success", "")
 }
 
@@ -164,25 +160,16 @@ func (app *DIDApplication) addAccessorMethod(param string, nodeID string) types.
 	if err != nil {
 		return app.ReturnDeliverTxLog(code.MarshalError, err.Error(), "")
 	}
-	// Add relation AccessorGroupID -> AccessorID
-	accessorInGroupKey := "AccessorInGroup" + "|" + funcParam.AccessorGroupID
-	_, accessorInGroupKeyValue := app.state.db.Get(prefixKey([]byte(accessorInGroupKey)))
-	var accessors data.AccessorInGroup
-	err = proto.Unmarshal(accessorInGroupKeyValue, &accessors)
-	if err != nil {
-		return app.ReturnDeliverTxLog(code.UnmarshalError, err.Error(), "")
-	}
-	accessors.Accessors = append(accessors.Accessors, funcParam.AccessorID)
-	accessorInGroupProtobuf, err := utils.ProtoDeterministicMarshal(&accessors)
+	requestProtobuf, err := utils.ProtoDeterministicMarshal(&request)
 	if err != nil {
 		return app.ReturnDeliverTxLog(code.MarshalError, err.Error(), "")
 	}
-	requestProtobuf, err := utils.ProtoDeterministicMarshal(&request)
+	app.SetStateDB([]byte(requestKey), []byte(requestProtobuf))
+	// Add relation AccessorGroupID -> AccessorID
+	err = app.setListItem(accessorInGroupList(funcParam.AccessorGroupID), funcParam.AccessorID, []byte(funcParam.AccessorID))
 	if err != nil {
 		return app.ReturnDeliverTxLog(code.MarshalError, err.Error(), "")
 	}
-	app.SetStateDB([]byte(requestKey), []byte(requestProtobuf))
-	app.SetStateDB([]byte(accessorInGroupKey), []byte(accessorInGroupProtobuf))
 	app.SetStateDB([]byte(accessorKey), []byte(accessorJSON))
 	return app.ReturnDeliverTxLog(code.OK, "success", "")
 }
@@ -224,68 +211,37 @@ func (app *DIDApplication) registerIdentity(param string, nodeID string) types.R
 	timeOutBlockInStateDB := timeOut.TimeOutBlock
 	// If validate passed then add Msq Destination
 	for _, user := range funcParam.Users {
-		key := "MsqDestination" + "|" + user.HashID
-		_, chkExists := app.state.db.Get(prefixKey([]byte(key)))
-		if chkExists != nil {
-			var nodes data.MsqDesList
-			err = proto.Unmarshal([]byte(chkExists), &nodes)
-			if err != nil {
-				return app.ReturnDeliverTxLog(code.UnmarshalError, err.Error(), "")
-			}
-			timeoutBlock := app.CurrentBlock + timeOutBlockInStateDB
-			var newNode data.Node
-			newNode.Ial = user.Ial
-			newNode.NodeId = nodeID
-			newNode.Active = true
-			newNode.First = user.First
-			newNode.TimeoutBlock = timeoutBlock
-			if !user.First {
-				newNode.TimeoutBlock = 0
-			}
-			// Check duplicate before add
-			chkDup := false
-			for _, node := range nodes.Nodes {
-				if &newNode == node {
-					chkDup = true
-					break
-				}
-			}
-			// Check first
-			if user.First {
-				for _, node := range nodes.Nodes {
-					if node.TimeoutBlock != 0 {
-						if node.TimeoutBlock > app.CurrentBlock {
-							return app.ReturnDeliverTxLog(code.NotFirstIdP, "This node is not first IdP", "")
-						}
+		nodes, err := app.getMsqDestination(user.HashID)
+		if err != nil {
+			return app.ReturnDeliverTxLog(code.UnmarshalError, err.Error(), "")
+		}
+		timeoutBlock := app.CurrentBlock + timeOutBlockInStateDB
+		var newNode data.Node
+		newNode.Ial = user.Ial
+		newNode.NodeId = nodeID
+		newNode.Active = true
+		newNode.First = user.First
+		newNode.TimeoutBlock = timeoutBlock
+		if !user.First {
+			newNode.TimeoutBlock = 0
+		}
+		// Check first
+		if user.First {
+			for _, node := range nodes {
+				if node.TimeoutBlock != 0 {
+					if node.TimeoutBlock > app.CurrentBlock {
+						return app.ReturnDeliverTxLog(code.NotFirstIdP, "This node is not first IdP", "")
 					}
 				}
 			}
-			if chkDup == false {
-				nodes.Nodes = append(nodes.Nodes, &newNode)
-				value, err := utils.ProtoDeterministicMarshal(&nodes)
-				if err != nil {
-					return app.ReturnDeliverTxLog(code.MarshalError, err.Error(), "")
-				}
-				app.SetStateDB([]byte(key), []byte(value))
-			}
-		} else {
-			var nodes data.MsqDesList
-			timeoutBlock := app.CurrentBlock + timeOutBlockInStateDB
-			var newNode data.Node
-			newNode.Ial = user.Ial
-			newNode.NodeId = nodeID
-			newNode.Active = true
-			newNode.First = user.First
-			newNode.TimeoutBlock = timeoutBlock
-			if !user.First {
-				newNode.TimeoutBlock = 0
-			}
-			nodes.Nodes = append(nodes.Nodes, &newNode)
-			value, err := utils.ProtoDeterministicMarshal(&nodes)
-			if err != nil {
-				return app.ReturnDeliverTxLog(code.MarshalError, err.Error(), "")
-			}
-			app.SetStateDB([]byte(key), []byte(value))
+		}
+		value, err := utils.ProtoDeterministicMarshal(&newNode)
+		if err != nil {
+			return app.ReturnDeliverTxLog(code.MarshalError, err.Error(), "")
+		}
+		err = app.setListItem(msqDestinationList(user.HashID), nodeID, value)
+		if err != nil {
+			return app.ReturnDeliverTxLog(code.MarshalError, err.Error(), "")
 		}
 	}
 	return app.ReturnDeliverTxLog(code.OK, "success", "")
@@ -358,7 +314,7 @@ func (app *DIDApplication) createIdpResponse(param string, nodeID string) types.
 		return app.ReturnDeliverTxLog(code.RequestIsClosed, "Can't response a request that's closed", "")
 	}
 	// Check IsTimedOut
-	if request.TimedOut {
+	if request.TimedOut || app.isRequestExpired(&request) {
 		return app.ReturnDeliverTxLog(code.RequestIsTimedOut, "Can't response a request that's timed out", "")
 	}
 	// Check identity proof if mode == 3
@@ -419,30 +375,30 @@ func (app *DIDApplication) updateIdentity(param string, nodeID string) types.Res
 	if funcParam.Ial > nodeDetail.MaxIal {
 		return app.ReturnDeliverTxLog(code.IALError, "New IAL is greater than max IAL", "")
 	}
-	msqDesKey := "MsqDestination" + "|" + funcParam.HashID
-	_, msqDesValue := app.state.db.Get(prefixKey([]byte(msqDesKey)))
+	msqDesValue, err := app.getListItem(msqDestinationList(funcParam.HashID), nodeID)
+	if err != nil {
+		return app.ReturnDeliverTxLog(code.UnmarshalError, err.Error(), "")
+	}
 	if msqDesValue == nil {
 		return app.ReturnDeliverTxLog(code.HashIDNotFound, "Hash ID not found", "")
 	}
-	var msqDes data.MsqDesList
+	var msqDes data.Node
 	err = proto.Unmarshal([]byte(msqDesValue), &msqDes)
 	if err != nil {
 		return app.ReturnDeliverTxLog(code.UnmarshalError, err.Error(), "")
 	}
 	// Selective update
 	if funcParam.Ial > 0 {
-		for index := range msqDes.Nodes {
-			if msqDes.Nodes[index].NodeId == nodeID {
-				msqDes.Nodes[index].Ial = funcParam.Ial
-				break
-			}
-		}
+		msqDes.Ial = funcParam.Ial
 	}
 	msqDesJSON, err := utils.ProtoDeterministicMarshal(&msqDes)
 	if err != nil {
 		return app.ReturnDeliverTxLog(code.MarshalError, err.Error(), "")
 	}
-	app.SetStateDB([]byte(msqDesKey), []byte(msqDesJSON))
+	err = app.setListItem(msqDestinationList(funcParam.HashID), nodeID, msqDesJSON)
+	if err != nil {
+		return app.ReturnDeliverTxLog(code.MarshalError, err.Error(), "")
+	}
 	return app.ReturnDeliverTxLog(code.OK, "success", "")
 }
 
@@ -493,36 +449,31 @@ func (app *DIDApplication) clearRegisterIdentityTimeout(param string, nodeID str
 	if err != nil {
 		return app.ReturnDeliverTxLog(code.UnmarshalError, err.Error(), "")
 	}
-	msqDesKey := "MsqDestination" + "|" + funcParam.HashID
-	_, msqDesValue := app.state.db.Get(prefixKey([]byte(msqDesKey)))
+	msqDesValue, err := app.getListItem(msqDestinationList(funcParam.HashID), nodeID)
+	if err != nil {
+		return app.ReturnDeliverTxLog(code.UnmarshalError, err.Error(), "")
+	}
 	if msqDesValue == nil {
 		return app.ReturnDeliverTxLog(code.HashIDNotFound, "Hash ID not found", "")
 	}
-	var nodes data.MsqDesList
-	err = proto.Unmarshal([]byte(msqDesValue), &nodes)
+	var node data.Node
+	err = proto.Unmarshal([]byte(msqDesValue), &node)
 	if err != nil {
 		return app.ReturnDeliverTxLog(code.UnmarshalError, err.Error(), "")
 	}
 	// Check is not timeout
-	for index := range nodes.Nodes {
-		if nodes.Nodes[index].NodeId == nodeID {
-			if nodes.Nodes[index].TimeoutBlock <= app.CurrentBlock {
-				return app.ReturnDeliverTxLog(code.RegisterIdentityIsTimedOut, "Cannot clear register identity that is timed out", "")
-			}
-			break
-		}
+	if node.TimeoutBlock <= app.CurrentBlock {
+		return app.ReturnDeliverTxLog(code.RegisterIdentityIsTimedOut, "Cannot clear register identity that is timed out", "")
 	}
-	for index := range nodes.Nodes {
-		if nodes.Nodes[index].NodeId == nodeID {
-			nodes.Nodes[index].TimeoutBlock = 0
-			break
-		}
+	node.TimeoutBlock = 0
+	msqDesJSON, err := utils.ProtoDeterministicMarshal(&node)
+	if err != nil {
+		return app.ReturnDeliverTxLog(code.MarshalError, err.Error(), "")
 	}
-	msqDesJSON, err := utils.ProtoDeterministicMarshal(&nodes)
+	err = app.setListItem(msqDestinationList(funcParam.HashID), nodeID, msqDesJSON)
 	if err != nil {
 		return app.ReturnDeliverTxLog(code.MarshalError, err.Error(), "")
 	}
-	app.SetStateDB([]byte(msqDesKey), []byte(msqDesJSON))
 	return app.ReturnDeliverTxLog(code.OK, "success", "")
 }
 
@@ -635,25 +586,6 @@ func (app *DIDApplication) revokeAccessorMethod(param string, nodeID string) typ
 		if err != nil {
 			return app.ReturnDeliverTxLog(code.MarshalError, err.Error(), "")
 		}
-		// Remove AccessorID from AccessorInGroup
-		accessorInGroupKey := "AccessorInGroup" + "|" + accessor.AccessorGroupId
-		_, accessorInGroupKeyValue := app.state.db.Get(prefixKey([]byte(accessorInGroupKey)))
-		var accessors data.AccessorInGroup
-		err = proto.Unmarshal(accessorInGroupKeyValue, &accessors)
-		if err != nil {
-			return app.ReturnDeliverTxLog(code.UnmarshalError, err.Error(), "")
-		}
-		for i, accessorIDInList := range accessors.Accessors {
-			if accessorIDInList == accessorID {
-				copy(accessors.Accessors[i:], accessors.Accessors[i+1:])
-				accessors.Accessors[len(accessors.Accessors)-1] = ""
-				accessors.Accessors = accessors.Accessors[:len(accessors.Accessors)-1]
-			}
-		}
-		accessorInGroupProtobuf, err := utils.ProtoDeterministicMarshal(&accessors)
-		if err != nil {
-			return app.ReturnDeliverTxLog(code.MarshalError, err.Error(), "")
-		}
 		// Add accessor ID to revokedAccessorInGroup
 		revokedAccessorInGroupKey := "RevokedAccessorInGroup" + "|" + accessor.AccessorGroupId
 		_, revokedAccessorInGroupValue := app.state.db.Get(prefixKey([]byte(revokedAccessorInGroupKey)))
@@ -668,7 +600,11 @@ func (app *DIDApplication) revokeAccessorMethod(param string, nodeID string) typ
 			return app.ReturnDeliverTxLog(code.MarshalError, err.Error(), "")
 		}
 		app.SetStateDB([]byte(accessorKey), []byte(accessorProtobuf))
-		app.SetStateDB([]byte(accessorInGroupKey), []byte(accessorInGroupProtobuf))
+		// Remove AccessorID from AccessorInGroup
+		err = app.removeListItem(accessorInGroupList(accessor.AccessorGroupId), accessorID)
+		if err != nil {
+			return app.ReturnDeliverTxLog(code.UnmarshalError, err.Error(), "")
+		}
 		app.SetStateDB([]byte(revokedAccessorInGroupKey), []byte(revokedAccessorInGroupProtobuf))
 	}
 	requestProtobuf, err := utils.ProtoDeterministicMarshal(&request)
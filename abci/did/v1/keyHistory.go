@@ -0,0 +1,123 @@
+/**
+ * Copyright (c) 2018, 2019 National Digital ID COMPANY LIMITED
+ *
+ * This file is part of NDID software.
+ *
+ * NDID is the free software: you can redistribute it and/or modify it under
+ * the terms of the Affero GNU General Public License as published by the
+ * Free Software Foundation, either version 3 of the License, or any later
+ * version.
+ *
+ * NDID is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.
+ * See the Affero GNU General Public License for more details.
+ *
+ * You should have received a copy of the Affero GNU General Public License
+ * along with the NDID source code. If not, see https://www.gnu.org/licenses/agpl.txt.
+ *
+ * Please contact info@ndid.co.th for any further questions
+ *
+ */
+
+package did
+
+import (
+	"encoding/json"
+
+	"github.com/golang/protobuf/proto"
+	"github.com/ndidplatform/smart-contract/abci/utils"
+	"github.com/ndidplatform/smart-contract/protos/data"
+	"github.com/tendermint/tendermint/abci/types"
+)
+
+// Each key in node public key history is valid from its activation block
+// until the block before its revocation block. Revocation block 0 means the key is still active.
+
+func (app *DIDApplication) getNodeKeyHistory(nodeID string, height int64) (data.NodeKeyHistory, error) {
+	historyKey := "NodeKeyHistory" + "|" + nodeID
+	nodeDetailKey := "NodeID" + "|" + nodeID
+	historyValue := app.GetVersionedStateDB([]byte(historyKey), height)
+	nodeDetailValue := app.GetVersionedStateDB([]byte(nodeDetailKey), height)
+	return unmarshalNodeKeyHistory(historyValue, nodeDetailValue)
+}
+
+func unmarshalNodeKeyHistory(historyValue []byte, nodeDetailValue []byte) (history data.NodeKeyHistory, err error) {
+	if historyValue != nil {
+		err = proto.Unmarshal(historyValue, &history)
+		return history, err
+	}
+	// Node registered before key history was recorded, its current key has unknown activation block
+	if nodeDetailValue == nil {
+		return history, nil
+	}
+	var nodeDetail data.NodeDetail
+	err = proto.Unmarshal(nodeDetailValue, &nodeDetail)
+	if err != nil {
+		return history, err
+	}
+	var nodeKey data.NodeKey
+	nodeKey.PublicKey = nodeDetail.PublicKey
+	history.Keys = append(history.Keys, &nodeKey)
+	return history, nil
+}
+
+// setNodePublicKey revokes the node's current public key and activates publicKey at the current block.
+// It must be called before the new key is written to NodeDetail.
+func (app *DIDApplication) setNodePublicKey(nodeID string, publicKey string) error {
+	historyKey := "NodeKeyHistory" + "|" + nodeID
+	nodeDetailKey := "NodeID" + "|" + nodeID
+	_, historyValue := app.state.db.Get(prefixKey([]byte(historyKey)))
+	_, nodeDetailValue := app.state.db.Get(prefixKey([]byte(nodeDetailKey)))
+	history, err := unmarshalNodeKeyHistory(historyValue, nodeDetailValue)
+	if err != nil {
+		return err
+	}
+	if len(history.Keys) > 0 {
+		lastKey := history.Keys[len(history.Keys)-1]
+		if lastKey.PublicKey == publicKey && lastKey.RevocationBlock == 0 {
+			return nil
+		}
+		lastKey.RevocationBlock = app.CurrentBlock
+	}
+	var nodeKey data.NodeKey
+	nodeKey.PublicKey = publicKey
+	nodeKey.ActivationBlock = app.CurrentBlock
+	history.Keys = append(history.Keys, &nodeKey)
+	value, err := utils.ProtoDeterministicMarshal(&history)
+	if err != nil {
+		return err
+	}
+	app.SetStateDB([]byte(historyKey), []byte(value))
+	return nil
+}
+
+func (app *DIDApplication) getNodePublicKeyHistory(param string, height int64) types.ResponseQuery {
+	app.logger.Infof("GetNodePublicKeyHistory, Parameter: %s", param)
+	var funcParam GetNodePublicKeyHistoryParam
+	err := json.Unmarshal([]byte(param), &funcParam)
+	if err != nil {
+		return app.ReturnQuery(nil, err.Error(), height)
+	}
+	history, err := app.getNodeKeyHistory(funcParam.NodeID, height)
+	if err != nil {
+		return app.ReturnQuery(nil, err.Error(), height)
+	}
+	if len(history.Keys) == 0 {
+		return app.ReturnQuery([]byte("{}"), "not found", height)
+	}
+	var result GetNodePublicKeyHistoryResult
+	result.History = make([]NodePublicKeyHistory, 0)
+	for _, nodeKey := range history.Keys {
+		result.History = append(result.History, NodePublicKeyHistory{
+			nodeKey.PublicKey,
+			nodeKey.ActivationBlock,
+			nodeKey.RevocationBlock,
+		})
+	}
+	value, err := json.Marshal(result)
+	if err != nil {
+		return app.ReturnQuery(nil, err.Error(), height)
+	}
+	return app.ReturnQuery(value, "success", height)
+}
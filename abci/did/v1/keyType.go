@@ -0,0 +1,151 @@
+/**
+ * Copyright (c) 2018, 2019 National Digital ID COMPANY LIMITED
+ *
+ * This file is part of NDID software.
+ *
+ * NDID is the free software: you can redistribute it and/or modify it under
+ * the terms of the Affero GNU General Public License as published by the
+ * Free Software Foundation, either version 3 of the License, or any later
+ * version.
+ *
+ * NDID is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.
+ * See the Affero GNU General Public License for more details.
+ *
+ * You should have received a copy of the Affero GNU General Public License
+ * along with the NDID source code. If not, see https://www.gnu.org/licenses/agpl.txt.
+ *
+ * Please contact info@ndid.co.th for any further questions
+ *
+ */
+
+package did
+
+import (
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/ed25519"
+	"crypto/elliptic"
+	"crypto/rsa"
+	"encoding/asn1"
+	"errors"
+	"math/big"
+	"reflect"
+
+	"github.com/ndidplatform/smart-contract/abci/code"
+)
+
+// Signature schemes a node can choose for its RSA keys. Empty means signatureSchemePKCS1v15.
+const (
+	signatureSchemePKCS1v15 = "PKCS1v15"
+	signatureSchemePSS      = "PSS"
+)
+
+var isSignatureScheme = map[string]bool{
+	signatureSchemePKCS1v15: true,
+	signatureSchemePSS:      true,
+}
+
+// keyType describes how a public key algorithm is validated and how it verifies signatures.
+// message passed to verify is the base64 encoded (method + param + nonce) that the sender signed.
+// scheme is the node's signature scheme, key types with a single scheme ignore it.
+type keyType struct {
+	name   string
+	check  func(pubKey crypto.PublicKey) (returnCode uint32, log string)
+	verify func(pubKey crypto.PublicKey, message []byte, signature []byte, scheme string) error
+}
+
+var keyTypes = make(map[reflect.Type]keyType)
+
+func registerKeyType(pubKey crypto.PublicKey, kt keyType) {
+	keyTypes[reflect.TypeOf(pubKey)] = kt
+}
+
+func getKeyType(pubKey crypto.PublicKey) (keyType, bool) {
+	kt, ok := keyTypes[reflect.TypeOf(pubKey)]
+	return kt, ok
+}
+
+func init() {
+	registerKeyType(&rsa.PublicKey{}, keyType{
+		name:   "RSA",
+		check:  checkRSAPubKey,
+		verify: verifyRSASignature,
+	})
+	registerKeyType(&ecdsa.PublicKey{}, keyType{
+		name:   "ECDSA",
+		check:  checkECDSAPubKey,
+		verify: verifyECDSASignature,
+	})
+	registerKeyType(ed25519.PublicKey{}, keyType{
+		name:   "Ed25519",
+		check:  checkEd25519PubKey,
+		verify: verifyEd25519Signature,
+	})
+}
+
+func sha256Hash(message []byte) []byte {
+	newhash := crypto.SHA256
+	hasher := newhash.New()
+	hasher.Write(message)
+	return hasher.Sum(nil)
+}
+
+func checkRSAPubKey(pubKey crypto.PublicKey) (returnCode uint32, log string) {
+	if pubKey.(*rsa.PublicKey).N.BitLen() < 2048 {
+		return code.RSAKeyLengthTooShort, "RSA key length is too short. Must be at least 2048-bit."
+	}
+	return code.OK, ""
+}
+
+func verifyRSASignature(pubKey crypto.PublicKey, message []byte, signature []byte, scheme string) error {
+	if scheme == signatureSchemePSS {
+		opts := &rsa.PSSOptions{SaltLength: rsa.PSSSaltLengthAuto, Hash: crypto.SHA256}
+		return rsa.VerifyPSS(pubKey.(*rsa.PublicKey), crypto.SHA256, sha256Hash(message), signature, opts)
+	}
+	return rsa.VerifyPKCS1v15(pubKey.(*rsa.PublicKey), crypto.SHA256, sha256Hash(message), signature)
+}
+
+func checkECDSAPubKey(pubKey crypto.PublicKey) (returnCode uint32, log string) {
+	if pubKey.(*ecdsa.PublicKey).Curve != elliptic.P256() {
+		return code.UnsupportedKeyType, "Unsupported ECDSA curve. Only P-256 is allowed."
+	}
+	return code.OK, ""
+}
+
+// verifyECDSASignature expects an ASN.1 DER encoded signature over SHA-256 of message
+func verifyECDSASignature(pubKey crypto.PublicKey, message []byte, signature []byte, scheme string) error {
+	var sig struct {
+		R, S *big.Int
+	}
+	rest, err := asn1.Unmarshal(signature, &sig)
+	if err != nil {
+		return err
+	}
+	if len(rest) != 0 {
+		return errors.New("trailing data after ECDSA signature")
+	}
+	if sig.R == nil || sig.S == nil || sig.R.Sign() <= 0 || sig.S.Sign() <= 0 {
+		return errors.New("invalid ECDSA signature")
+	}
+	if !ecdsa.Verify(pubKey.(*ecdsa.PublicKey), sha256Hash(message), sig.R, sig.S) {
+		return errors.New("ECDSA verification error")
+	}
+	return nil
+}
+
+func checkEd25519PubKey(pubKey crypto.PublicKey) (returnCode uint32, log string) {
+	if len(pubKey.(ed25519.PublicKey)) != ed25519.PublicKeySize {
+		return code.InvalidKeyFormat, "Invalid Ed25519 key length."
+	}
+	return code.OK, ""
+}
+
+// verifyEd25519Signature expects a signature over message itself since Ed25519 hashes internally
+func verifyEd25519Signature(pubKey crypto.PublicKey, message []byte, signature []byte, scheme string) error {
+	if !ed25519.Verify(pubKey.(ed25519.PublicKey), message, signature) {
+		return errors.New("Ed25519 verification error")
+	}
+	return nil
+}
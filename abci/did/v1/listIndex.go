@@ -0,0 +1,611 @@
+/**
+ * Copyright (c) 2018, 2019 National Digital ID COMPANY LIMITED
+ *
+ * This file is part of NDID software.
+ *
+ * NDID is the free software: you can redistribute it and/or modify it under
+ * the terms of the Affero GNU General Public License as published by the
+ * Free Software Foundation, either version 3 of the License, or any later
+ * version.
+ *
+ * NDID is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.
+ * See the Affero GNU General Public License for more details.
+ *
+ * You should have received a copy of the Affero GNU General Public License
+ * along with the NDID source code. If not, see https://www.gnu.org/licenses/agpl.txt.
+ *
+ * Please contact info@ndid.co.th for any further questions
+ *
+ */
+
+package did
+
+import (
+	"bytes"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/golang/protobuf/proto"
+	"github.com/ndidplatform/smart-contract/abci/utils"
+	"github.com/ndidplatform/smart-contract/protos/data"
+)
+
+// From ListIndexVersion on, lists are stored with one state key per item,
+// "<list>|<item>", so adding or updating an item does not rewrite the whole
+// list. Items keep the order they were added in: "<list>#<seq>" maps the
+// sequence number of each item to the item, and "<list>@<item>" maps an item
+// back to its sequence number so a scan can resume from a cursor. Earlier app
+// versions store each list as one value under "<list>", which readers keep
+// using until the upgrade moves it to per-item keys.
+const (
+	idpListKey       = "IdPList"
+	rpListKey        = "rpList"
+	asListKey        = "asList"
+	allListKey       = "allList"
+	namespaceListKey = "AllNamespace"
+	serviceListKey   = "AllService"
+)
+
+func listItemKey(list string, item string) string {
+	return list + "|" + item
+}
+
+// listEndKey is the first key after every item key of list
+func listEndKey(list string) string {
+	return list + "}"
+}
+
+func listOrderKey(list string, seq int64) string {
+	return list + "#" + fmt.Sprintf("%020d", seq)
+}
+
+// listOrderEndKey is the first key after every order key of list
+func listOrderEndKey(list string) string {
+	return list + "$"
+}
+
+func listPositionKey(list string, item string) string {
+	return list + "@" + item
+}
+
+// msqDestinationList returns the list of IdPs that registered hashID
+func msqDestinationList(hashID string) string {
+	return "MsqDestination" + "|" + hashID
+}
+
+// accessorInGroupList returns the list of accessors in accessorGroupID
+func accessorInGroupList(accessorGroupID string) string {
+	return "AccessorInGroup" + "|" + accessorGroupID
+}
+
+// listsIndexed reports whether the app version being run stores lists one key per item
+func (app *DIDApplication) listsIndexed() bool {
+	version, err := strconv.Atoi(app.appVersion)
+	if err != nil {
+		return false
+	}
+	indexVersion, _ := strconv.Atoi(ListIndexVersion)
+	return version >= indexVersion
+}
+
+// setListItem sets the value of item in list, adding item after the last
+// item when it is not in the list yet
+func (app *DIDApplication) setListItem(list string, item string, value []byte) error {
+	if !app.listsIndexed() {
+		return app.setListBlobItem(list, item, value)
+	}
+	_, position := app.state.db.Get(prefixKey([]byte(listPositionKey(list, item))))
+	if position == nil {
+		seq := app.nextListSeq(list)
+		app.SetStateDB([]byte(listOrderKey(list, seq)), []byte(item))
+		app.SetStateDB([]byte(listPositionKey(list, item)), []byte(strconv.FormatInt(seq, 10)))
+	}
+	app.SetStateDB([]byte(listItemKey(list, item)), value)
+	return nil
+}
+
+// removeListItem removes item from list, keeping the order of the other items
+func (app *DIDApplication) removeListItem(list string, item string) error {
+	if !app.listsIndexed() {
+		return app.removeListBlobItem(list, item)
+	}
+	_, position := app.state.db.Get(prefixKey([]byte(listPositionKey(list, item))))
+	if position == nil {
+		return nil
+	}
+	seq, err := strconv.ParseInt(string(position), 10, 64)
+	if err != nil {
+		return err
+	}
+	app.DeleteStateDB([]byte(listOrderKey(list, seq)))
+	app.DeleteStateDB([]byte(listPositionKey(list, item)))
+	app.DeleteStateDB([]byte(listItemKey(list, item)))
+	return nil
+}
+
+// removeListBlobItem removes item from the list blob
+func (app *DIDApplication) removeListBlobItem(list string, item string) error {
+	_, blob := app.state.db.Get(prefixKey([]byte(list)))
+	if blob == nil {
+		return nil
+	}
+	items, values, err := decodeListBlob(list, blob)
+	if err != nil {
+		return err
+	}
+	var keptItems []string
+	var keptValues [][]byte
+	for index := range items {
+		if items[index] != item {
+			keptItems = append(keptItems, items[index])
+			keptValues = append(keptValues, values[index])
+		}
+	}
+	blob, err = encodeListBlob(list, keptItems, keptValues)
+	if err != nil {
+		return err
+	}
+	app.SetStateDB([]byte(list), blob)
+	return nil
+}
+
+// setListBlobItem sets the value of item in the list blob
+func (app *DIDApplication) setListBlobItem(list string, item string, value []byte) error {
+	var items []string
+	var values [][]byte
+	_, blob := app.state.db.Get(prefixKey([]byte(list)))
+	if blob != nil {
+		var err error
+		items, values, err = decodeListBlob(list, blob)
+		if err != nil {
+			return err
+		}
+	}
+	found := false
+	for index := range items {
+		if items[index] == item {
+			values[index] = value
+			found = true
+			break
+		}
+	}
+	if !found {
+		items = append(items, item)
+		values = append(values, value)
+	}
+	blob, err := encodeListBlob(list, items, values)
+	if err != nil {
+		return err
+	}
+	app.SetStateDB([]byte(list), blob)
+	return nil
+}
+
+// getListItem returns the value of item in list in the latest state
+func (app *DIDApplication) getListItem(list string, item string) ([]byte, error) {
+	_, blob := app.state.db.Get(prefixKey([]byte(list)))
+	if blob != nil {
+		return listBlobItem(list, blob, item)
+	}
+	_, value := app.state.db.Get(prefixKey([]byte(listItemKey(list, item))))
+	return value, nil
+}
+
+// getVersionedListItem returns the value of item in list at height
+func (app *DIDApplication) getVersionedListItem(list string, item string, height int64) ([]byte, error) {
+	blob := app.GetVersionedStateDB([]byte(list), height)
+	if blob != nil {
+		return listBlobItem(list, blob, item)
+	}
+	return app.GetVersionedStateDB([]byte(listItemKey(list, item)), height), nil
+}
+
+func listBlobItem(list string, blob []byte, item string) ([]byte, error) {
+	items, values, err := decodeListBlob(list, blob)
+	if err != nil {
+		return nil, err
+	}
+	for index := range items {
+		if items[index] == item {
+			return values[index], nil
+		}
+	}
+	return nil, nil
+}
+
+// nextListSeq returns the sequence number of the next item added to list
+func (app *DIDApplication) nextListSeq(list string) int64 {
+	startKey := prefixKey([]byte(list + "#"))
+	endKey := prefixKey([]byte(listOrderEndKey(list)))
+	var seq int64
+	app.state.db.IterateRange(startKey, endKey, false, func(key []byte, value []byte) bool {
+		last, err := strconv.ParseInt(string(key[len(startKey):]), 10, 64)
+		if err == nil {
+			seq = last + 1
+		}
+		return true
+	})
+	return seq
+}
+
+// listIterator reads items of a list at a height in list order, in chunks of
+// size items (all items when size is 0), so a scan stops reading the state
+// soon after the caller stops asking for items. When a cursor is given, the
+// scan starts at the cursor item and yields nothing if the cursor is not in
+// the list.
+type listIterator struct {
+	app    *DIDApplication
+	list   string
+	cursor string
+	height int64
+	size   int
+	start  []byte
+	items  []string
+	values [][]byte
+	index  int
+	done   bool
+	err    error
+}
+
+func (app *DIDApplication) newVersionedListIterator(list string, cursor string, height int64, size int) *listIterator {
+	return &listIterator{
+		app:    app,
+		list:   list,
+		cursor: cursor,
+		height: height,
+		size:   size,
+		index:  -1,
+	}
+}
+
+// Next moves to the next item and reports whether there is one
+func (it *listIterator) Next() bool {
+	it.index++
+	if it.index < len(it.items) {
+		return true
+	}
+	if it.done {
+		return false
+	}
+	if it.start == nil {
+		blob := it.app.GetVersionedStateDB([]byte(it.list), it.height)
+		if blob != nil {
+			it.nextFromBlob(blob)
+			return len(it.items) > 0
+		}
+		it.start = []byte(it.list + "#")
+		if it.cursor != "" {
+			position := it.app.GetVersionedStateDB([]byte(listPositionKey(it.list, it.cursor)), it.height)
+			if position == nil {
+				it.done = true
+				return false
+			}
+			seq, err := strconv.ParseInt(string(position), 10, 64)
+			if err != nil {
+				it.err, it.done = err, true
+				return false
+			}
+			it.start = []byte(listOrderKey(it.list, seq))
+		}
+	}
+	it.items, it.values, it.index = nil, nil, 0
+	it.err = it.app.IterateVersionedStateDB(it.start, []byte(listOrderEndKey(it.list)), it.height, func(key []byte, value []byte) bool {
+		it.items = append(it.items, string(value))
+		it.start = append(append([]byte{}, key...), 0)
+		return it.size > 0 && len(it.items) >= it.size
+	})
+	if it.err != nil || it.size == 0 || len(it.items) < it.size {
+		it.done = true
+	}
+	for _, item := range it.items {
+		it.values = append(it.values, it.app.GetVersionedStateDB([]byte(listItemKey(it.list, item)), it.height))
+	}
+	return len(it.items) > 0
+}
+
+// nextFromBlob reads every item of a list blob from the cursor on
+func (it *listIterator) nextFromBlob(blob []byte) {
+	it.done = true
+	items, values, err := decodeListBlob(it.list, blob)
+	if err != nil {
+		it.err = err
+		return
+	}
+	start := 0
+	if it.cursor != "" {
+		start = len(items)
+		for index := range items {
+			if items[index] == it.cursor {
+				start = index
+				break
+			}
+		}
+	}
+	it.items, it.values, it.index = items[start:], values[start:], 0
+}
+
+// Item returns the current item
+func (it *listIterator) Item() string {
+	return it.items[it.index]
+}
+
+// Value returns the value of the current item
+func (it *listIterator) Value() []byte {
+	return it.values[it.index]
+}
+
+// Err returns the error that stopped the scan, if any
+func (it *listIterator) Err() error {
+	return it.err
+}
+
+// getListItems returns items of list in the latest state
+func (app *DIDApplication) getListItems(list string) (items []string, values [][]byte, err error) {
+	_, blob := app.state.db.Get(prefixKey([]byte(list)))
+	if blob != nil {
+		return decodeListBlob(list, blob)
+	}
+	startKey := prefixKey([]byte(list + "#"))
+	endKey := prefixKey([]byte(listOrderEndKey(list)))
+	app.state.db.IterateRange(startKey, endKey, true, func(key []byte, value []byte) bool {
+		items = append(items, string(value))
+		return false
+	})
+	for _, item := range items {
+		_, value := app.state.db.Get(prefixKey([]byte(listItemKey(list, item))))
+		values = append(values, value)
+	}
+	return items, values, nil
+}
+
+func (app *DIDApplication) addNodeToList(list string, nodeID string) error {
+	return app.setListItem(list, nodeID, []byte(nodeID))
+}
+
+// getVersionedMsqDestination returns IdPs of hashID at height
+func (app *DIDApplication) getVersionedMsqDestination(hashID string, height int64) ([]*data.Node, error) {
+	var values [][]byte
+	it := app.newVersionedListIterator(msqDestinationList(hashID), "", height, 0)
+	for it.Next() {
+		values = append(values, it.Value())
+	}
+	if it.Err() != nil {
+		return nil, it.Err()
+	}
+	return unmarshalMsqDestination(values)
+}
+
+// getMsqDestination returns IdPs of hashID in the latest state
+func (app *DIDApplication) getMsqDestination(hashID string) ([]*data.Node, error) {
+	_, values, err := app.getListItems(msqDestinationList(hashID))
+	if err != nil {
+		return nil, err
+	}
+	return unmarshalMsqDestination(values)
+}
+
+func unmarshalMsqDestination(values [][]byte) ([]*data.Node, error) {
+	nodes := make([]*data.Node, 0, len(values))
+	for _, value := range values {
+		var node data.Node
+		err := proto.Unmarshal(value, &node)
+		if err != nil {
+			return nil, err
+		}
+		nodes = append(nodes, &node)
+	}
+	return nodes, nil
+}
+
+// ListIndexVersion is the app version from which lists are stored one key per
+// item. A chain upgraded to it from an earlier version moves its list blobs to
+// per-item keys in the first block of the version.
+const ListIndexVersion = "2"
+
+// Upgrade converts the state written by app version from for app version to.
+// It runs at the beginning of the first block of version to.
+func (app *DIDApplication) Upgrade(from string, to string) {
+	if to == ListIndexVersion {
+		app.migrateListBlobs()
+	}
+}
+
+// migrateListBlobs moves every list blob in the state to per-item keys
+func (app *DIDApplication) migrateListBlobs() {
+	var keys, values [][]byte
+	for _, list := range []string{idpListKey, rpListKey, asListKey, allListKey, namespaceListKey, serviceListKey} {
+		key := prefixKey([]byte(list))
+		_, value := app.state.db.Get(key)
+		if value != nil {
+			keys = append(keys, key)
+			values = append(values, value)
+		}
+	}
+	for _, prefix := range []string{"MsqDestination", "AccessorInGroup"} {
+		startKey := prefixKey([]byte(prefix + "|"))
+		endKey := prefixKey([]byte(listEndKey(prefix)))
+		app.state.db.IterateRange(startKey, endKey, true, func(key []byte, value []byte) bool {
+			if isListBlob(string(key[len(kvPairPrefixKey):])) {
+				keys = append(keys, append([]byte{}, key...))
+				values = append(values, append([]byte{}, value...))
+			}
+			return false
+		})
+	}
+	migrated := 0
+	for index, key := range keys {
+		_, err := app.migrateList(key, values[index])
+		if err != nil {
+			app.logger.Errorf("Can not migrate list %s: %s", string(key), err.Error())
+			continue
+		}
+		app.DeleteStateDB(bytes.TrimPrefix(key, kvPairPrefixKey))
+		migrated++
+	}
+	app.logger.Infof("Migrated %d lists to per-item keys", migrated)
+}
+
+// migrateList writes the items of a list blob of a state backup taken before
+// lists were stored one key per item. It reports whether key is a list blob
+// to convert, which it is not while the app version being run still stores
+// lists as blobs.
+func (app *DIDApplication) migrateList(key []byte, value []byte) (bool, error) {
+	list := string(bytes.TrimPrefix(key, kvPairPrefixKey))
+	if !bytes.HasPrefix(key, kvPairPrefixKey) || !isListBlob(list) || !app.listsIndexed() {
+		return false, nil
+	}
+	items, values, err := decodeListBlob(list, value)
+	if err != nil {
+		return true, err
+	}
+	for index, item := range items {
+		err = app.setListItem(list, item, values[index])
+		if err != nil {
+			return true, err
+		}
+	}
+	return true, nil
+}
+
+// isListBlob reports whether key is where app versions before
+// ListIndexVersion store list as one value
+func isListBlob(key string) bool {
+	switch key {
+	case idpListKey, rpListKey, asListKey, allListKey, namespaceListKey, serviceListKey:
+		return true
+	}
+	for _, prefix := range []string{msqDestinationList(""), accessorInGroupList("")} {
+		if strings.HasPrefix(key, prefix) && !strings.ContainsAny(key[len(prefix):], "|#@") {
+			return true
+		}
+	}
+	return false
+}
+
+// decodeListBlob returns the items of a list blob and their values as
+// stored under per-item keys
+func decodeListBlob(list string, blob []byte) (items []string, values [][]byte, err error) {
+	var messages []proto.Message
+	switch {
+	case list == idpListKey || list == rpListKey || list == asListKey || list == allListKey:
+		// IdPList, RPList, ASList and AllList share the same wire format
+		var nodes data.AllList
+		err := proto.Unmarshal(blob, &nodes)
+		if err != nil {
+			return nil, nil, err
+		}
+		for _, nodeID := range nodes.NodeId {
+			items, values = addBlobItem(items, values, nodeID, []byte(nodeID))
+		}
+		return items, values, nil
+	case strings.HasPrefix(list, accessorInGroupList("")):
+		var accessors data.AccessorInGroup
+		err := proto.Unmarshal(blob, &accessors)
+		if err != nil {
+			return nil, nil, err
+		}
+		for _, accessorID := range accessors.Accessors {
+			items, values = addBlobItem(items, values, accessorID, []byte(accessorID))
+		}
+		return items, values, nil
+	case list == namespaceListKey:
+		var namespaces data.NamespaceList
+		err := proto.Unmarshal(blob, &namespaces)
+		if err != nil {
+			return nil, nil, err
+		}
+		for _, namespace := range namespaces.Namespaces {
+			items = append(items, namespace.Namespace)
+			messages = append(messages, namespace)
+		}
+	case list == serviceListKey:
+		var services data.ServiceDetailList
+		err := proto.Unmarshal(blob, &services)
+		if err != nil {
+			return nil, nil, err
+		}
+		for _, service := range services.Services {
+			items = append(items, service.ServiceId)
+			messages = append(messages, service)
+		}
+	default:
+		var nodes data.MsqDesList
+		err := proto.Unmarshal(blob, &nodes)
+		if err != nil {
+			return nil, nil, err
+		}
+		for _, node := range nodes.Nodes {
+			items = append(items, node.NodeId)
+			messages = append(messages, node)
+		}
+	}
+	var blobItems []string
+	for index, item := range items {
+		value, err := utils.ProtoDeterministicMarshal(messages[index])
+		if err != nil {
+			return nil, nil, err
+		}
+		blobItems, values = addBlobItem(blobItems, values, item, value)
+	}
+	return blobItems, values, nil
+}
+
+// addBlobItem adds item to items, a later entry of the same item replacing
+// the value of an earlier one
+func addBlobItem(items []string, values [][]byte, item string, value []byte) ([]string, [][]byte) {
+	for index := range items {
+		if items[index] == item {
+			values[index] = value
+			return items, values
+		}
+	}
+	return append(items, item), append(values, value)
+}
+
+// encodeListBlob returns the list blob of items with values as stored under per-item keys
+func encodeListBlob(list string, items []string, values [][]byte) ([]byte, error) {
+	var blob proto.Message
+	switch {
+	case list == idpListKey || list == rpListKey || list == asListKey || list == allListKey:
+		blob = &data.AllList{NodeId: items}
+	case strings.HasPrefix(list, accessorInGroupList("")):
+		blob = &data.AccessorInGroup{Accessors: items}
+	case list == namespaceListKey:
+		var namespaces data.NamespaceList
+		for _, value := range values {
+			var namespace data.Namespace
+			err := proto.Unmarshal(value, &namespace)
+			if err != nil {
+				return nil, err
+			}
+			namespaces.Namespaces = append(namespaces.Namespaces, &namespace)
+		}
+		blob = &namespaces
+	case list == serviceListKey:
+		var services data.ServiceDetailList
+		for _, value := range values {
+			var service data.ServiceDetail
+			err := proto.Unmarshal(value, &service)
+			if err != nil {
+				return nil, err
+			}
+			services.Services = append(services.Services, &service)
+		}
+		blob = &services
+	default:
+		var nodes data.MsqDesList
+		for _, value := range values {
+			var node data.Node
+			err := proto.Unmarshal(value, &node)
+			if err != nil {
+				return nil, err
+			}
+			nodes.Nodes = append(nodes.Nodes, &node)
+		}
+		blob = &nodes
+	}
+	return utils.ProtoDeterministicMarshal(blob)
+}
@@ -0,0 +1,201 @@
+/**
+ * Copyright (c) 2018, 2019 National Digital ID COMPANY LIMITED
+ *
+ * This file is part of NDID software.
+ *
+ * NDID is the free software: you can redistribute it and/or modify it under
+ * the terms of the Affero GNU General Public License as published by the
+ * Free Software Foundation, either version 3 of the License, or any later
+ * version.
+ *
+ * NDID is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.
+ * See the Affero GNU General Public License for more details.
+ *
+ * You should have received a copy of the Affero GNU General Public License
+ * along with the NDID source code. If not, see https://www.gnu.org/licenses/agpl.txt.
+ *
+ * Please contact info@ndid.co.th for any further questions
+ *
+ */
+
+package did
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/golang/protobuf/proto"
+	"github.com/ndidplatform/smart-contract/abci/code"
+	"github.com/ndidplatform/smart-contract/abci/utils"
+	"github.com/ndidplatform/smart-contract/protos/data"
+	"github.com/tendermint/tendermint/abci/types"
+	cmn "github.com/tendermint/tendermint/libs/common"
+)
+
+// masterKeyRecoveryTimeLockBlock is the number of blocks the node's current master key
+// has to cancel a master key recovery started by NDID
+const masterKeyRecoveryTimeLockBlock int64 = 17280
+
+const (
+	masterKeyRecoveryStatusPending   = "pending"
+	masterKeyRecoveryStatusCancelled = "cancelled"
+	masterKeyRecoveryStatusExecuted  = "executed"
+)
+
+func masterKeyRecoveryExecuteKey(height int64, nodeID string) string {
+	return "MasterKeyRecoveryExecute" + "|" + fmt.Sprintf("%020d", height) + "|" + nodeID
+}
+
+func masterKeyRecoveryTags(nodeID string, status string) []cmn.KVPair {
+	return []cmn.KVPair{
+		{Key: []byte(tagMasterKeyRecoveryNodeID), Value: []byte(nodeID)},
+		{Key: []byte(tagMasterKeyRecoveryStatus), Value: []byte(status)},
+	}
+}
+
+func (app *DIDApplication) initiateMasterKeyRecovery(param string, nodeID string) types.ResponseDeliverTx {
+	app.logger.Infof("InitiateMasterKeyRecovery, Parameter: %s", param)
+	var funcParam InitiateMasterKeyRecoveryParam
+	err := json.Unmarshal([]byte(param), &funcParam)
+	if err != nil {
+		return app.ReturnDeliverTxLog(code.UnmarshalError, err.Error(), "")
+	}
+	nodeDetailKey := "NodeID" + "|" + funcParam.NodeID
+	_, nodeDetailValue := app.state.db.Get(prefixKey([]byte(nodeDetailKey)))
+	if nodeDetailValue == nil {
+		return app.ReturnDeliverTxLog(code.NodeIDNotFound, "Node ID not found", "")
+	}
+	checkCode, log := checkPubKey(funcParam.NewMasterPublicKey)
+	if checkCode != code.OK {
+		return app.ReturnDeliverTxLog(checkCode, log, "")
+	}
+	key := "MasterKeyRecovery" + "|" + funcParam.NodeID
+	_, value := app.state.db.Get(prefixKey([]byte(key)))
+	if value != nil {
+		var recovery data.MasterKeyRecovery
+		err = proto.Unmarshal(value, &recovery)
+		if err != nil {
+			return app.ReturnDeliverTxLog(code.UnmarshalError, err.Error(), "")
+		}
+		if recovery.Status == masterKeyRecoveryStatusPending {
+			return app.ReturnDeliverTxLog(code.MasterKeyRecoveryIsAlreadyPending, "Master key recovery is already pending", "")
+		}
+	}
+	var recovery data.MasterKeyRecovery
+	recovery.NewMasterPublicKey = funcParam.NewMasterPublicKey
+	recovery.InitiatedBlock = app.CurrentBlock
+	recovery.ExecuteBlock = app.CurrentBlock + masterKeyRecoveryTimeLockBlock
+	recovery.Status = masterKeyRecoveryStatusPending
+	recoveryValue, err := utils.ProtoDeterministicMarshal(&recovery)
+	if err != nil {
+		return app.ReturnDeliverTxLog(code.MarshalError, err.Error(), "")
+	}
+	app.SetStateDB([]byte(key), []byte(recoveryValue))
+	app.SetStateDB([]byte(masterKeyRecoveryExecuteKey(recovery.ExecuteBlock, funcParam.NodeID)), []byte(funcParam.NodeID))
+	result := app.ReturnDeliverTxLog(code.OK, "success", "")
+	result.Tags = append(result.Tags, masterKeyRecoveryTags(funcParam.NodeID, recovery.Status)...)
+	return result
+}
+
+func (app *DIDApplication) cancelMasterKeyRecovery(param string, nodeID string) types.ResponseDeliverTx {
+	app.logger.Infof("CancelMasterKeyRecovery, Parameter: %s", param)
+	key := "MasterKeyRecovery" + "|" + nodeID
+	_, value := app.state.db.Get(prefixKey([]byte(key)))
+	if value == nil {
+		return app.ReturnDeliverTxLog(code.MasterKeyRecoveryNotFound, "Master key recovery not found", "")
+	}
+	var recovery data.MasterKeyRecovery
+	err := proto.Unmarshal(value, &recovery)
+	if err != nil {
+		return app.ReturnDeliverTxLog(code.UnmarshalError, err.Error(), "")
+	}
+	if recovery.Status != masterKeyRecoveryStatusPending {
+		return app.ReturnDeliverTxLog(code.MasterKeyRecoveryNotFound, "Master key recovery not found", "")
+	}
+	recovery.Status = masterKeyRecoveryStatusCancelled
+	recoveryValue, err := utils.ProtoDeterministicMarshal(&recovery)
+	if err != nil {
+		return app.ReturnDeliverTxLog(code.MarshalError, err.Error(), "")
+	}
+	app.SetStateDB([]byte(key), []byte(recoveryValue))
+	app.DeleteStateDB([]byte(masterKeyRecoveryExecuteKey(recovery.ExecuteBlock, nodeID)))
+	result := app.ReturnDeliverTxLog(code.OK, "success", "")
+	result.Tags = append(result.Tags, masterKeyRecoveryTags(nodeID, recovery.Status)...)
+	return result
+}
+
+// executeMasterKeyRecovery replaces master public key of nodes whose recovery time lock ends at or below height
+func (app *DIDApplication) executeMasterKeyRecovery(height int64) (tags []cmn.KVPair) {
+	startKey := prefixKey([]byte("MasterKeyRecoveryExecute" + "|"))
+	endKey := prefixKey([]byte(masterKeyRecoveryExecuteKey(height+1, "")))
+	var executeKeys [][]byte
+	var nodeIDs []string
+	app.state.db.IterateRange(startKey, endKey, true, func(key []byte, value []byte) bool {
+		executeKeys = append(executeKeys, key)
+		nodeIDs = append(nodeIDs, string(value))
+		return false
+	})
+	for index, nodeID := range nodeIDs {
+		app.state.db.Remove(executeKeys[index])
+		key := "MasterKeyRecovery" + "|" + nodeID
+		_, value := app.state.db.Get(prefixKey([]byte(key)))
+		var recovery data.MasterKeyRecovery
+		err := proto.Unmarshal(value, &recovery)
+		if err != nil || recovery.Status != masterKeyRecoveryStatusPending {
+			continue
+		}
+		nodeDetailKey := "NodeID" + "|" + nodeID
+		_, nodeDetailValue := app.state.db.Get(prefixKey([]byte(nodeDetailKey)))
+		var nodeDetail data.NodeDetail
+		err = proto.Unmarshal(nodeDetailValue, &nodeDetail)
+		if err != nil {
+			continue
+		}
+		nodeDetail.MasterPublicKey = recovery.NewMasterPublicKey
+		nodeDetailByte, err := utils.ProtoDeterministicMarshal(&nodeDetail)
+		if err != nil {
+			continue
+		}
+		recovery.Status = masterKeyRecoveryStatusExecuted
+		recoveryValue, err := utils.ProtoDeterministicMarshal(&recovery)
+		if err != nil {
+			continue
+		}
+		app.SetStateDB([]byte(nodeDetailKey), []byte(nodeDetailByte))
+		app.SetStateDB([]byte(key), []byte(recoveryValue))
+		app.logger.Infof("Master key recovery executed, NodeID: %s", nodeID)
+		tags = append(tags, masterKeyRecoveryTags(nodeID, recovery.Status)...)
+	}
+	return tags
+}
+
+func (app *DIDApplication) getMasterKeyRecovery(param string, height int64) types.ResponseQuery {
+	app.logger.Infof("GetMasterKeyRecovery, Parameter: %s", param)
+	var funcParam GetMasterKeyRecoveryParam
+	err := json.Unmarshal([]byte(param), &funcParam)
+	if err != nil {
+		return app.ReturnQuery(nil, err.Error(), height)
+	}
+	key := "MasterKeyRecovery" + "|" + funcParam.NodeID
+	value := app.GetVersionedStateDB([]byte(key), height)
+	if value == nil {
+		return app.ReturnQuery([]byte("{}"), "not found", height)
+	}
+	var recovery data.MasterKeyRecovery
+	err = proto.Unmarshal(value, &recovery)
+	if err != nil {
+		return app.ReturnQuery(nil, err.Error(), height)
+	}
+	var result GetMasterKeyRecoveryResult
+	result.NewMasterPublicKey = recovery.NewMasterPublicKey
+	result.InitiatedBlock = recovery.InitiatedBlock
+	result.ExecuteBlock = recovery.ExecuteBlock
+	result.Status = recovery.Status
+	returnValue, err := json.Marshal(result)
+	if err != nil {
+		return app.ReturnQuery(nil, err.Error(), height)
+	}
+	return app.ReturnQuery(returnValue, "success", height)
+}
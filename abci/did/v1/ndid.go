@@ -62,6 +62,13 @@ var isNDIDMethod = map[string]bool{
 	"SetInitData":                      true,
 	"EndInit":                          true,
 	"SetLastBlock":                     true,
+	"SetGovernance":                    true,
+	"InitiateMasterKeyRecovery":        true,
+	"ScheduleUpgrade":                  true,
+	"SetTokenTransferWhitelist":        true,
+	"SetPriceRule":                     true,
+	"SetRevenueShareRule":              true,
+	"SetNodeTokenLimit":                true,
 }
 
 func (app *DIDApplication) initNDID(param string, nodeID string) types.ResponseDeliverTx {
@@ -84,6 +91,10 @@ func (app *DIDApplication) initNDID(param string, nodeID string) types.ResponseD
 	masterNDIDKey := "MasterNDID"
 	nodeDetailKey := "NodeID" + "|" + funcParam.NodeID
 	initStateKey := "InitState"
+	err = app.setNodePublicKey(funcParam.NodeID, funcParam.PublicKey)
+	if err != nil {
+		return app.ReturnDeliverTxLog(code.MarshalError, err.Error(), "")
+	}
 	app.SetStateDB([]byte(masterNDIDKey), []byte(nodeID))
 	app.SetStateDB([]byte(nodeDetailKey), []byte(nodeDetailByte))
 	app.SetStateDB([]byte(initStateKey), []byte("true"))
@@ -125,79 +136,34 @@ func (app *DIDApplication) registerNode(param string, nodeID string) types.Respo
 		nodeDetail.MaxAal = funcParam.MaxAal
 		nodeDetail.MaxIal = funcParam.MaxIal
 	}
-	// if node is IdP, add node id to IdPList
-	var idpsList data.IdPList
-	idpsKey := "IdPList"
-	if funcParam.Role == "IdP" {
-		_, idpsValue := app.state.db.Get(prefixKey([]byte(idpsKey)))
-		if idpsValue != nil {
-			err := proto.Unmarshal(idpsValue, &idpsList)
-			if err != nil {
-				return app.ReturnDeliverTxLog(code.UnmarshalError, err.Error(), "")
-			}
-		}
-		idpsList.NodeId = append(idpsList.NodeId, funcParam.NodeID)
-		idpsListByte, err := utils.ProtoDeterministicMarshal(&idpsList)
+	// add node id to IdPList, rpList or asList by role and to allList
+	roleList := ""
+	switch funcParam.Role {
+	case "IdP":
+		roleList = idpListKey
+	case "RP":
+		roleList = rpListKey
+	case "AS":
+		roleList = asListKey
+	}
+	if roleList != "" {
+		err = app.addNodeToList(roleList, funcParam.NodeID)
 		if err != nil {
 			return app.ReturnDeliverTxLog(code.MarshalError, err.Error(), "")
 		}
-		app.SetStateDB([]byte(idpsKey), []byte(idpsListByte))
-	}
-	// if node is rp, add node id to rpList
-	var rpsList data.RPList
-	rpsKey := "rpList"
-	if funcParam.Role == "RP" {
-		_, rpsValue := app.state.db.Get(prefixKey([]byte(rpsKey)))
-		if rpsValue != nil {
-			err := proto.Unmarshal(rpsValue, &rpsList)
-			if err != nil {
-				return app.ReturnDeliverTxLog(code.UnmarshalError, err.Error(), "")
-			}
-		}
-		rpsList.NodeId = append(rpsList.NodeId, funcParam.NodeID)
-		rpsListByte, err := utils.ProtoDeterministicMarshal(&rpsList)
-		if err != nil {
-			return app.ReturnDeliverTxLog(code.MarshalError, err.Error(), "")
-		}
-		app.SetStateDB([]byte(rpsKey), []byte(rpsListByte))
-	}
-	// if node is as, add node id to asList
-	var asList data.ASList
-	asKey := "asList"
-	if funcParam.Role == "AS" {
-		_, asValue := app.state.db.Get(prefixKey([]byte(asKey)))
-		if asValue != nil {
-			err := proto.Unmarshal(asValue, &asList)
-			if err != nil {
-				return app.ReturnDeliverTxLog(code.UnmarshalError, err.Error(), "")
-			}
-		}
-		asList.NodeId = append(asList.NodeId, funcParam.NodeID)
-		asListByte, err := utils.ProtoDeterministicMarshal(&asList)
-		if err != nil {
-			return app.ReturnDeliverTxLog(code.MarshalError, err.Error(), "")
-		}
-		app.SetStateDB([]byte(asKey), []byte(asListByte))
-	}
-	var allList data.AllList
-	allKey := "allList"
-	_, allValue := app.state.db.Get(prefixKey([]byte(allKey)))
-	if allValue != nil {
-		err := proto.Unmarshal(allValue, &allList)
-		if err != nil {
-			return app.ReturnDeliverTxLog(code.UnmarshalError, err.Error(), "")
-		}
 	}
-	allList.NodeId = append(allList.NodeId, funcParam.NodeID)
-	allListByte, err := utils.ProtoDeterministicMarshal(&allList)
+	err = app.addNodeToList(allListKey, funcParam.NodeID)
 	if err != nil {
 		return app.ReturnDeliverTxLog(code.MarshalError, err.Error(), "")
 	}
-	app.SetStateDB([]byte(allKey), []byte(allListByte))
 	nodeDetailByte, err := utils.ProtoDeterministicMarshal(&nodeDetail)
 	if err != nil {
 		return app.ReturnDeliverTxLog(code.MarshalError, err.Error(), "")
 	}
+	err = app.setNodePublicKey(funcParam.NodeID, funcParam.PublicKey)
+	if err != nil {
+		return app.ReturnDeliverTxLog(code.MarshalError, err.Error(), "")
+	}
 	nodeDetailKey := "NodeID" + "|" + funcParam.NodeID
 	app.SetStateDB([]byte(nodeDetailKey), []byte(nodeDetailByte))
 	app.createTokenAccount(funcParam.NodeID)
@@ -211,33 +177,27 @@ func (app *DIDApplication) addNamespace(param string, nodeID string) types.Respo
 	if err != nil {
 		return app.ReturnDeliverTxLog(code.UnmarshalError, err.Error(), "")
 	}
-	key := "AllNamespace"
-	_, chkExists := app.state.db.Get(prefixKey([]byte(key)))
-	var namespaces data.NamespaceList
+	chkExists, err := app.getListItem(namespaceListKey, funcParam.Namespace)
+	if err != nil {
+		return app.ReturnDeliverTxLog(code.UnmarshalError, err.Error(), "")
+	}
+	// Check duplicate namespace
 	if chkExists != nil {
-		err = proto.Unmarshal([]byte(chkExists), &namespaces)
-		if err != nil {
-			return app.ReturnDeliverTxLog(code.UnmarshalError, err.Error(), "")
-		}
-
-		// Check duplicate namespace
-		for _, namespace := range namespaces.Namespaces {
-			if namespace.Namespace == funcParam.Namespace {
-				return app.ReturnDeliverTxLog(code.DuplicateNamespace, "Duplicate namespace", "")
-			}
-		}
+		return app.ReturnDeliverTxLog(code.DuplicateNamespace, "Duplicate namespace", "")
 	}
 	var newNamespace data.Namespace
 	newNamespace.Namespace = funcParam.Namespace
 	newNamespace.Description = funcParam.Description
 	// set active flag
 	newNamespace.Active = true
-	namespaces.Namespaces = append(namespaces.Namespaces, &newNamespace)
-	value, err := utils.ProtoDeterministicMarshal(&namespaces)
+	value, err := utils.ProtoDeterministicMarshal(&newNamespace)
+	if err != nil {
+		return app.ReturnDeliverTxLog(code.MarshalError, err.Error(), "")
+	}
+	err = app.setListItem(namespaceListKey, funcParam.Namespace, value)
 	if err != nil {
 		return app.ReturnDeliverTxLog(code.MarshalError, err.Error(), "")
 	}
-	app.SetStateDB([]byte(key), []byte(value))
 	return app.ReturnDeliverTxLog(code.OK, "success", "")
 }
 
@@ -248,27 +208,27 @@ func (app *DIDApplication) disableNamespace(param string, nodeID string) types.R
 	if err != nil {
 		return app.ReturnDeliverTxLog(code.UnmarshalError, err.Error(), "")
 	}
-	key := "AllNamespace"
-	_, chkExists := app.state.db.Get(prefixKey([]byte(key)))
+	chkExists, err := app.getListItem(namespaceListKey, funcParam.Namespace)
+	if err != nil {
+		return app.ReturnDeliverTxLog(code.UnmarshalError, err.Error(), "")
+	}
 	if chkExists == nil {
-		return app.ReturnDeliverTxLog(code.NamespaceNotFound, "List of namespace not found", "")
+		return app.ReturnDeliverTxLog(code.NamespaceNotFound, "Namespace not found", "")
 	}
-	var namespaces data.NamespaceList
-	err = proto.Unmarshal([]byte(chkExists), &namespaces)
+	var namespace data.Namespace
+	err = proto.Unmarshal([]byte(chkExists), &namespace)
 	if err != nil {
 		return app.ReturnDeliverTxLog(code.UnmarshalError, err.Error(), "")
 	}
-	for index, namespace := range namespaces.Namespaces {
-		if namespace.Namespace == funcParam.Namespace {
-			namespaces.Namespaces[index].Active = false
-			break
-		}
+	namespace.Active = false
+	value, err := utils.ProtoDeterministicMarshal(&namespace)
+	if err != nil {
+		return app.ReturnDeliverTxLog(code.MarshalError, err.Error(), "")
 	}
-	value, err := utils.ProtoDeterministicMarshal(&namespaces)
+	err = app.setListItem(namespaceListKey, funcParam.Namespace, value)
 	if err != nil {
 		return app.ReturnDeliverTxLog(code.MarshalError, err.Error(), "")
 	}
-	app.SetStateDB([]byte(key), []byte(value))
 	return app.ReturnDeliverTxLog(code.OK, "success", "")
 }
 
@@ -296,31 +256,18 @@ func (app *DIDApplication) addService(param string, nodeID string) types.Respons
 		return app.ReturnDeliverTxLog(code.MarshalError, err.Error(), "")
 	}
 	// Add detail to service directory
-	allServiceKey := "AllService"
-	_, allServiceValue := app.state.db.Get(prefixKey([]byte(allServiceKey)))
-	var services data.ServiceDetailList
-	if allServiceValue != nil {
-		err = proto.Unmarshal([]byte(allServiceValue), &services)
-		if err != nil {
-			return app.ReturnDeliverTxLog(code.UnmarshalError, err.Error(), "")
-		}
-		// Check duplicate service
-		for _, service := range services.Services {
-			if service.ServiceId == funcParam.ServiceID {
-				return app.ReturnDeliverTxLog(code.DuplicateServiceID, "Duplicate service ID", "")
-			}
-		}
-	}
 	var newService data.ServiceDetail
 	newService.ServiceId = funcParam.ServiceID
 	newService.ServiceName = funcParam.ServiceName
 	newService.Active = true
-	services.Services = append(services.Services, &newService)
-	allServiceJSON, err := utils.ProtoDeterministicMarshal(&services)
+	allServiceJSON, err := utils.ProtoDeterministicMarshal(&newService)
+	if err != nil {
+		return app.ReturnDeliverTxLog(code.MarshalError, err.Error(), "")
+	}
+	err = app.setListItem(serviceListKey, funcParam.ServiceID, allServiceJSON)
 	if err != nil {
 		return app.ReturnDeliverTxLog(code.MarshalError, err.Error(), "")
 	}
-	app.SetStateDB([]byte(allServiceKey), []byte(allServiceJSON))
 	app.SetStateDB([]byte(serviceKey), []byte(serviceJSON))
 	return app.ReturnDeliverTxLog(code.OK, "success", "")
 }
@@ -338,29 +285,26 @@ func (app *DIDApplication) disableService(param string, nodeID string) types.Res
 		return app.ReturnDeliverTxLog(code.ServiceIDNotFound, "Service ID not found", "")
 	}
 	// Delete detail in service directory
-	allServiceKey := "AllService"
-	_, allServiceValue := app.state.db.Get(prefixKey([]byte(allServiceKey)))
-	var services data.ServiceDetailList
+	allServiceValue, err := app.getListItem(serviceListKey, funcParam.ServiceID)
+	if err != nil {
+		return app.ReturnDeliverTxLog(code.UnmarshalError, err.Error(), "")
+	}
+	var allService data.ServiceDetail
 	if allServiceValue == nil {
 		return app.ReturnDeliverTxLog(code.ServiceIDNotFound, "List of Service not found", "")
 	}
-	err = proto.Unmarshal([]byte(allServiceValue), &services)
+	err = proto.Unmarshal([]byte(allServiceValue), &allService)
 	if err != nil {
 		return app.ReturnDeliverTxLog(code.UnmarshalError, err.Error(), "")
 	}
-	for index, service := range services.Services {
-		if service.ServiceId == funcParam.ServiceID {
-			services.Services[index].Active = false
-			break
-		}
-	}
+	allService.Active = false
 	var service data.ServiceDetail
 	err = proto.Unmarshal([]byte(chkExists), &service)
 	if err != nil {
 		return app.ReturnDeliverTxLog(code.UnmarshalError, err.Error(), "")
 	}
 	service.Active = false
-	allServiceJSON, err := utils.ProtoDeterministicMarshal(&services)
+	allServiceJSON, err := utils.ProtoDeterministicMarshal(&allService)
 	if err != nil {
 		return app.ReturnDeliverTxLog(code.MarshalError, err.Error(), "")
 	}
@@ -369,7 +313,10 @@ func (app *DIDApplication) disableService(param string, nodeID string) types.Res
 		return app.ReturnDeliverTxLog(code.MarshalError, err.Error(), "")
 	}
 	app.SetStateDB([]byte(serviceKey), []byte(serviceJSON))
-	app.SetStateDB([]byte(allServiceKey), []byte(allServiceJSON))
+	err = app.setListItem(serviceListKey, funcParam.ServiceID, allServiceJSON)
+	if err != nil {
+		return app.ReturnDeliverTxLog(code.MarshalError, err.Error(), "")
+	}
 	return app.ReturnDeliverTxLog(code.OK, "success", "")
 }
 
@@ -441,33 +388,33 @@ func (app *DIDApplication) updateService(param string, nodeID string) types.Resp
 		service.DataSchemaVersion = funcParam.DataSchemaVersion
 	}
 	// Update detail in service directory
-	allServiceKey := "AllService"
-	_, allServiceValue := app.state.db.Get(prefixKey([]byte(allServiceKey)))
-	var services data.ServiceDetailList
+	allServiceValue, err := app.getListItem(serviceListKey, funcParam.ServiceID)
+	if err != nil {
+		return app.ReturnDeliverTxLog(code.UnmarshalError, err.Error(), "")
+	}
+	var allService data.ServiceDetail
 	if allServiceValue != nil {
-		err = proto.Unmarshal([]byte(allServiceValue), &services)
+		err = proto.Unmarshal([]byte(allServiceValue), &allService)
 		if err != nil {
 			return app.ReturnDeliverTxLog(code.UnmarshalError, err.Error(), "")
 		}
 		// Update service
-		for index, service := range services.Services {
-			if service.ServiceId == funcParam.ServiceID {
-				if funcParam.ServiceName != "" {
-					services.Services[index].ServiceName = funcParam.ServiceName
-				}
-			}
+		if funcParam.ServiceName != "" {
+			allService.ServiceName = funcParam.ServiceName
+		}
+		allServiceJSON, err := utils.ProtoDeterministicMarshal(&allService)
+		if err != nil {
+			return app.ReturnDeliverTxLog(code.MarshalError, err.Error(), "")
+		}
+		err = app.setListItem(serviceListKey, funcParam.ServiceID, allServiceJSON)
+		if err != nil {
+			return app.ReturnDeliverTxLog(code.MarshalError, err.Error(), "")
 		}
 	}
 	serviceJSON, err := utils.ProtoDeterministicMarshal(&service)
 	if err != nil {
 		return app.ReturnDeliverTxLog(code.MarshalError, err.Error(), "")
 	}
-
-	allServiceJSON, err := utils.ProtoDeterministicMarshal(&services)
-	if err != nil {
-		return app.ReturnDeliverTxLog(code.MarshalError, err.Error(), "")
-	}
-	app.SetStateDB([]byte(allServiceKey), []byte(allServiceJSON))
 	app.SetStateDB([]byte(serviceKey), []byte(serviceJSON))
 	return app.ReturnDeliverTxLog(code.OK, "success", "")
 }
@@ -679,27 +626,27 @@ func (app *DIDApplication) enableNamespace(param string, nodeID string) types.Re
 	if err != nil {
 		return app.ReturnDeliverTxLog(code.UnmarshalError, err.Error(), "")
 	}
-	key := "AllNamespace"
-	_, chkExists := app.state.db.Get(prefixKey([]byte(key)))
-	var namespaces data.NamespaceList
+	chkExists, err := app.getListItem(namespaceListKey, funcParam.Namespace)
+	if err != nil {
+		return app.ReturnDeliverTxLog(code.UnmarshalError, err.Error(), "")
+	}
 	if chkExists == nil {
 		return app.ReturnDeliverTxLog(code.NamespaceNotFound, "Namespace not found", "")
 	}
-	err = proto.Unmarshal([]byte(chkExists), &namespaces)
+	var namespace data.Namespace
+	err = proto.Unmarshal([]byte(chkExists), &namespace)
 	if err != nil {
 		return app.ReturnDeliverTxLog(code.UnmarshalError, err.Error(), "")
 	}
-	for index, namespace := range namespaces.Namespaces {
-		if namespace.Namespace == funcParam.Namespace {
-			namespaces.Namespaces[index].Active = true
-			break
-		}
+	namespace.Active = true
+	value, err := utils.ProtoDeterministicMarshal(&namespace)
+	if err != nil {
+		return app.ReturnDeliverTxLog(code.MarshalError, err.Error(), "")
 	}
-	value, err := utils.ProtoDeterministicMarshal(&namespaces)
+	err = app.setListItem(namespaceListKey, funcParam.Namespace, value)
 	if err != nil {
 		return app.ReturnDeliverTxLog(code.MarshalError, err.Error(), "")
 	}
-	app.SetStateDB([]byte(key), []byte(value))
 	return app.ReturnDeliverTxLog(code.OK, "success", "")
 }
 
@@ -716,26 +663,26 @@ func (app *DIDApplication) enableService(param string, nodeID string) types.Resp
 		return app.ReturnDeliverTxLog(code.ServiceIDNotFound, "Service ID not found", "")
 	}
 	// Delete detail in service directory
-	allServiceKey := "AllService"
-	_, allServiceValue := app.state.db.Get(prefixKey([]byte(allServiceKey)))
-	var services data.ServiceDetailList
-	err = proto.Unmarshal([]byte(allServiceValue), &services)
+	allServiceValue, err := app.getListItem(serviceListKey, funcParam.ServiceID)
 	if err != nil {
 		return app.ReturnDeliverTxLog(code.UnmarshalError, err.Error(), "")
 	}
-	for index, service := range services.Services {
-		if service.ServiceId == funcParam.ServiceID {
-			services.Services[index].Active = true
-			break
-		}
+	var allService data.ServiceDetail
+	if allServiceValue == nil {
+		return app.ReturnDeliverTxLog(code.ServiceIDNotFound, "List of Service not found", "")
+	}
+	err = proto.Unmarshal([]byte(allServiceValue), &allService)
+	if err != nil {
+		return app.ReturnDeliverTxLog(code.UnmarshalError, err.Error(), "")
 	}
+	allService.Active = true
 	var service data.ServiceDetail
 	err = proto.Unmarshal([]byte(chkExists), &service)
 	if err != nil {
 		return app.ReturnDeliverTxLog(code.UnmarshalError, err.Error(), "")
 	}
 	service.Active = true
-	allServiceJSON, err := utils.ProtoDeterministicMarshal(&services)
+	allServiceJSON, err := utils.ProtoDeterministicMarshal(&allService)
 	if err != nil {
 		return app.ReturnDeliverTxLog(code.MarshalError, err.Error(), "")
 	}
@@ -744,7 +691,10 @@ func (app *DIDApplication) enableService(param string, nodeID string) types.Resp
 		return app.ReturnDeliverTxLog(code.MarshalError, err.Error(), "")
 	}
 	app.SetStateDB([]byte(serviceKey), []byte(serviceJSON))
-	app.SetStateDB([]byte(allServiceKey), []byte(allServiceJSON))
+	err = app.setListItem(serviceListKey, funcParam.ServiceID, allServiceJSON)
+	if err != nil {
+		return app.ReturnDeliverTxLog(code.MarshalError, err.Error(), "")
+	}
 	return app.ReturnDeliverTxLog(code.OK, "success", "")
 }
 
@@ -1013,7 +963,14 @@ func (app *DIDApplication) SetInitData(param string, nodeID string) types.Respon
 		return app.ReturnDeliverTxLog(code.UnmarshalError, err.Error(), "")
 	}
 	for _, kv := range funcParam.KVList {
-		app.SetStateDBWithOutPrefix(kv.Key, kv.Value)
+		isList, err := app.migrateList(kv.Key, kv.Value)
+		if err != nil {
+			return app.ReturnDeliverTxLog(code.UnmarshalError, err.Error(), "")
+		}
+		if isList {
+			continue
+		}
+		app.SetStateDBWithOutPrefix(kv.Key, migrateTokenValue(kv.Key, kv.Value))
 	}
 	return app.ReturnDeliverTxLog(code.OK, "success", "")
 }
@@ -0,0 +1,113 @@
+/**
+ * Copyright (c) 2018, 2019 National Digital ID COMPANY LIMITED
+ *
+ * This file is part of NDID software.
+ *
+ * NDID is the free software: you can redistribute it and/or modify it under
+ * the terms of the Affero GNU General Public License as published by the
+ * Free Software Foundation, either version 3 of the License, or any later
+ * version.
+ *
+ * NDID is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.
+ * See the Affero GNU General Public License for more details.
+ *
+ * You should have received a copy of the Affero GNU General Public License
+ * along with the NDID source code. If not, see https://www.gnu.org/licenses/agpl.txt.
+ *
+ * Please contact info@ndid.co.th for any further questions
+ *
+ */
+
+package did
+
+import (
+	"bytes"
+	"fmt"
+	"strconv"
+
+	"github.com/ndidplatform/smart-contract/abci/code"
+	"github.com/tendermint/tendermint/abci/types"
+)
+
+// nonceExpireBlock is the number of blocks a nonce is valid and remembered for.
+const nonceExpireBlock int64 = 10000
+
+// nonceSigner returns the signer of a transaction of method. Governance keys
+// and nodes have separate nonces, so a governance key ID that is also a node
+// ID does not share its nonces with the node.
+func nonceSigner(method string, nodeID string) string {
+	if isGovernanceMethod[method] {
+		return "GovernanceKey" + "|" + nodeID
+	}
+	return "Node" + "|" + nodeID
+}
+
+func nonceKey(signer string, nonce []byte) string {
+	return "Nonce" + "|" + signer + "|" + string(nonce)
+}
+
+func nonceExpireKey(height int64, signer string, nonce []byte) string {
+	return "NonceExpire" + "|" + fmt.Sprintf("%020d", height) + "|" + signer + "|" + string(nonce)
+}
+
+// nonceHeight returns the block height a nonce is bound to. A nonce is the
+// decimal height of a committed block, ":" and random bytes.
+func nonceHeight(nonce []byte) (int64, bool) {
+	index := bytes.IndexByte(nonce, ':')
+	if index <= 0 {
+		return 0, false
+	}
+	height, err := strconv.ParseInt(string(nonce[:index]), 10, 64)
+	if err != nil || height < 0 {
+		return 0, false
+	}
+	return height, true
+}
+
+func (app *DIDApplication) checkNonce(nonce []byte, signer string) types.ResponseCheckTx {
+	height, ok := nonceHeight(nonce)
+	if !ok {
+		return ReturnCheckTx(code.BadNonce, "Invalid nonce")
+	}
+	if height > app.CurrentBlock {
+		return ReturnCheckTx(code.BadNonce, "Nonce height is in the future")
+	}
+	// Nonce is forgotten once expired so it must be rejected from then on
+	if app.CurrentBlock-height >= nonceExpireBlock {
+		return ReturnCheckTx(code.BadNonce, "Nonce expired")
+	}
+	key := nonceKey(signer, nonce)
+	_, value := app.state.db.Get(prefixKey([]byte(key)))
+	if value != nil {
+		return ReturnCheckTx(code.BadNonce, "Duplicate nonce")
+	}
+	return ReturnCheckTx(code.OK, "")
+}
+
+func (app *DIDApplication) setNonceUsed(nonce []byte, signer string) {
+	key := nonceKey(signer, nonce)
+	height, _ := nonceHeight(nonce)
+	expireHeight := height + nonceExpireBlock
+	app.SetStateDB([]byte(key), []byte(fmt.Sprintf("%d", app.CurrentBlock)))
+	expireKey := nonceExpireKey(expireHeight, signer, nonce)
+	app.SetStateDB([]byte(expireKey), []byte(key))
+}
+
+// removeExpiredNonce forgets every nonce whose expiry height is at or below height
+func (app *DIDApplication) removeExpiredNonce(height int64) {
+	startKey := prefixKey([]byte("NonceExpire" + "|"))
+	endKey := prefixKey([]byte("NonceExpire" + "|" + fmt.Sprintf("%020d", height+1) + "|"))
+	var expireKeys [][]byte
+	var nonceKeys [][]byte
+	app.state.db.IterateRange(startKey, endKey, true, func(key []byte, value []byte) bool {
+		expireKeys = append(expireKeys, key)
+		nonceKeys = append(nonceKeys, value)
+		return false
+	})
+	for index := range expireKeys {
+		app.state.db.Remove(expireKeys[index])
+		app.DeleteStateDB(nonceKeys[index])
+	}
+}
@@ -0,0 +1,83 @@
+/**
+ * Copyright (c) 2018, 2019 National Digital ID COMPANY LIMITED
+ *
+ * This file is part of NDID software.
+ *
+ * NDID is the free software: you can redistribute it and/or modify it under
+ * the terms of the Affero GNU General Public License as published by the
+ * Free Software Foundation, either version 3 of the License, or any later
+ * version.
+ *
+ * NDID is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.
+ * See the Affero GNU General Public License for more details.
+ *
+ * You should have received a copy of the Affero GNU General Public License
+ * along with the NDID source code. If not, see https://www.gnu.org/licenses/agpl.txt.
+ *
+ * Please contact info@ndid.co.th for any further questions
+ *
+ */
+
+package did
+
+// listPager pages through the items of a list query in list order. Items up
+// to and including the cursor item are skipped, and a page holds up to limit
+// items, or every item when limit is 0.
+type listPager struct {
+	cursor  string
+	limit   int
+	started bool
+	count   int
+	last    string
+	// nextCursor is the last item of a full page that has more items after it
+	nextCursor string
+}
+
+func newListPager(cursor string, limit int) *listPager {
+	return &listPager{cursor: cursor, limit: limit, started: cursor == ""}
+}
+
+// scanSize is the number of list items a page reads: the cursor item, the
+// page and one more item that tells whether there is a next page. 0 means
+// every item.
+func (pager *listPager) scanSize() int {
+	if pager.limit <= 0 {
+		return 0
+	}
+	if pager.cursor != "" {
+		return pager.limit + 2
+	}
+	return pager.limit + 1
+}
+
+// skip reports whether id is at or before the cursor
+func (pager *listPager) skip(id string) bool {
+	if pager.started {
+		return false
+	}
+	if id == pager.cursor {
+		pager.started = true
+	}
+	return true
+}
+
+// full reports whether the page has no room for another matching item
+func (pager *listPager) full() bool {
+	if pager.limit > 0 && pager.count >= pager.limit {
+		pager.nextCursor = pager.last
+		return true
+	}
+	return false
+}
+
+func (pager *listPager) add(id string) {
+	pager.count++
+	pager.last = id
+}
+
+// validCursor reports whether the cursor was found in the list
+func (pager *listPager) validCursor() bool {
+	return pager.started
+}
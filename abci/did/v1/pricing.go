@@ -0,0 +1,225 @@
+/**
+ * Copyright (c) 2018, 2019 National Digital ID COMPANY LIMITED
+ *
+ * This file is part of NDID software.
+ *
+ * NDID is the free software: you can redistribute it and/or modify it under
+ * the terms of the Affero GNU General Public License as published by the
+ * Free Software Foundation, either version 3 of the License, or any later
+ * version.
+ *
+ * NDID is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.
+ * See the Affero GNU General Public License for more details.
+ *
+ * You should have received a copy of the Affero GNU General Public License
+ * along with the NDID source code. If not, see https://www.gnu.org/licenses/agpl.txt.
+ *
+ * Please contact info@ndid.co.th for any further questions
+ *
+ */
+
+package did
+
+import (
+	"encoding/json"
+
+	"github.com/golang/protobuf/proto"
+	"github.com/ndidplatform/smart-contract/abci/code"
+	"github.com/ndidplatform/smart-contract/abci/utils"
+	"github.com/ndidplatform/smart-contract/protos/data"
+	"github.com/tendermint/tendermint/abci/types"
+)
+
+// stateReader returns the state value of a key without prefix
+type stateReader func(key []byte) []byte
+
+func (app *DIDApplication) latestState(key []byte) []byte {
+	_, value := app.state.db.Get(prefixKey(key))
+	return value
+}
+
+func (app *DIDApplication) versionedState(height int64) stateReader {
+	return func(key []byte) []byte {
+		return app.GetVersionedStateDB(key, height)
+	}
+}
+
+// pricedParams are the params fields that pricing rules charge for
+type pricedParams struct {
+	ServiceID       string   `json:"service_id"`
+	MinIdp          int64    `json:"min_idp"`
+	IdPIDList       []string `json:"idp_id_list"`
+	DataRequestList []struct {
+		ServiceID string   `json:"service_id"`
+		MinAs     int64    `json:"min_as"`
+		ASIDList  []string `json:"as_id_list"`
+	} `json:"data_request_list"`
+}
+
+// getTxTokenPrice returns token price of a transaction in the latest state
+func (app *DIDApplication) getTxTokenPrice(method string, param string) TokenAmount {
+	return txTokenPrice(app.latestState, method, param)
+}
+
+// txTokenPrice returns price of the method plus surcharges of its pricing
+// rule for the IdPs, ASes and services in param. Batch costs the sum of its
+// transactions.
+func txTokenPrice(get stateReader, method string, param string) TokenAmount {
+	if method == "Batch" {
+		var funcParam BatchParam
+		err := json.Unmarshal([]byte(param), &funcParam)
+		if err != nil {
+			return 0
+		}
+		var price TokenAmount
+		for _, tx := range funcParam.Transactions {
+			price = addTokenAmounts(price, txTokenPrice(get, tx.Method, tx.Params))
+		}
+		return price
+	}
+	price := unmarshalTokenPrice(get([]byte("TokenPriceFunc" + "|" + method)))
+	value := get([]byte("TokenPriceRule" + "|" + method))
+	if value == nil {
+		return price
+	}
+	var rule data.TokenPriceRule
+	err := proto.Unmarshal(value, &rule)
+	if err != nil {
+		return price
+	}
+	servicePrices := make(map[string]TokenAmount)
+	for _, servicePrice := range rule.ServicePriceList {
+		servicePrices[servicePrice.ServiceId] = TokenAmount(servicePrice.Price)
+	}
+	var funcParam pricedParams
+	json.Unmarshal([]byte(param), &funcParam)
+	// A request fans out to the listed nodes, or to at least min_idp IdPs and
+	// min_as ASes when nodes are not listed
+	idpCount := int64(len(funcParam.IdPIDList))
+	if idpCount < funcParam.MinIdp {
+		idpCount = funcParam.MinIdp
+	}
+	price = addTokenAmounts(price, mulTokenAmount(TokenAmount(rule.PerIdpPrice), idpCount))
+	for _, dataRequest := range funcParam.DataRequestList {
+		asCount := int64(len(dataRequest.ASIDList))
+		if asCount < dataRequest.MinAs {
+			asCount = dataRequest.MinAs
+		}
+		price = addTokenAmounts(price, mulTokenAmount(TokenAmount(rule.PerAsPrice), asCount))
+		price = addTokenAmounts(price, servicePrices[dataRequest.ServiceID])
+	}
+	if funcParam.ServiceID != "" {
+		price = addTokenAmounts(price, servicePrices[funcParam.ServiceID])
+	}
+	return price
+}
+
+func (app *DIDApplication) setPriceRule(param string, nodeID string) types.ResponseDeliverTx {
+	app.logger.Infof("SetPriceRule, Parameter: %s", param)
+	var funcParam SetPriceRuleParam
+	err := json.Unmarshal([]byte(param), &funcParam)
+	if err != nil {
+		return app.ReturnDeliverTxLog(code.UnmarshalError, err.Error(), "")
+	}
+	// Validate parameter
+	if funcParam.PerIdPPrice < 0 || funcParam.PerASPrice < 0 {
+		return app.ReturnDeliverTxLog(code.AmountMustBeGreaterOrEqualToZero, "Price must be greater than or equal to zero", "")
+	}
+	var rule data.TokenPriceRule
+	rule.PerIdpPrice = int64(funcParam.PerIdPPrice)
+	rule.PerAsPrice = int64(funcParam.PerASPrice)
+	for _, servicePrice := range funcParam.ServicePriceList {
+		if servicePrice.Price < 0 {
+			return app.ReturnDeliverTxLog(code.AmountMustBeGreaterOrEqualToZero, "Price must be greater than or equal to zero", "")
+		}
+		rule.ServicePriceList = append(rule.ServicePriceList, &data.ServicePrice{
+			ServiceId: servicePrice.ServiceID,
+			Price:     int64(servicePrice.Price),
+		})
+	}
+	key := "TokenPriceRule" + "|" + funcParam.Func
+	// A rule without surcharges is removed
+	if rule.PerIdpPrice == 0 && rule.PerAsPrice == 0 && len(rule.ServicePriceList) == 0 {
+		app.DeleteStateDB([]byte(key))
+		return app.ReturnDeliverTxLog(code.OK, "success", "")
+	}
+	value, err := utils.ProtoDeterministicMarshal(&rule)
+	if err != nil {
+		return app.ReturnDeliverTxLog(code.MarshalError, err.Error(), "")
+	}
+	app.SetStateDB([]byte(key), []byte(value))
+	return app.ReturnDeliverTxLog(code.OK, "success", "")
+}
+
+func (app *DIDApplication) getPriceRule(param string, height int64) types.ResponseQuery {
+	app.logger.Infof("GetPriceRule, Parameter: %s", param)
+	var funcParam GetPriceFuncParam
+	err := json.Unmarshal([]byte(param), &funcParam)
+	if err != nil {
+		return app.ReturnQuery(nil, err.Error(), height)
+	}
+	var result GetPriceRuleResult
+	result.Price = app.getTokenPriceByFunc(funcParam.Func, height)
+	result.ServicePriceList = make([]ServicePrice, 0)
+	key := "TokenPriceRule" + "|" + funcParam.Func
+	value := app.GetVersionedStateDB([]byte(key), height)
+	if value != nil {
+		var rule data.TokenPriceRule
+		err = proto.Unmarshal(value, &rule)
+		if err != nil {
+			return app.ReturnQuery(nil, err.Error(), height)
+		}
+		result.PerIdPPrice = TokenAmount(rule.PerIdpPrice)
+		result.PerASPrice = TokenAmount(rule.PerAsPrice)
+		for _, servicePrice := range rule.ServicePriceList {
+			result.ServicePriceList = append(result.ServicePriceList, ServicePrice{servicePrice.ServiceId, TokenAmount(servicePrice.Price)})
+		}
+	}
+	returnValue, err := json.Marshal(result)
+	if err != nil {
+		return app.ReturnQuery(nil, err.Error(), height)
+	}
+	return app.ReturnQuery(returnValue, "success", height)
+}
+
+// getTxCost previews the token price that a transaction would be charged
+func (app *DIDApplication) getTxCost(param string, height int64) types.ResponseQuery {
+	app.logger.Infof("GetTxCost, Parameter: %s", param)
+	var funcParam GetTxCostParam
+	err := json.Unmarshal([]byte(param), &funcParam)
+	if err != nil {
+		return app.ReturnQuery(nil, err.Error(), height)
+	}
+	if !IsMethod[funcParam.Method] {
+		return app.ReturnQuery(nil, "Unknown method name", height)
+	}
+	_, log := validateParams(funcParam.Method, funcParam.Params)
+	if log != "" {
+		return app.ReturnQuery(nil, log, height)
+	}
+	var result GetTxCostResult
+	// NDID and governance methods and transactions of NDID are free
+	if !isNDIDMethod[funcParam.Method] && !isGovernanceMethod[funcParam.Method] && !app.isNDIDAt(funcParam.NodeID, height) {
+		result.Cost = txTokenPrice(app.versionedState(height), funcParam.Method, funcParam.Params)
+	}
+	returnValue, err := json.Marshal(result)
+	if err != nil {
+		return app.ReturnQuery(nil, err.Error(), height)
+	}
+	return app.ReturnQuery(returnValue, "success", height)
+}
+
+func (app *DIDApplication) isNDIDAt(nodeID string, height int64) bool {
+	if nodeID == "" {
+		return false
+	}
+	value := app.GetVersionedStateDB([]byte("NodeID"+"|"+nodeID), height)
+	var node data.NodeDetail
+	err := proto.Unmarshal(value, &node)
+	if err != nil {
+		return false
+	}
+	return node.Role == "NDID"
+}
@@ -0,0 +1,70 @@
+/**
+ * Copyright (c) 2018, 2019 National Digital ID COMPANY LIMITED
+ *
+ * This file is part of NDID software.
+ *
+ * NDID is the free software: you can redistribute it and/or modify it under
+ * the terms of the Affero GNU General Public License as published by the
+ * Free Software Foundation, either version 3 of the License, or any later
+ * version.
+ *
+ * NDID is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.
+ * See the Affero GNU General Public License for more details.
+ *
+ * You should have received a copy of the Affero GNU General Public License
+ * along with the NDID source code. If not, see https://www.gnu.org/licenses/agpl.txt.
+ *
+ * Please contact info@ndid.co.th for any further questions
+ *
+ */
+
+package did
+
+import (
+	"encoding/json"
+
+	"github.com/ndidplatform/smart-contract/abci/proof"
+)
+
+// keyRange is a range [start, end) of state keys read by a query
+type keyRange struct {
+	start []byte
+	end   []byte
+}
+
+// queryProof returns the proofs of every state key and key range read by the
+// query being served, against the tree at height
+func (app *DIDApplication) queryProof(height int64) ([]byte, error) {
+	proofs := proof.Proofs{
+		KeyProofs:   make([]proof.KeyProof, 0),
+		RangeProofs: make([]proof.RangeProof, 0),
+	}
+	proven := make(map[string]bool)
+	for _, key := range app.proofKeys {
+		if proven[string(key)] {
+			continue
+		}
+		proven[string(key)] = true
+		value, rangeProof, err := app.state.db.GetVersionedWithProof(key, height)
+		if err != nil {
+			return nil, err
+		}
+		proofs.KeyProofs = append(proofs.KeyProofs, proof.KeyProof{Key: key, Value: value, Proof: rangeProof})
+	}
+	for _, keyRange := range app.proofRanges {
+		keys, values, rangeProof, err := app.state.db.GetVersionedRangeWithProof(keyRange.start, keyRange.end, 0, height)
+		if err != nil {
+			return nil, err
+		}
+		proofs.RangeProofs = append(proofs.RangeProofs, proof.RangeProof{
+			Start:  keyRange.start,
+			End:    keyRange.end,
+			Keys:   keys,
+			Values: values,
+			Proof:  rangeProof,
+		})
+	}
+	return json.Marshal(proofs)
+}
@@ -105,6 +105,30 @@ func (app *DIDApplication) callQuery(name string, param string, height int64) ty
 		return app.getAccessorOwner(param, height)
 	case "IsInitEnded":
 		return app.isInitEnded(param, height)
+	case "GetGovernance":
+		return app.getGovernanceInfo(param, height)
+	case "GetProposal":
+		return app.getProposal(param, height)
+	case "GetNodePublicKeyHistory":
+		return app.getNodePublicKeyHistory(param, height)
+	case "GetMasterKeyRecovery":
+		return app.getMasterKeyRecovery(param, height)
+	case "GetUpgradeSchedule":
+		return app.getUpgradeSchedule(param, height)
+	case "GetTokenTransferWhitelist":
+		return app.getTokenTransferWhitelist(param, height)
+	case "GetTokenLedger":
+		return app.getTokenLedger(param, height)
+	case "GetPriceRule":
+		return app.getPriceRule(param, height)
+	case "GetTxCost":
+		return app.getTxCost(param, height)
+	case "GetRevenueShareRule":
+		return app.getRevenueShareRule(param, height)
+	case "GetEarnings":
+		return app.getEarnings(param, height)
+	case "GetNodeTokenLimit":
+		return app.getNodeTokenLimit(param, height)
 	default:
 		return types.ResponseQuery{Code: code.UnknownMethod, Log: "Unknown method name"}
 	}
@@ -72,7 +72,7 @@ import (
 // 		return app.ReturnQuery(nil, err.Error(), app.state.db.Version())
 // 	}
 // 	key := "SpendGas" + "|" + funcParam.NodeID
-// 	_, value := app.state.db.GetVersioned(prefixKey([]byte(key)), height)
+// 	value := app.GetVersionedStateDB([]byte(key), height)
 // 	if value == nil {
 // 		value = []byte("[]")
 // 		return app.ReturnQuery(value, "not found", app.state.db.Version())
@@ -0,0 +1,151 @@
+/**
+ * Copyright (c) 2018, 2019 National Digital ID COMPANY LIMITED
+ *
+ * This file is part of NDID software.
+ *
+ * NDID is the free software: you can redistribute it and/or modify it under
+ * the terms of the Affero GNU General Public License as published by the
+ * Free Software Foundation, either version 3 of the License, or any later
+ * version.
+ *
+ * NDID is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.
+ * See the Affero GNU General Public License for more details.
+ *
+ * You should have received a copy of the Affero GNU General Public License
+ * along with the NDID source code. If not, see https://www.gnu.org/licenses/agpl.txt.
+ *
+ * Please contact info@ndid.co.th for any further questions
+ *
+ */
+
+package did
+
+import (
+	"github.com/golang/protobuf/proto"
+	"github.com/ndidplatform/smart-contract/abci/utils"
+	"github.com/ndidplatform/smart-contract/protos/data"
+)
+
+// Token price of CreateRequest is held in escrow of the request instead of
+// being burned, until the request is closed or timed out
+func requestEscrowKey(requestID string) string {
+	return "RequestEscrow" + "|" + requestID
+}
+
+func (app *DIDApplication) holdRequestEscrow(requestID string, amount TokenAmount) error {
+	if amount == 0 {
+		return nil
+	}
+	var escrow data.RequestEscrow
+	escrow.Amount = int64(amount)
+	value, err := utils.ProtoDeterministicMarshal(&escrow)
+	if err != nil {
+		return err
+	}
+	app.SetStateDB([]byte(requestEscrowKey(requestID)), value)
+	return nil
+}
+
+// isResponseCounted reports whether the owner of the request has not marked
+// the response as invalid
+func isResponseCounted(response *data.Response) bool {
+	return response.ValidProof != "false" &&
+		response.ValidIal != "false" &&
+		response.ValidSignature != "false"
+}
+
+// settleRequestEscrow pays out escrow of request. There is a share for each
+// IdP the request needs (min_idp, or every IdP in the response list if more
+// responded) and for each AS each data request needs (min_as, or every
+// answered AS if more answered). IdPs with counted responses and answered ASes
+// are paid their shares. Without a revenue share rule the escrow is split
+// equally among all shares. With a rule, the IdP shares split idp_percent of
+// the escrow, the AS shares split as_percent and the rest is burned when the
+// request is closed. Shares nobody earned, the remainder of the division and,
+// for a timed out request, the rest go back to the owner. method is recorded
+// in the token ledger entries.
+func (app *DIDApplication) settleRequestEscrow(request *data.Request, method string) {
+	key := requestEscrowKey(request.RequestId)
+	_, value := app.state.db.Get(prefixKey([]byte(key)))
+	if value == nil {
+		return
+	}
+	app.DeleteStateDB([]byte(key))
+	var escrow data.RequestEscrow
+	err := proto.Unmarshal(value, &escrow)
+	if err != nil {
+		app.logger.Errorf("Can not settle escrow of request %s: %s", request.RequestId, err.Error())
+		return
+	}
+	amount := TokenAmount(escrow.Amount)
+	var idpPayees []string
+	idpShareCount := request.MinIdp
+	if int64(len(request.ResponseList)) > idpShareCount {
+		idpShareCount = int64(len(request.ResponseList))
+	}
+	for _, response := range request.ResponseList {
+		if isResponseCounted(response) {
+			idpPayees = append(idpPayees, response.IdpId)
+		}
+	}
+	var asPayees []string
+	var asShareCount int64
+	for _, dataRequest := range request.DataRequestList {
+		asPayees = append(asPayees, dataRequest.AnsweredAsIdList...)
+		if int64(len(dataRequest.AnsweredAsIdList)) > dataRequest.MinAs {
+			asShareCount += int64(len(dataRequest.AnsweredAsIdList))
+		} else {
+			asShareCount += dataRequest.MinAs
+		}
+	}
+	var idpPool, asPool TokenAmount
+	rule, ruleExists := app.revenueShareRule()
+	if ruleExists {
+		idpPool = percentOfTokenAmount(amount, rule.IdpPercent)
+		asPool = percentOfTokenAmount(amount, rule.AsPercent)
+	} else if idpShareCount+asShareCount > 0 {
+		share := amount / TokenAmount(idpShareCount+asShareCount)
+		idpPool = share * TokenAmount(idpShareCount)
+		asPool = share * TokenAmount(asShareCount)
+	}
+	refund := amount
+	if ruleExists && !request.TimedOut {
+		refund = idpPool + asPool
+	}
+	refund -= app.payRevenueShares(request, method, idpPayees, idpPool, idpShareCount)
+	refund -= app.payRevenueShares(request, method, asPayees, asPool, asShareCount)
+	if refund == 0 {
+		return
+	}
+	err = app.addToken(request.Owner, refund)
+	if err != nil {
+		app.logger.Errorf("Can not refund escrow of request %s: %s", request.RequestId, err.Error())
+		return
+	}
+	app.writeTokenLedger(request.Owner, method, refund, request.RequestId)
+}
+
+// payRevenueShares pays each payee a share of pool split into shareCount
+// shares and returns the total paid
+func (app *DIDApplication) payRevenueShares(request *data.Request, method string, payees []string, pool TokenAmount, shareCount int64) (paid TokenAmount) {
+	if shareCount <= 0 {
+		return 0
+	}
+	share := pool / TokenAmount(shareCount)
+	if share == 0 {
+		return 0
+	}
+	for _, payee := range payees {
+		// A share that can not be paid goes back to the owner
+		err := app.addToken(payee, share)
+		if err != nil {
+			continue
+		}
+		position := app.writeTokenLedger(payee, method, share, request.RequestId)
+		app.writeEarning(payee, position, method, share, request.RequestId)
+		paid += share
+	}
+	return paid
+}
@@ -0,0 +1,83 @@
+/**
+ * Copyright (c) 2018, 2019 National Digital ID COMPANY LIMITED
+ *
+ * This file is part of NDID software.
+ *
+ * NDID is the free software: you can redistribute it and/or modify it under
+ * the terms of the Affero GNU General Public License as published by the
+ * Free Software Foundation, either version 3 of the License, or any later
+ * version.
+ *
+ * NDID is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.
+ * See the Affero GNU General Public License for more details.
+ *
+ * You should have received a copy of the Affero GNU General Public License
+ * along with the NDID source code. If not, see https://www.gnu.org/licenses/agpl.txt.
+ *
+ * Please contact info@ndid.co.th for any further questions
+ *
+ */
+
+package did
+
+import (
+	"fmt"
+
+	"github.com/golang/protobuf/proto"
+	"github.com/ndidplatform/smart-contract/abci/utils"
+	"github.com/ndidplatform/smart-contract/protos/data"
+	cmn "github.com/tendermint/tendermint/libs/common"
+)
+
+// defaultRequestTimeoutBlock is the number of blocks a request stays open
+// when request_timeout_block is not given in CreateRequest
+const defaultRequestTimeoutBlock int64 = 17280
+
+// maxRequestTimeoutBlock is the largest request_timeout_block CreateRequest
+// accepts, 30 times the default
+const maxRequestTimeoutBlock int64 = 30 * defaultRequestTimeoutBlock
+
+func requestExpireKey(height int64, requestID string) string {
+	return "RequestExpire" + "|" + fmt.Sprintf("%020d", height) + "|" + requestID
+}
+
+// isRequestExpired reports whether request has passed its expire block height
+func (app *DIDApplication) isRequestExpired(request *data.Request) bool {
+	return request.ExpireBlockHeight > 0 && app.CurrentBlock > request.ExpireBlockHeight
+}
+
+// timeOutExpiredRequests marks requests whose expire block height is at or below height as timed out
+// and settles their escrow
+func (app *DIDApplication) timeOutExpiredRequests(height int64) (tags []cmn.KVPair) {
+	startKey := prefixKey([]byte("RequestExpire" + "|"))
+	endKey := prefixKey([]byte(requestExpireKey(height+1, "")))
+	var expireKeys [][]byte
+	var requestIDs []string
+	app.state.db.IterateRange(startKey, endKey, true, func(key []byte, value []byte) bool {
+		expireKeys = append(expireKeys, key)
+		requestIDs = append(requestIDs, string(value))
+		return false
+	})
+	for index, requestID := range requestIDs {
+		app.state.db.Remove(expireKeys[index])
+		key := "Request" + "|" + requestID
+		_, value := app.state.db.Get(prefixKey([]byte(key)))
+		var request data.Request
+		err := proto.Unmarshal(value, &request)
+		if err != nil || request.Closed || request.TimedOut {
+			continue
+		}
+		request.TimedOut = true
+		requestValue, err := utils.ProtoDeterministicMarshal(&request)
+		if err != nil {
+			continue
+		}
+		app.SetStateDB([]byte(key), []byte(requestValue))
+		app.settleRequestEscrow(&request, "RequestTimedOut")
+		app.logger.Infof("Request timed out, RequestID: %s", requestID)
+		tags = append(tags, cmn.KVPair{Key: []byte(tagRequestTimedOut), Value: []byte(requestID)})
+	}
+	return tags
+}
@@ -0,0 +1,153 @@
+/**
+ * Copyright (c) 2018, 2019 National Digital ID COMPANY LIMITED
+ *
+ * This file is part of NDID software.
+ *
+ * NDID is the free software: you can redistribute it and/or modify it under
+ * the terms of the Affero GNU General Public License as published by the
+ * Free Software Foundation, either version 3 of the License, or any later
+ * version.
+ *
+ * NDID is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.
+ * See the Affero GNU General Public License for more details.
+ *
+ * You should have received a copy of the Affero GNU General Public License
+ * along with the NDID source code. If not, see https://www.gnu.org/licenses/agpl.txt.
+ *
+ * Please contact info@ndid.co.th for any further questions
+ *
+ */
+
+package did
+
+import (
+	"encoding/json"
+
+	"github.com/golang/protobuf/proto"
+	"github.com/ndidplatform/smart-contract/abci/code"
+	"github.com/ndidplatform/smart-contract/abci/utils"
+	"github.com/ndidplatform/smart-contract/protos/data"
+	"github.com/tendermint/tendermint/abci/types"
+)
+
+const revenueShareRuleKey = "RevenueShareRule"
+
+// Earnings of a node are the revenue shares paid to it, keyed by the position
+// of their token ledger entries
+func earningKey(nodeID string, position string) string {
+	return "Earning" + "|" + nodeID + "|" + position
+}
+
+func (app *DIDApplication) revenueShareRule() (rule data.RevenueShareRule, exists bool) {
+	_, value := app.state.db.Get(prefixKey([]byte(revenueShareRuleKey)))
+	if value == nil {
+		return rule, false
+	}
+	err := proto.Unmarshal(value, &rule)
+	if err != nil {
+		return rule, false
+	}
+	return rule, true
+}
+
+func (app *DIDApplication) writeEarning(nodeID string, position string, method string, amount TokenAmount, requestID string) {
+	if position == "" {
+		return
+	}
+	var entry data.TokenLedgerEntry
+	entry.Method = method
+	entry.Amount = int64(amount)
+	entry.RequestId = requestID
+	value, err := utils.ProtoDeterministicMarshal(&entry)
+	if err != nil {
+		app.logger.Errorf("Can not write earning of %s: %s", nodeID, err.Error())
+		return
+	}
+	app.SetStateDB([]byte(earningKey(nodeID, position)), value)
+}
+
+func (app *DIDApplication) setRevenueShareRule(param string, nodeID string) types.ResponseDeliverTx {
+	app.logger.Infof("SetRevenueShareRule, Parameter: %s", param)
+	var funcParam SetRevenueShareRuleParam
+	err := json.Unmarshal([]byte(param), &funcParam)
+	if err != nil {
+		return app.ReturnDeliverTxLog(code.UnmarshalError, err.Error(), "")
+	}
+	if funcParam.IdPPercent < 0 || funcParam.ASPercent < 0 || funcParam.IdPPercent+funcParam.ASPercent > 100 {
+		return app.ReturnDeliverTxLog(code.InvalidRevenueShareRule, "Revenue shares must not be negative and must not add up to more than 100 percent", "")
+	}
+	// Without a rule the escrow is split equally among all shares
+	if funcParam.IdPPercent == 0 && funcParam.ASPercent == 0 {
+		app.DeleteStateDB([]byte(revenueShareRuleKey))
+		return app.ReturnDeliverTxLog(code.OK, "success", "")
+	}
+	var rule data.RevenueShareRule
+	rule.IdpPercent = funcParam.IdPPercent
+	rule.AsPercent = funcParam.ASPercent
+	value, err := utils.ProtoDeterministicMarshal(&rule)
+	if err != nil {
+		return app.ReturnDeliverTxLog(code.MarshalError, err.Error(), "")
+	}
+	app.SetStateDB([]byte(revenueShareRuleKey), []byte(value))
+	return app.ReturnDeliverTxLog(code.OK, "success", "")
+}
+
+func (app *DIDApplication) getRevenueShareRule(param string, height int64) types.ResponseQuery {
+	app.logger.Infof("GetRevenueShareRule, Parameter: %s", param)
+	var result GetRevenueShareRuleResult
+	value := app.GetVersionedStateDB([]byte(revenueShareRuleKey), height)
+	if value != nil {
+		var rule data.RevenueShareRule
+		err := proto.Unmarshal(value, &rule)
+		if err != nil {
+			return app.ReturnQuery(nil, err.Error(), height)
+		}
+		result.IdPPercent = rule.IdpPercent
+		result.ASPercent = rule.AsPercent
+	}
+	returnValue, err := json.Marshal(result)
+	if err != nil {
+		return app.ReturnQuery(nil, err.Error(), height)
+	}
+	return app.ReturnQuery(returnValue, "success", height)
+}
+
+func (app *DIDApplication) getEarnings(param string, height int64) types.ResponseQuery {
+	app.logger.Infof("GetEarnings, Parameter: %s", param)
+	var funcParam GetEarningsParam
+	err := json.Unmarshal([]byte(param), &funcParam)
+	if err != nil {
+		return app.ReturnQuery(nil, err.Error(), height)
+	}
+	toHeight := funcParam.ToHeight
+	if toHeight <= 0 || toHeight > height {
+		toHeight = height
+	}
+	startKey := earningKey(funcParam.NodeID, tokenLedgerPosition(funcParam.FromHeight, 0, 0))
+	endKey := earningKey(funcParam.NodeID, tokenLedgerPosition(toHeight+1, 0, 0))
+	var result GetEarningsResult
+	var iterateErr error
+	err = app.IterateVersionedStateDB([]byte(startKey), []byte(endKey), height, func(key []byte, value []byte) bool {
+		var entry data.TokenLedgerEntry
+		iterateErr = proto.Unmarshal(value, &entry)
+		if iterateErr != nil {
+			return true
+		}
+		result.Amount = addTokenAmounts(result.Amount, TokenAmount(entry.Amount))
+		result.PayoutCount++
+		return false
+	})
+	if err == nil {
+		err = iterateErr
+	}
+	if err != nil {
+		return app.ReturnQuery(nil, err.Error(), height)
+	}
+	returnValue, err := json.Marshal(result)
+	if err != nil {
+		return app.ReturnQuery(nil, err.Error(), height)
+	}
+	return app.ReturnQuery(returnValue, "success", height)
+}
@@ -24,6 +24,7 @@ package did
 
 import (
 	"encoding/json"
+	"fmt"
 
 	"github.com/golang/protobuf/proto"
 	"github.com/ndidplatform/smart-contract/abci/code"
@@ -187,6 +188,18 @@ func (app *DIDApplication) createRequest(param string, nodeID string) types.Resp
 	request.CreationBlockHeight = app.CurrentBlock
 	// set chain_id
 	request.ChainId = app.CurrentChain
+	// set expire_block_height
+	if funcParam.TimeoutBlock < 0 {
+		return app.ReturnDeliverTxLog(code.InvalidParameter, "Request timeout block must not be negative", "")
+	}
+	if funcParam.TimeoutBlock > maxRequestTimeoutBlock {
+		return app.ReturnDeliverTxLog(code.InvalidParameter, fmt.Sprintf("Request timeout block must not be greater than %d", maxRequestTimeoutBlock), "")
+	}
+	timeoutBlock := funcParam.TimeoutBlock
+	if timeoutBlock == 0 {
+		timeoutBlock = defaultRequestTimeoutBlock
+	}
+	request.ExpireBlockHeight = app.CurrentBlock + timeoutBlock
 	key := "Request" + "|" + request.RequestId
 	value, err := utils.ProtoDeterministicMarshal(&request)
 	if err != nil {
@@ -197,6 +210,11 @@ func (app *DIDApplication) createRequest(param string, nodeID string) types.Resp
 		return app.ReturnDeliverTxLog(code.DuplicateRequestID, "Duplicate Request ID", "")
 	}
 	app.SetStateDB([]byte(key), []byte(value))
+	app.SetStateDB([]byte(requestExpireKey(request.ExpireBlockHeight, request.RequestId)), []byte(request.RequestId))
+	err = app.holdRequestEscrow(request.RequestId, app.getTxTokenPrice("CreateRequest", param))
+	if err != nil {
+		return app.ReturnDeliverTxLog(code.MarshalError, err.Error(), "")
+	}
 	return app.ReturnDeliverTxLog(code.OK, "success", request.RequestId)
 }
 
@@ -256,6 +274,8 @@ func (app *DIDApplication) closeRequest(param string, nodeID string) types.Respo
 		return app.ReturnDeliverTxLog(code.MarshalError, err.Error(), "")
 	}
 	app.SetStateDB([]byte(key), []byte(value))
+	app.DeleteStateDB([]byte(requestExpireKey(request.ExpireBlockHeight, funcParam.RequestID)))
+	app.settleRequestEscrow(&request, "CloseRequest")
 	return app.ReturnDeliverTxLog(code.OK, "success", funcParam.RequestID)
 }
 
@@ -315,6 +335,8 @@ func (app *DIDApplication) timeOutRequest(param string, nodeID string) types.Res
 		return app.ReturnDeliverTxLog(code.MarshalError, err.Error(), "")
 	}
 	app.SetStateDB([]byte(key), []byte(value))
+	app.DeleteStateDB([]byte(requestExpireKey(request.ExpireBlockHeight, funcParam.RequestID)))
+	app.settleRequestEscrow(&request, "TimeOutRequest")
 	return app.ReturnDeliverTxLog(code.OK, "success", funcParam.RequestID)
 }
 
@@ -0,0 +1,127 @@
+/**
+ * Copyright (c) 2018, 2019 National Digital ID COMPANY LIMITED
+ *
+ * This file is part of NDID software.
+ *
+ * NDID is the free software: you can redistribute it and/or modify it under
+ * the terms of the Affero GNU General Public License as published by the
+ * Free Software Foundation, either version 3 of the License, or any later
+ * version.
+ *
+ * NDID is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.
+ * See the Affero GNU General Public License for more details.
+ *
+ * You should have received a copy of the Affero GNU General Public License
+ * along with the NDID source code. If not, see https://www.gnu.org/licenses/agpl.txt.
+ *
+ * Please contact info@ndid.co.th for any further questions
+ *
+ */
+
+package did
+
+import (
+	"encoding/json"
+	"reflect"
+	"strings"
+
+	cmn "github.com/tendermint/tendermint/libs/common"
+)
+
+// Tag keys of DeliverTx and EndBlock responses. Indexers and websocket
+// subscribers query by these keys, so they must not be renamed.
+const (
+	// Every transaction
+	tagSuccess = "success"
+	tagMethod  = "method"
+	tagNodeID  = "node_id"
+
+	// Successful transactions whose params carry the field
+	tagRequestID       = "request_id"
+	tagServiceID       = "service_id"
+	tagHashID          = "hash_id"
+	tagAccessorGroupID = "accessor_group_id"
+	tagAccessorID      = "accessor_id"
+	tagNamespace       = "namespace"
+	tagTargetNodeID    = "target_node_id"
+	tagProxyNodeID     = "proxy_node_id"
+
+	// Events of proposals, master key recoveries, requests, upgrades and
+	// token transfers
+	tagProposalID              = "proposal.id"
+	tagProposalStatus          = "proposal.status"
+	tagProposalLapsed          = "proposal.lapsed"
+	tagMasterKeyRecoveryNodeID = "master_key_recovery.node_id"
+	tagMasterKeyRecoveryStatus = "master_key_recovery.status"
+	tagRequestTimedOut         = "request.timed_out"
+	tagUpgradeVersion          = "upgrade.version"
+	tagUpgradeHeight           = "upgrade.height"
+	tagTokenTransferFrom       = "token_transfer.from_node_id"
+	tagTokenTransferTo         = "token_transfer.to_node_id"
+
+	// Token accounts whose balance drops to the low balance threshold
+	tagTokenLow = "token_low"
+)
+
+// paramsTagKeys maps params fields, by JSON name, to the tag they are emitted as
+var paramsTagKeys = map[string]string{
+	"request_id":        tagRequestID,
+	"service_id":        tagServiceID,
+	"hash_id":           tagHashID,
+	"accessor_group_id": tagAccessorGroupID,
+	"accessor_id":       tagAccessorID,
+	"namespace":         tagNamespace,
+	"node_id":           tagTargetNodeID,
+	"proxy_node_id":     tagProxyNodeID,
+}
+
+func txTags(method string, nodeID string) []cmn.KVPair {
+	return []cmn.KVPair{
+		{Key: []byte(tagMethod), Value: []byte(method)},
+		{Key: []byte(tagNodeID), Value: []byte(nodeID)},
+	}
+}
+
+// paramsTags returns a tag for each distinct value of the fields in
+// paramsTagKeys found in params of method, nested fields included
+func paramsTags(method string, param string) (tags []cmn.KVPair) {
+	newParams, ok := paramsSchemas[method]
+	if !ok {
+		return nil
+	}
+	params := newParams()
+	err := json.Unmarshal([]byte(param), params)
+	if err != nil {
+		return nil
+	}
+	seen := make(map[string]bool)
+	collectTags(reflect.ValueOf(params).Elem(), seen, &tags)
+	return tags
+}
+
+func collectTags(value reflect.Value, seen map[string]bool, tags *[]cmn.KVPair) {
+	for i := 0; i < value.NumField(); i++ {
+		name := strings.Split(value.Type().Field(i).Tag.Get("json"), ",")[0]
+		fieldValue := value.Field(i)
+		switch fieldValue.Kind() {
+		case reflect.String:
+			tagKey, ok := paramsTagKeys[name]
+			if !ok || fieldValue.Len() == 0 || seen[tagKey+"|"+fieldValue.String()] {
+				continue
+			}
+			seen[tagKey+"|"+fieldValue.String()] = true
+			*tags = append(*tags, cmn.KVPair{Key: []byte(tagKey), Value: []byte(fieldValue.String())})
+		case reflect.Struct:
+			collectTags(fieldValue, seen, tags)
+		case reflect.Slice:
+			if fieldValue.Type().Elem().Kind() != reflect.Struct {
+				continue
+			}
+			for j := 0; j < fieldValue.Len(); j++ {
+				collectTags(fieldValue.Index(j), seen, tags)
+			}
+		}
+	}
+}
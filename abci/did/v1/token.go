@@ -23,36 +23,103 @@
 package did
 
 import (
+	"bytes"
 	"encoding/json"
 	"errors"
+	"math"
 
 	"github.com/golang/protobuf/proto"
 	"github.com/ndidplatform/smart-contract/abci/code"
 	"github.com/ndidplatform/smart-contract/abci/utils"
 	"github.com/tendermint/tendermint/abci/types"
+	cmn "github.com/tendermint/tendermint/libs/common"
 
 	data "github.com/ndidplatform/smart-contract/protos/data"
 )
 
-func (app *DIDApplication) getTokenPriceByFunc(fnName string, height int64) float64 {
+var errTokenAmountOverflow = errors.New("token amount overflow")
+
+// tokenBalance returns the balance of a token account. A float balance
+// written before fixed-point accounting is converted to minor units.
+func tokenBalance(token *data.Token) TokenAmount {
+	if token.MinorAmount == 0 && token.Amount != 0 {
+		return tokenAmountFromFloat(token.Amount)
+	}
+	return TokenAmount(token.MinorAmount)
+}
+
+func setTokenBalance(token *data.Token, amount TokenAmount) {
+	token.Amount = 0
+	token.MinorAmount = int64(amount)
+}
+
+// tokenPriceAmount returns a token price. A float price written before
+// fixed-point accounting is converted to minor units.
+func tokenPriceAmount(tokenPrice *data.TokenPrice) TokenAmount {
+	if tokenPrice.MinorPrice == 0 && tokenPrice.Price != 0 {
+		return tokenAmountFromFloat(tokenPrice.Price)
+	}
+	return TokenAmount(tokenPrice.MinorPrice)
+}
+
+// migrateTokenValue rewrites a float token balance or price of a state
+// backup taken before fixed-point accounting in minor units. Values of other
+// keys are returned unchanged.
+func migrateTokenValue(key []byte, value []byte) []byte {
+	switch {
+	case bytes.HasPrefix(key, prefixKey([]byte("TokenPriceFunc|"))):
+		var tokenPrice data.TokenPrice
+		err := proto.Unmarshal(value, &tokenPrice)
+		if err != nil {
+			return value
+		}
+		price := tokenPriceAmount(&tokenPrice)
+		tokenPrice.Price = 0
+		tokenPrice.MinorPrice = int64(price)
+		migrated, err := utils.ProtoDeterministicMarshal(&tokenPrice)
+		if err != nil {
+			return value
+		}
+		return migrated
+	case bytes.HasPrefix(key, prefixKey([]byte("Token|"))):
+		var token data.Token
+		err := proto.Unmarshal(value, &token)
+		if err != nil {
+			return value
+		}
+		setTokenBalance(&token, tokenBalance(&token))
+		migrated, err := utils.ProtoDeterministicMarshal(&token)
+		if err != nil {
+			return value
+		}
+		return migrated
+	}
+	return value
+}
+
+func (app *DIDApplication) getTokenPriceByFunc(fnName string, height int64) TokenAmount {
 	key := "TokenPriceFunc" + "|" + fnName
-	_, value := app.state.db.Get(prefixKey([]byte(key)))
+	value := app.GetVersionedStateDB([]byte(key), height)
+	return unmarshalTokenPrice(value)
+}
+
+func unmarshalTokenPrice(value []byte) TokenAmount {
 	if value == nil {
 		// if not set price of Function --> return price=1
-		return 1.0
+		return TokenScale
 	}
 	var tokenPrice data.TokenPrice
 	err := proto.Unmarshal(value, &tokenPrice)
 	if err != nil {
-		return 1.0
+		return TokenScale
 	}
-	return tokenPrice.Price
+	return tokenPriceAmount(&tokenPrice)
 }
 
-func (app *DIDApplication) setTokenPriceByFunc(fnName string, price float64) error {
+func (app *DIDApplication) setTokenPriceByFunc(fnName string, price TokenAmount) error {
 	key := "TokenPriceFunc" + "|" + fnName
 	var tokenPrice data.TokenPrice
-	tokenPrice.Price = price
+	tokenPrice.MinorPrice = int64(price)
 	value, err := utils.ProtoDeterministicMarshal(&tokenPrice)
 	if err != nil {
 		return err
@@ -64,12 +131,12 @@ func (app *DIDApplication) setTokenPriceByFunc(fnName string, price float64) err
 func (app *DIDApplication) createTokenAccount(nodeID string) {
 	key := "Token" + "|" + nodeID
 	var token data.Token
-	token.Amount = 0
+	setTokenBalance(&token, 0)
 	value, _ := utils.ProtoDeterministicMarshal(&token)
 	app.SetStateDB([]byte(key), []byte(value))
 }
 
-func (app *DIDApplication) setToken(nodeID string, amount float64) error {
+func (app *DIDApplication) setToken(nodeID string, amount TokenAmount) error {
 	key := "Token" + "|" + nodeID
 	_, value := app.state.db.Get(prefixKey([]byte(key)))
 	if value == nil {
@@ -80,7 +147,7 @@ func (app *DIDApplication) setToken(nodeID string, amount float64) error {
 	if err != nil {
 		return errors.New("token account not found")
 	}
-	token.Amount = amount
+	setTokenBalance(&token, amount)
 	value, err = utils.ProtoDeterministicMarshal(&token)
 	if err != nil {
 		return errors.New("token account not found")
@@ -108,7 +175,7 @@ func (app *DIDApplication) getPriceFunc(param string, height int64) types.Respon
 	var funcParam GetPriceFuncParam
 	err := json.Unmarshal([]byte(param), &funcParam)
 	if err != nil {
-		return app.ReturnQuery(nil, err.Error(), app.state.db.Version())
+		return app.ReturnQuery(nil, err.Error(), height)
 	}
 	price := app.getTokenPriceByFunc(funcParam.Func, height)
 	var res = GetPriceFuncResult{
@@ -116,12 +183,12 @@ func (app *DIDApplication) getPriceFunc(param string, height int64) types.Respon
 	}
 	value, err := json.Marshal(res)
 	if err != nil {
-		return app.ReturnQuery(nil, err.Error(), app.state.db.Version())
+		return app.ReturnQuery(nil, err.Error(), height)
 	}
-	return app.ReturnQuery(value, "success", app.state.db.Version())
+	return app.ReturnQuery(value, "success", height)
 }
 
-func (app *DIDApplication) addToken(nodeID string, amount float64) error {
+func (app *DIDApplication) addToken(nodeID string, amount TokenAmount) error {
 	key := "Token" + "|" + nodeID
 	_, value := app.state.db.Get(prefixKey([]byte(key)))
 	if value == nil {
@@ -132,7 +199,11 @@ func (app *DIDApplication) addToken(nodeID string, amount float64) error {
 	if err != nil {
 		return errors.New("token account not found")
 	}
-	token.Amount = token.Amount + amount
+	balance := tokenBalance(&token)
+	if balance > 0 && amount > math.MaxInt64-balance {
+		return errTokenAmountOverflow
+	}
+	setTokenBalance(&token, balance+amount)
 	value, err = utils.ProtoDeterministicMarshal(&token)
 	if err != nil {
 		return errors.New("token account not found")
@@ -155,7 +226,7 @@ func (app *DIDApplication) checkTokenAccount(nodeID string) bool {
 	return true
 }
 
-func (app *DIDApplication) reduceToken(nodeID string, amount float64) error {
+func (app *DIDApplication) reduceToken(nodeID string, amount TokenAmount) error {
 	key := "Token" + "|" + nodeID
 	_, value := app.state.db.Get(prefixKey([]byte(key)))
 	if value == nil {
@@ -166,10 +237,15 @@ func (app *DIDApplication) reduceToken(nodeID string, amount float64) error {
 	if err != nil {
 		return errors.New("token account not found")
 	}
-	if amount > token.Amount {
+	balance := tokenBalance(&token)
+	if amount > addTokenAmounts(balance, TokenAmount(token.CreditLimit)) {
 		return errors.New("token not enough")
 	}
-	token.Amount = token.Amount - amount
+	setTokenBalance(&token, balance-amount)
+	threshold := TokenAmount(token.LowBalanceThreshold)
+	if token.LowBalanceAlert && balance > threshold && balance-amount <= threshold {
+		app.lowTokenNodes = append(app.lowTokenNodes, nodeID)
+	}
 	value, err = utils.ProtoDeterministicMarshal(&token)
 	if err != nil {
 		return errors.New("token account not found")
@@ -178,7 +254,15 @@ func (app *DIDApplication) reduceToken(nodeID string, amount float64) error {
 	return nil
 }
 
-func (app *DIDApplication) getToken(nodeID string) (float64, error) {
+func (app *DIDApplication) getToken(nodeID string) (TokenAmount, error) {
+	key := "Token" + "|" + nodeID
+	_, value := app.state.db.Get(prefixKey([]byte(key)))
+	return unmarshalToken(value)
+}
+
+// getAvailableToken returns balance of token account of nodeID plus its
+// credit limit
+func (app *DIDApplication) getAvailableToken(nodeID string) (TokenAmount, error) {
 	key := "Token" + "|" + nodeID
 	_, value := app.state.db.Get(prefixKey([]byte(key)))
 	if value == nil {
@@ -189,7 +273,25 @@ func (app *DIDApplication) getToken(nodeID string) (float64, error) {
 	if err != nil {
 		return 0, errors.New("token account not found")
 	}
-	return token.Amount, nil
+	return addTokenAmounts(tokenBalance(&token), TokenAmount(token.CreditLimit)), nil
+}
+
+func (app *DIDApplication) getVersionedToken(nodeID string, height int64) (TokenAmount, error) {
+	key := "Token" + "|" + nodeID
+	value := app.GetVersionedStateDB([]byte(key), height)
+	return unmarshalToken(value)
+}
+
+func unmarshalToken(value []byte) (TokenAmount, error) {
+	if value == nil {
+		return 0, errors.New("token account not found")
+	}
+	var token data.Token
+	err := proto.Unmarshal(value, &token)
+	if err != nil {
+		return 0, errors.New("token account not found")
+	}
+	return tokenBalance(&token), nil
 }
 
 func (app *DIDApplication) setNodeToken(param string, nodeID string) types.ResponseDeliverTx {
@@ -207,10 +309,15 @@ func (app *DIDApplication) setNodeToken(param string, nodeID string) types.Respo
 	if !app.checkTokenAccount(funcParam.NodeID) {
 		return app.ReturnDeliverTxLog(code.TokenAccountNotFound, "token account not found", "")
 	}
+	balance, err := app.getToken(funcParam.NodeID)
+	if err != nil {
+		return app.ReturnDeliverTxLog(code.TokenAccountNotFound, err.Error(), "")
+	}
 	err = app.setToken(funcParam.NodeID, funcParam.Amount)
 	if err != nil {
 		return app.ReturnDeliverTxLog(code.TokenAccountNotFound, err.Error(), "")
 	}
+	app.writeTokenLedger(funcParam.NodeID, "SetNodeToken", funcParam.Amount-balance, "")
 	return app.ReturnDeliverTxLog(code.OK, "success", "")
 }
 
@@ -230,9 +337,13 @@ func (app *DIDApplication) addNodeToken(param string, nodeID string) types.Respo
 		return app.ReturnDeliverTxLog(code.TokenAccountNotFound, "token account not found", "")
 	}
 	err = app.addToken(funcParam.NodeID, funcParam.Amount)
+	if err == errTokenAmountOverflow {
+		return app.ReturnDeliverTxLog(code.TokenAmountOverflow, err.Error(), "")
+	}
 	if err != nil {
 		return app.ReturnDeliverTxLog(code.TokenAccountNotFound, err.Error(), "")
 	}
+	app.writeTokenLedger(funcParam.NodeID, "AddNodeToken", funcParam.Amount, "")
 	return app.ReturnDeliverTxLog(code.OK, "success", "")
 }
 
@@ -255,6 +366,7 @@ func (app *DIDApplication) reduceNodeToken(param string, nodeID string) types.Re
 	if err != nil {
 		return app.ReturnDeliverTxLog(code.TokenNotEnough, err.Error(), "")
 	}
+	app.writeTokenLedger(funcParam.NodeID, "ReduceNodeToken", -funcParam.Amount, "")
 	return app.ReturnDeliverTxLog(code.OK, "success", "")
 }
 
@@ -263,18 +375,228 @@ func (app *DIDApplication) getNodeToken(param string, height int64) types.Respon
 	var funcParam GetNodeTokenParam
 	err := json.Unmarshal([]byte(param), &funcParam)
 	if err != nil {
-		return app.ReturnQuery([]byte("{}"), err.Error(), app.state.db.Version())
+		return app.ReturnQuery([]byte("{}"), err.Error(), height)
 	}
-	tokenAmount, err := app.getToken(funcParam.NodeID)
+	tokenAmount, err := app.getVersionedToken(funcParam.NodeID, height)
 	if err != nil {
-		return app.ReturnQuery([]byte("{}"), "not found", app.state.db.Version())
+		return app.ReturnQuery([]byte("{}"), "not found", height)
 	}
 	var res = GetNodeTokenResult{
 		tokenAmount,
 	}
 	value, err := json.Marshal(res)
 	if err != nil {
-		return app.ReturnQuery(nil, err.Error(), app.state.db.Version())
+		return app.ReturnQuery(nil, err.Error(), height)
+	}
+	return app.ReturnQuery(value, "success", height)
+}
+
+func (app *DIDApplication) checkTxTransferToken(param string, nodeID string) types.ResponseCheckTx {
+	if !app.checkTokenAccount(nodeID) {
+		return ReturnCheckTx(code.TokenAccountNotFound, "token account not found")
+	}
+	return ReturnCheckTx(code.OK, "")
+}
+
+func (app *DIDApplication) transferToken(param string, nodeID string) types.ResponseDeliverTx {
+	app.logger.Infof("TransferToken, Parameter: %s", param)
+	var funcParam TransferTokenParam
+	err := json.Unmarshal([]byte(param), &funcParam)
+	if err != nil {
+		return app.ReturnDeliverTxLog(code.UnmarshalError, err.Error(), "")
+	}
+	// Validate parameter
+	if funcParam.Amount < 0 {
+		return app.ReturnDeliverTxLog(code.AmountMustBeGreaterOrEqualToZero, "Amount must be greater than or equal to zero", "")
+	}
+	if funcParam.ToNodeID == nodeID {
+		return app.ReturnDeliverTxLog(code.CanNotTransferTokenToSelf, "Can not transfer token to self", "")
+	}
+	// Check token account of recipient
+	if !app.checkTokenAccount(funcParam.ToNodeID) {
+		return app.ReturnDeliverTxLog(code.TokenAccountNotFound, "token account not found", "")
+	}
+	whitelist, err := app.tokenTransferWhitelist(nodeID)
+	if err != nil {
+		return app.ReturnDeliverTxLog(code.UnmarshalError, err.Error(), "")
+	}
+	if len(whitelist) > 0 && !containsWord(whitelist, funcParam.ToNodeID) {
+		return app.ReturnDeliverTxLog(code.RecipientIsNotInTokenTransferWhitelist, "Recipient is not in token transfer whitelist", "")
+	}
+	// Sender must also be able to pay for the transaction afterward, the
+	// whole Batch when transferring in a batch
+	balance, err := app.getToken(nodeID)
+	if err != nil {
+		return app.ReturnDeliverTxLog(code.TokenAccountNotFound, err.Error(), "")
+	}
+	price := app.txTokenPrice
+	if balance < price || funcParam.Amount > balance-price {
+		return app.ReturnDeliverTxLog(code.TokenNotEnough, "token not enough", "")
+	}
+	err = app.addToken(funcParam.ToNodeID, funcParam.Amount)
+	if err == errTokenAmountOverflow {
+		return app.ReturnDeliverTxLog(code.TokenAmountOverflow, err.Error(), "")
+	}
+	if err != nil {
+		return app.ReturnDeliverTxLog(code.TokenAccountNotFound, err.Error(), "")
+	}
+	err = app.reduceToken(nodeID, funcParam.Amount)
+	if err != nil {
+		return app.ReturnDeliverTxLog(code.TokenNotEnough, err.Error(), "")
+	}
+	app.writeTokenLedger(nodeID, "TransferToken", -funcParam.Amount, "")
+	app.writeTokenLedger(funcParam.ToNodeID, "TransferToken", funcParam.Amount, "")
+	result := app.ReturnDeliverTxLog(code.OK, "success", "")
+	result.Tags = append(result.Tags,
+		cmn.KVPair{Key: []byte(tagTokenTransferFrom), Value: []byte(nodeID)},
+		cmn.KVPair{Key: []byte(tagTokenTransferTo), Value: []byte(funcParam.ToNodeID)},
+	)
+	return result
+}
+
+// tokenTransferWhitelist returns node IDs a node may transfer token to. An
+// empty list means the node may transfer to any node.
+func (app *DIDApplication) tokenTransferWhitelist(nodeID string) ([]string, error) {
+	key := "TokenTransferWhitelist" + "|" + nodeID
+	_, value := app.state.db.Get(prefixKey([]byte(key)))
+	if value == nil {
+		return nil, nil
+	}
+	var whitelist data.TokenTransferWhitelist
+	err := proto.Unmarshal(value, &whitelist)
+	if err != nil {
+		return nil, err
+	}
+	return whitelist.NodeIdList, nil
+}
+
+func (app *DIDApplication) setTokenTransferWhitelist(param string, nodeID string) types.ResponseDeliverTx {
+	app.logger.Infof("SetTokenTransferWhitelist, Parameter: %s", param)
+	var funcParam SetTokenTransferWhitelistParam
+	err := json.Unmarshal([]byte(param), &funcParam)
+	if err != nil {
+		return app.ReturnDeliverTxLog(code.UnmarshalError, err.Error(), "")
+	}
+	// Check token account
+	if !app.checkTokenAccount(funcParam.NodeID) {
+		return app.ReturnDeliverTxLog(code.TokenAccountNotFound, "token account not found", "")
+	}
+	key := "TokenTransferWhitelist" + "|" + funcParam.NodeID
+	// Empty list removes the whitelist
+	if len(funcParam.NodeIDList) == 0 {
+		app.DeleteStateDB([]byte(key))
+		return app.ReturnDeliverTxLog(code.OK, "success", "")
+	}
+	var whitelist data.TokenTransferWhitelist
+	whitelist.NodeIdList = funcParam.NodeIDList
+	value, err := utils.ProtoDeterministicMarshal(&whitelist)
+	if err != nil {
+		return app.ReturnDeliverTxLog(code.MarshalError, err.Error(), "")
+	}
+	app.SetStateDB([]byte(key), []byte(value))
+	return app.ReturnDeliverTxLog(code.OK, "success", "")
+}
+
+func (app *DIDApplication) getTokenTransferWhitelist(param string, height int64) types.ResponseQuery {
+	app.logger.Infof("GetTokenTransferWhitelist, Parameter: %s", param)
+	var funcParam GetTokenTransferWhitelistParam
+	err := json.Unmarshal([]byte(param), &funcParam)
+	if err != nil {
+		return app.ReturnQuery(nil, err.Error(), height)
+	}
+	key := "TokenTransferWhitelist" + "|" + funcParam.NodeID
+	value := app.GetVersionedStateDB([]byte(key), height)
+	var result GetTokenTransferWhitelistResult
+	result.NodeIDList = make([]string, 0)
+	if value != nil {
+		var whitelist data.TokenTransferWhitelist
+		err = proto.Unmarshal(value, &whitelist)
+		if err != nil {
+			return app.ReturnQuery(nil, err.Error(), height)
+		}
+		result.NodeIDList = whitelist.NodeIdList
+	}
+	returnValue, err := json.Marshal(result)
+	if err != nil {
+		return app.ReturnQuery(nil, err.Error(), height)
+	}
+	return app.ReturnQuery(returnValue, "success", height)
+}
+
+// tokenLowTags tags each node whose balance dropped to its low balance
+// threshold in the transaction being delivered
+func (app *DIDApplication) tokenLowTags() (tags []cmn.KVPair) {
+	var nodeIDs []string
+	for _, nodeID := range app.lowTokenNodes {
+		if !containsWord(nodeIDs, nodeID) {
+			nodeIDs = append(nodeIDs, nodeID)
+			tags = append(tags, cmn.KVPair{Key: []byte(tagTokenLow), Value: []byte(nodeID)})
+		}
+	}
+	return tags
+}
+
+func (app *DIDApplication) setNodeTokenLimit(param string, nodeID string) types.ResponseDeliverTx {
+	app.logger.Infof("SetNodeTokenLimit, Parameter: %s", param)
+	var funcParam SetNodeTokenLimitParam
+	err := json.Unmarshal([]byte(param), &funcParam)
+	if err != nil {
+		return app.ReturnDeliverTxLog(code.UnmarshalError, err.Error(), "")
+	}
+	// Validate parameter
+	if funcParam.CreditLimit < 0 {
+		return app.ReturnDeliverTxLog(code.AmountMustBeGreaterOrEqualToZero, "Credit limit must be greater than or equal to zero", "")
+	}
+	key := "Token" + "|" + funcParam.NodeID
+	_, value := app.state.db.Get(prefixKey([]byte(key)))
+	if value == nil {
+		return app.ReturnDeliverTxLog(code.TokenAccountNotFound, "token account not found", "")
+	}
+	var token data.Token
+	err = proto.Unmarshal(value, &token)
+	if err != nil {
+		return app.ReturnDeliverTxLog(code.UnmarshalError, err.Error(), "")
+	}
+	token.CreditLimit = int64(funcParam.CreditLimit)
+	token.LowBalanceAlert = funcParam.LowBalanceThreshold != nil
+	token.LowBalanceThreshold = 0
+	if funcParam.LowBalanceThreshold != nil {
+		token.LowBalanceThreshold = int64(*funcParam.LowBalanceThreshold)
+	}
+	value, err = utils.ProtoDeterministicMarshal(&token)
+	if err != nil {
+		return app.ReturnDeliverTxLog(code.MarshalError, err.Error(), "")
+	}
+	app.SetStateDB([]byte(key), []byte(value))
+	return app.ReturnDeliverTxLog(code.OK, "success", "")
+}
+
+func (app *DIDApplication) getNodeTokenLimit(param string, height int64) types.ResponseQuery {
+	app.logger.Infof("GetNodeTokenLimit, Parameter: %s", param)
+	var funcParam GetNodeTokenParam
+	err := json.Unmarshal([]byte(param), &funcParam)
+	if err != nil {
+		return app.ReturnQuery(nil, err.Error(), height)
+	}
+	key := "Token" + "|" + funcParam.NodeID
+	value := app.GetVersionedStateDB([]byte(key), height)
+	if value == nil {
+		return app.ReturnQuery(nil, "token account not found", height)
+	}
+	var token data.Token
+	err = proto.Unmarshal(value, &token)
+	if err != nil {
+		return app.ReturnQuery(nil, err.Error(), height)
+	}
+	var result GetNodeTokenLimitResult
+	result.CreditLimit = TokenAmount(token.CreditLimit)
+	if token.LowBalanceAlert {
+		threshold := TokenAmount(token.LowBalanceThreshold)
+		result.LowBalanceThreshold = &threshold
+	}
+	returnValue, err := json.Marshal(result)
+	if err != nil {
+		return app.ReturnQuery(nil, err.Error(), height)
 	}
-	return app.ReturnQuery(value, "success", app.state.db.Version())
+	return app.ReturnQuery(returnValue, "success", height)
 }
@@ -0,0 +1,176 @@
+/**
+ * Copyright (c) 2018, 2019 National Digital ID COMPANY LIMITED
+ *
+ * This file is part of NDID software.
+ *
+ * NDID is the free software: you can redistribute it and/or modify it under
+ * the terms of the Affero GNU General Public License as published by the
+ * Free Software Foundation, either version 3 of the License, or any later
+ * version.
+ *
+ * NDID is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.
+ * See the Affero GNU General Public License for more details.
+ *
+ * You should have received a copy of the Affero GNU General Public License
+ * along with the NDID source code. If not, see https://www.gnu.org/licenses/agpl.txt.
+ *
+ * Please contact info@ndid.co.th for any further questions
+ *
+ */
+
+package did
+
+import (
+	"encoding/json"
+	"fmt"
+	"math"
+	"reflect"
+	"strconv"
+	"strings"
+)
+
+// TokenAmount is an amount of token in fixed-point minor units. Balances and
+// prices are kept and summed as integers so every node computes the same
+// state. It is written to and read from JSON as a decimal number of tokens.
+type TokenAmount int64
+
+// TokenScale is the number of minor units in one token
+const TokenScale = 1000000
+
+const tokenDecimals = 6
+
+// ParseTokenAmount parses a decimal number of tokens, e.g. "9.99", without
+// going through float
+func ParseTokenAmount(s string) (TokenAmount, error) {
+	mantissa, exponent := s, 0
+	if i := strings.IndexAny(s, "eE"); i >= 0 {
+		e, err := strconv.Atoi(s[i+1:])
+		if err != nil {
+			return 0, fmt.Errorf("invalid token amount %s", s)
+		}
+		mantissa, exponent = s[:i], e
+	}
+	sign := ""
+	if strings.HasPrefix(mantissa, "-") {
+		sign, mantissa = "-", mantissa[1:]
+	}
+	whole, fraction := mantissa, ""
+	if i := strings.IndexByte(mantissa, '.'); i >= 0 {
+		whole, fraction = mantissa[:i], mantissa[i+1:]
+	}
+	if whole == "" || !isDigits(whole) || !isDigits(fraction) {
+		return 0, fmt.Errorf("invalid token amount %s", s)
+	}
+	digits := strings.TrimLeft(whole+fraction, "0")
+	if digits == "" {
+		return 0, nil
+	}
+	// Number of zeros to append to digits to get minor units
+	shift := tokenDecimals + exponent - len(fraction)
+	for shift < 0 && strings.HasSuffix(digits, "0") {
+		digits = digits[:len(digits)-1]
+		shift++
+	}
+	if shift < 0 {
+		return 0, fmt.Errorf("token amount %s has more than %d decimal places", s, tokenDecimals)
+	}
+	if len(digits)+shift > 19 {
+		return 0, fmt.Errorf("token amount %s is out of range", s)
+	}
+	units, err := strconv.ParseInt(sign+digits+strings.Repeat("0", shift), 10, 64)
+	if err != nil {
+		return 0, fmt.Errorf("token amount %s is out of range", s)
+	}
+	return TokenAmount(units), nil
+}
+
+func isDigits(s string) bool {
+	for _, c := range s {
+		if c < '0' || c > '9' {
+			return false
+		}
+	}
+	return true
+}
+
+// tokenAmountFromFloat converts a float amount written before fixed-point
+// accounting to the nearest minor unit
+func tokenAmountFromFloat(amount float64) TokenAmount {
+	return TokenAmount(math.Round(amount * TokenScale))
+}
+
+// addTokenAmounts returns a + b, capped at the smallest and the largest
+// amount instead of wrapping around
+func addTokenAmounts(a TokenAmount, b TokenAmount) TokenAmount {
+	if b > 0 && a > math.MaxInt64-b {
+		return math.MaxInt64
+	}
+	if b < 0 && a < math.MinInt64-b {
+		return math.MinInt64
+	}
+	return a + b
+}
+
+// mulTokenAmount returns amount * count, capped like addTokenAmounts
+func mulTokenAmount(amount TokenAmount, count int64) TokenAmount {
+	if amount == 0 || count <= 0 {
+		return 0
+	}
+	if amount > 0 && amount > math.MaxInt64/TokenAmount(count) {
+		return math.MaxInt64
+	}
+	if amount < 0 && amount < math.MinInt64/TokenAmount(count) {
+		return math.MinInt64
+	}
+	return amount * TokenAmount(count)
+}
+
+// percentOfTokenAmount returns percent% of amount rounded down, percent is
+// between 0 and 100
+func percentOfTokenAmount(amount TokenAmount, percent int64) TokenAmount {
+	return amount/100*TokenAmount(percent) + amount%100*TokenAmount(percent)/100
+}
+
+// String returns amount as a decimal number of tokens without trailing zeros
+func (amount TokenAmount) String() string {
+	sign := ""
+	units := uint64(amount)
+	if amount < 0 {
+		sign, units = "-", -units
+	}
+	whole := strconv.FormatUint(units/TokenScale, 10)
+	fraction := units % TokenScale
+	if fraction == 0 {
+		return sign + whole
+	}
+	return sign + whole + "." + strings.TrimRight(fmt.Sprintf("%0*d", tokenDecimals, fraction), "0")
+}
+
+func (amount TokenAmount) MarshalJSON() ([]byte, error) {
+	return []byte(amount.String()), nil
+}
+
+func (amount *TokenAmount) UnmarshalJSON(data []byte) error {
+	value := string(data)
+	if value == "null" {
+		return nil
+	}
+	switch value[0] {
+	case '"':
+		return &json.UnmarshalTypeError{Value: "string", Type: reflect.TypeOf(*amount)}
+	case 't', 'f':
+		return &json.UnmarshalTypeError{Value: "bool", Type: reflect.TypeOf(*amount)}
+	case '{':
+		return &json.UnmarshalTypeError{Value: "object", Type: reflect.TypeOf(*amount)}
+	case '[':
+		return &json.UnmarshalTypeError{Value: "array", Type: reflect.TypeOf(*amount)}
+	}
+	units, err := ParseTokenAmount(value)
+	if err != nil {
+		return err
+	}
+	*amount = units
+	return nil
+}
@@ -0,0 +1,152 @@
+/**
+ * Copyright (c) 2018, 2019 National Digital ID COMPANY LIMITED
+ *
+ * This file is part of NDID software.
+ *
+ * NDID is the free software: you can redistribute it and/or modify it under
+ * the terms of the Affero GNU General Public License as published by the
+ * Free Software Foundation, either version 3 of the License, or any later
+ * version.
+ *
+ * NDID is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.
+ * See the Affero GNU General Public License for more details.
+ *
+ * You should have received a copy of the Affero GNU General Public License
+ * along with the NDID source code. If not, see https://www.gnu.org/licenses/agpl.txt.
+ *
+ * Please contact info@ndid.co.th for any further questions
+ *
+ */
+
+package did
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/golang/protobuf/proto"
+	"github.com/ndidplatform/smart-contract/abci/utils"
+	"github.com/ndidplatform/smart-contract/protos/data"
+	"github.com/tendermint/tendermint/abci/types"
+)
+
+const (
+	defaultTokenLedgerLimit = 100
+	maxTokenLedgerLimit     = 1000
+)
+
+// Token ledger entries of a node are keyed by block height, index of the
+// transaction in the block and index of the entry in the transaction, so
+// entries are listed in the order they were written
+func tokenLedgerKey(nodeID string, position string) string {
+	return "TokenLedger" + "|" + nodeID + "|" + position
+}
+
+func tokenLedgerPosition(height int64, txIndex int64, entryIndex int64) string {
+	return fmt.Sprintf("%020d|%010d|%05d", height, txIndex, entryIndex)
+}
+
+func parseTokenLedgerPosition(position string) (height int64, txIndex int64, entryIndex int64, err error) {
+	if len(position) != len(tokenLedgerPosition(0, 0, 0)) {
+		return 0, 0, 0, fmt.Errorf("invalid token ledger position %s", position)
+	}
+	_, err = fmt.Sscanf(position, "%d|%d|%d", &height, &txIndex, &entryIndex)
+	return height, txIndex, entryIndex, err
+}
+
+// writeTokenLedger records a charge (negative amount) or credit of token
+// account of nodeID made by the transaction being delivered and returns the
+// position of the entry
+func (app *DIDApplication) writeTokenLedger(nodeID string, method string, amount TokenAmount, requestID string) string {
+	var entry data.TokenLedgerEntry
+	entry.Method = method
+	entry.Amount = int64(amount)
+	entry.RequestId = requestID
+	value, err := utils.ProtoDeterministicMarshal(&entry)
+	if err != nil {
+		app.logger.Errorf("Can not write token ledger of %s: %s", nodeID, err.Error())
+		return ""
+	}
+	position := tokenLedgerPosition(app.CurrentBlock, app.txIndex, app.ledgerEntryIndex)
+	app.SetStateDB([]byte(tokenLedgerKey(nodeID, position)), value)
+	app.ledgerEntryIndex++
+	return position
+}
+
+// paramsRequestID returns request_id in params of a transaction, if any
+func paramsRequestID(param string) string {
+	var funcParam struct {
+		RequestID string `json:"request_id"`
+	}
+	json.Unmarshal([]byte(param), &funcParam)
+	return funcParam.RequestID
+}
+
+func (app *DIDApplication) getTokenLedger(param string, height int64) types.ResponseQuery {
+	app.logger.Infof("GetTokenLedger, Parameter: %s", param)
+	var funcParam GetTokenLedgerParam
+	err := json.Unmarshal([]byte(param), &funcParam)
+	if err != nil {
+		return app.ReturnQuery(nil, err.Error(), height)
+	}
+	limit := funcParam.Limit
+	if limit <= 0 {
+		limit = defaultTokenLedgerLimit
+	}
+	if limit > maxTokenLedgerLimit {
+		limit = maxTokenLedgerLimit
+	}
+	toHeight := funcParam.ToHeight
+	if toHeight <= 0 || toHeight > height {
+		toHeight = height
+	}
+	startPosition := tokenLedgerPosition(funcParam.FromHeight, 0, 0)
+	if funcParam.Cursor != "" {
+		_, _, _, err = parseTokenLedgerPosition(funcParam.Cursor)
+		if err != nil {
+			return app.ReturnQuery(nil, "Invalid cursor", height)
+		}
+		if funcParam.Cursor > startPosition {
+			startPosition = funcParam.Cursor
+		}
+	}
+	startKey := tokenLedgerKey(funcParam.NodeID, startPosition)
+	endKey := tokenLedgerKey(funcParam.NodeID, tokenLedgerPosition(toHeight+1, 0, 0))
+	var result GetTokenLedgerResult
+	result.Entries = make([]TokenLedgerEntry, 0)
+	var iterateErr error
+	err = app.IterateVersionedStateDB([]byte(startKey), []byte(endKey), height, func(key []byte, value []byte) bool {
+		position := string(key[len(tokenLedgerKey(funcParam.NodeID, "")):])
+		if len(result.Entries) == limit {
+			result.NextCursor = position
+			return true
+		}
+		var entry data.TokenLedgerEntry
+		iterateErr = proto.Unmarshal(value, &entry)
+		if iterateErr != nil {
+			return true
+		}
+		entryHeight, txIndex, _, _ := parseTokenLedgerPosition(position)
+		result.Entries = append(result.Entries, TokenLedgerEntry{
+			Height:    entryHeight,
+			TxIndex:   txIndex,
+			Method:    entry.Method,
+			Amount:    TokenAmount(entry.Amount),
+			RequestID: entry.RequestId,
+		})
+		return false
+	})
+	if err == nil {
+		err = iterateErr
+	}
+	if err != nil {
+		return app.ReturnQuery(nil, err.Error(), height)
+	}
+	value, err := json.Marshal(result)
+	if err != nil {
+		return app.ReturnQuery(nil, err.Error(), height)
+	}
+	return app.ReturnQuery(value, "success", height)
+}
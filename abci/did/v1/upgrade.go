@@ -0,0 +1,127 @@
+/**
+ * Copyright (c) 2018, 2019 National Digital ID COMPANY LIMITED
+ *
+ * This file is part of NDID software.
+ *
+ * NDID is the free software: you can redistribute it and/or modify it under
+ * the terms of the Affero GNU General Public License as published by the
+ * Free Software Foundation, either version 3 of the License, or any later
+ * version.
+ *
+ * NDID is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.
+ * See the Affero GNU General Public License for more details.
+ *
+ * You should have received a copy of the Affero GNU General Public License
+ * along with the NDID source code. If not, see https://www.gnu.org/licenses/agpl.txt.
+ *
+ * Please contact info@ndid.co.th for any further questions
+ *
+ */
+
+package did
+
+import (
+	"encoding/json"
+	"sort"
+	"strconv"
+
+	"github.com/golang/protobuf/proto"
+	"github.com/ndidplatform/smart-contract/abci/code"
+	"github.com/ndidplatform/smart-contract/abci/utils"
+	"github.com/ndidplatform/smart-contract/protos/data"
+	"github.com/tendermint/tendermint/abci/types"
+	cmn "github.com/tendermint/tendermint/libs/common"
+)
+
+func (app *DIDApplication) scheduleUpgrade(param string, nodeID string) types.ResponseDeliverTx {
+	app.logger.Infof("ScheduleUpgrade, Parameter: %s", param)
+	var funcParam ScheduleUpgradeParam
+	err := json.Unmarshal([]byte(param), &funcParam)
+	if err != nil {
+		return app.ReturnDeliverTxLog(code.UnmarshalError, err.Error(), "")
+	}
+	if funcParam.Height <= app.CurrentBlock {
+		return app.ReturnDeliverTxLog(code.InvalidUpgradeHeight, "Upgrade height must be above current block height", "")
+	}
+	key := "UpgradeSchedule"
+	_, value := app.state.db.Get(prefixKey([]byte(key)))
+	var schedule data.UpgradeSchedule
+	if value != nil {
+		err = proto.Unmarshal(value, &schedule)
+		if err != nil {
+			return app.ReturnDeliverTxLog(code.UnmarshalError, err.Error(), "")
+		}
+	}
+	// An upgrade at the same height is replaced
+	upgrades := make([]*data.ScheduledUpgrade, 0, len(schedule.Upgrades)+1)
+	for _, upgrade := range schedule.Upgrades {
+		if upgrade.Height != funcParam.Height {
+			upgrades = append(upgrades, upgrade)
+		}
+	}
+	upgrades = append(upgrades, &data.ScheduledUpgrade{
+		Height:         funcParam.Height,
+		Version:        funcParam.Version,
+		ScheduledBlock: app.CurrentBlock,
+	})
+	sort.Slice(upgrades, func(i, j int) bool {
+		return upgrades[i].Height < upgrades[j].Height
+	})
+	schedule.Upgrades = upgrades
+	scheduleValue, err := utils.ProtoDeterministicMarshal(&schedule)
+	if err != nil {
+		return app.ReturnDeliverTxLog(code.MarshalError, err.Error(), "")
+	}
+	app.SetStateDB([]byte(key), []byte(scheduleValue))
+	app.logger.Infof("Upgrade to app version %s scheduled at height %d", funcParam.Version, funcParam.Height)
+	result := app.ReturnDeliverTxLog(code.OK, "success", "")
+	result.Tags = append(result.Tags,
+		cmn.KVPair{Key: []byte(tagUpgradeVersion), Value: []byte(funcParam.Version)},
+		cmn.KVPair{Key: []byte(tagUpgradeHeight), Value: []byte(strconv.FormatInt(funcParam.Height, 10))},
+	)
+	return result
+}
+
+// UpgradeSchedule returns the app version of every upgrade scheduled on chain by height
+func (app *DIDApplication) UpgradeSchedule() map[int64]string {
+	_, value := app.state.db.Get(prefixKey([]byte("UpgradeSchedule")))
+	upgrades := make(map[int64]string)
+	if value == nil {
+		return upgrades
+	}
+	var schedule data.UpgradeSchedule
+	err := proto.Unmarshal(value, &schedule)
+	if err != nil {
+		app.logger.Errorf("Can not read upgrade schedule: %s", err.Error())
+		return upgrades
+	}
+	for _, upgrade := range schedule.Upgrades {
+		upgrades[upgrade.Height] = upgrade.Version
+	}
+	return upgrades
+}
+
+func (app *DIDApplication) getUpgradeSchedule(param string, height int64) types.ResponseQuery {
+	app.logger.Infof("GetUpgradeSchedule, Parameter: %s", param)
+	key := "UpgradeSchedule"
+	value := app.GetVersionedStateDB([]byte(key), height)
+	var result GetUpgradeScheduleResult
+	result.Upgrades = make([]ScheduledUpgrade, 0)
+	if value != nil {
+		var schedule data.UpgradeSchedule
+		err := proto.Unmarshal(value, &schedule)
+		if err != nil {
+			return app.ReturnQuery(nil, err.Error(), height)
+		}
+		for _, upgrade := range schedule.Upgrades {
+			result.Upgrades = append(result.Upgrades, ScheduledUpgrade{upgrade.Height, upgrade.Version, upgrade.ScheduledBlock})
+		}
+	}
+	returnValue, err := json.Marshal(result)
+	if err != nil {
+		return app.ReturnQuery(nil, err.Error(), height)
+	}
+	return app.ReturnQuery(returnValue, "success", height)
+}
@@ -0,0 +1,194 @@
+/**
+ * Copyright (c) 2018, 2019 National Digital ID COMPANY LIMITED
+ *
+ * This file is part of NDID software.
+ *
+ * NDID is the free software: you can redistribute it and/or modify it under
+ * the terms of the Affero GNU General Public License as published by the
+ * Free Software Foundation, either version 3 of the License, or any later
+ * version.
+ *
+ * NDID is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.
+ * See the Affero GNU General Public License for more details.
+ *
+ * You should have received a copy of the Affero GNU General Public License
+ * along with the NDID source code. If not, see https://www.gnu.org/licenses/agpl.txt.
+ *
+ * Please contact info@ndid.co.th for any further questions
+ *
+ */
+
+package did
+
+import (
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"strings"
+
+	"github.com/ndidplatform/smart-contract/abci/code"
+)
+
+// paramsSchemas maps each method to the struct of its params. Fields are
+// checked against their `validate` tag: "required" rejects an empty value
+// and "oneof=a b" limits a non-empty value to the listed words.
+var paramsSchemas = map[string]func() interface{}{
+	"InitNDID":                         func() interface{} { return &InitNDIDParam{} },
+	"RegisterNode":                     func() interface{} { return &RegisterNode{} },
+	"AddNodeToken":                     func() interface{} { return &AddNodeTokenParam{} },
+	"ReduceNodeToken":                  func() interface{} { return &ReduceNodeTokenParam{} },
+	"SetNodeToken":                     func() interface{} { return &SetNodeTokenParam{} },
+	"SetPriceFunc":                     func() interface{} { return &SetPriceFuncParam{} },
+	"AddNamespace":                     func() interface{} { return &Namespace{} },
+	"SetValidator":                     func() interface{} { return &SetValidatorParam{} },
+	"AddService":                       func() interface{} { return &AddServiceParam{} },
+	"UpdateNodeByNDID":                 func() interface{} { return &UpdateNodeByNDIDParam{} },
+	"UpdateService":                    func() interface{} { return &UpdateServiceParam{} },
+	"RegisterServiceDestinationByNDID": func() interface{} { return &RegisterServiceDestinationByNDIDParam{} },
+	"DisableNode":                      func() interface{} { return &DisableNodeParam{} },
+	"DisableNamespace":                 func() interface{} { return &DisableNamespaceParam{} },
+	"DisableService":                   func() interface{} { return &DisableServiceParam{} },
+	"DisableServiceDestinationByNDID":  func() interface{} { return &DisableServiceDestinationByNDIDParam{} },
+	"EnableNode":                       func() interface{} { return &DisableNodeParam{} },
+	"EnableServiceDestinationByNDID":   func() interface{} { return &DisableServiceDestinationByNDIDParam{} },
+	"EnableNamespace":                  func() interface{} { return &DisableNamespaceParam{} },
+	"EnableService":                    func() interface{} { return &DisableServiceParam{} },
+	"RegisterIdentity":                 func() interface{} { return &RegisterIdentityParam{} },
+	"AddAccessorMethod":                func() interface{} { return &AccessorMethod{} },
+	"CreateIdpResponse":                func() interface{} { return &CreateIdpResponseParam{} },
+	"RegisterAccessor":                 func() interface{} { return &RegisterAccessorParam{} },
+	"UpdateIdentity":                   func() interface{} { return &UpdateIdentityParam{} },
+	"DeclareIdentityProof":             func() interface{} { return &DeclareIdentityProofParam{} },
+	"SignData":                         func() interface{} { return &SignDataParam{} },
+	"RegisterServiceDestination":       func() interface{} { return &RegisterServiceDestinationParam{} },
+	"UpdateServiceDestination":         func() interface{} { return &UpdateServiceDestinationParam{} },
+	"CreateRequest":                    func() interface{} { return &Request{} },
+	"SetMqAddresses":                   func() interface{} { return &SetMqAddressesParam{} },
+	"UpdateNode":                       func() interface{} { return &UpdateNodeParam{} },
+	"CloseRequest":                     func() interface{} { return &CloseRequestParam{} },
+	"TimeOutRequest":                   func() interface{} { return &TimeOutRequestParam{} },
+	"SetDataReceived":                  func() interface{} { return &SetDataReceivedParam{} },
+	"DisableServiceDestination":        func() interface{} { return &DisableServiceDestinationParam{} },
+	"EnableServiceDestination":         func() interface{} { return &DisableServiceDestinationParam{} },
+	"ClearRegisterIdentityTimeout":     func() interface{} { return &ClearRegisterIdentityTimeoutParam{} },
+	"SetTimeOutBlockRegisterIdentity":  func() interface{} { return &TimeOutBlockRegisterIdentity{} },
+	"AddNodeToProxyNode":               func() interface{} { return &AddNodeToProxyNodeParam{} },
+	"UpdateNodeProxyNode":              func() interface{} { return &UpdateNodeProxyNodeParam{} },
+	"RemoveNodeFromProxyNode":          func() interface{} { return &RemoveNodeFromProxyNode{} },
+	"RevokeAccessorMethod":             func() interface{} { return &RevokeAccessorMethodParam{} },
+	"SetInitData":                      func() interface{} { return &SetInitDataParam{} },
+	"EndInit":                          func() interface{} { return &EndInitParam{} },
+	"SetLastBlock":                     func() interface{} { return &SetLastBlockParam{} },
+	"SetGovernance":                    func() interface{} { return &SetGovernanceParam{} },
+	"CreateProposal":                   func() interface{} { return &CreateProposalParam{} },
+	"ApproveProposal":                  func() interface{} { return &ApproveProposalParam{} },
+	"InitiateMasterKeyRecovery":        func() interface{} { return &InitiateMasterKeyRecoveryParam{} },
+	"CancelMasterKeyRecovery":          func() interface{} { return &CancelMasterKeyRecoveryParam{} },
+	"Batch":                            func() interface{} { return &BatchParam{} },
+	"ScheduleUpgrade":                  func() interface{} { return &ScheduleUpgradeParam{} },
+	"TransferToken":                    func() interface{} { return &TransferTokenParam{} },
+	"SetTokenTransferWhitelist":        func() interface{} { return &SetTokenTransferWhitelistParam{} },
+	"SetPriceRule":                     func() interface{} { return &SetPriceRuleParam{} },
+	"SetRevenueShareRule":              func() interface{} { return &SetRevenueShareRuleParam{} },
+	"SetNodeTokenLimit":                func() interface{} { return &SetNodeTokenLimitParam{} },
+}
+
+// validateParams checks params of method against its schema. The log names
+// the first offending field.
+func validateParams(method string, param string) (returnCode uint32, log string) {
+	newParams, ok := paramsSchemas[method]
+	if !ok {
+		return code.OK, ""
+	}
+	params := newParams()
+	decoder := json.NewDecoder(strings.NewReader(param))
+	decoder.DisallowUnknownFields()
+	err := decoder.Decode(params)
+	if err == nil && decoder.More() {
+		err = fmt.Errorf("unexpected data after params")
+	}
+	if err != nil {
+		if typeErr, ok := err.(*json.UnmarshalTypeError); ok {
+			return code.InvalidParameter, fmt.Sprintf("Invalid parameter: %s must be %s", typeErr.Field, jsonTypeName(typeErr.Type))
+		}
+		return code.InvalidParameter, "Invalid parameter: " + strings.TrimPrefix(err.Error(), "json: ")
+	}
+	fieldErr := checkFields(reflect.ValueOf(params).Elem(), "")
+	if fieldErr != "" {
+		return code.InvalidParameter, "Invalid parameter: " + fieldErr
+	}
+	return code.OK, ""
+}
+
+func checkFields(value reflect.Value, path string) string {
+	for i := 0; i < value.NumField(); i++ {
+		field := value.Type().Field(i)
+		name := path + strings.Split(field.Tag.Get("json"), ",")[0]
+		fieldValue := value.Field(i)
+		empty := isEmptyField(fieldValue)
+		for _, rule := range strings.Split(field.Tag.Get("validate"), ",") {
+			if rule == "required" && empty {
+				return name + " is required"
+			}
+			if strings.HasPrefix(rule, "oneof=") && !empty {
+				words := strings.Fields(strings.TrimPrefix(rule, "oneof="))
+				if !containsWord(words, fieldValue.String()) {
+					return fmt.Sprintf("%s must be one of %s", name, strings.Join(words, ", "))
+				}
+			}
+		}
+		switch fieldValue.Kind() {
+		case reflect.Struct:
+			fieldErr := checkFields(fieldValue, name+".")
+			if fieldErr != "" {
+				return fieldErr
+			}
+		case reflect.Slice:
+			if fieldValue.Type().Elem().Kind() != reflect.Struct {
+				continue
+			}
+			for j := 0; j < fieldValue.Len(); j++ {
+				fieldErr := checkFields(fieldValue.Index(j), fmt.Sprintf("%s[%d].", name, j))
+				if fieldErr != "" {
+					return fieldErr
+				}
+			}
+		}
+	}
+	return ""
+}
+
+func isEmptyField(value reflect.Value) bool {
+	switch value.Kind() {
+	case reflect.String, reflect.Slice:
+		return value.Len() == 0
+	}
+	return value.IsZero()
+}
+
+func containsWord(words []string, word string) bool {
+	for _, w := range words {
+		if w == word {
+			return true
+		}
+	}
+	return false
+}
+
+func jsonTypeName(t reflect.Type) string {
+	switch t.Kind() {
+	case reflect.String:
+		return "string"
+	case reflect.Bool:
+		return "boolean"
+	case reflect.Slice, reflect.Array:
+		return "array"
+	case reflect.Struct, reflect.Map:
+		return "object"
+	case reflect.Ptr:
+		return jsonTypeName(t.Elem())
+	}
+	return "number"
+}
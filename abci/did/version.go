@@ -0,0 +1,106 @@
+/**
+ * Copyright (c) 2018, 2019 National Digital ID COMPANY LIMITED
+ *
+ * This file is part of NDID software.
+ *
+ * NDID is the free software: you can redistribute it and/or modify it under
+ * the terms of the Affero GNU General Public License as published by the
+ * Free Software Foundation, either version 3 of the License, or any later
+ * version.
+ *
+ * NDID is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.
+ * See the Affero GNU General Public License for more details.
+ *
+ * You should have received a copy of the Affero GNU General Public License
+ * along with the NDID source code. If not, see https://www.gnu.org/licenses/agpl.txt.
+ *
+ * Please contact info@ndid.co.th for any further questions
+ *
+ */
+
+package did
+
+import (
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// VersionUpgrade switches the app to Version from block Height on
+type VersionUpgrade struct {
+	Height  int64
+	Version string
+}
+
+// VersionSchedule lists app version upgrades in ascending height
+type VersionSchedule []VersionUpgrade
+
+// ParseVersionSchedule parses comma separated "height:version" pairs,
+// e.g. "0:1,150000:2"
+func ParseVersionSchedule(value string) (VersionSchedule, error) {
+	var schedule VersionSchedule
+	if strings.TrimSpace(value) == "" {
+		return schedule, nil
+	}
+	for _, pair := range strings.Split(value, ",") {
+		parts := strings.Split(strings.TrimSpace(pair), ":")
+		if len(parts) != 2 || parts[1] == "" {
+			return nil, fmt.Errorf("invalid upgrade %q, expected height:version", pair)
+		}
+		height, err := strconv.ParseInt(parts[0], 10, 64)
+		if err != nil || height < 0 {
+			return nil, fmt.Errorf("invalid upgrade height %q", parts[0])
+		}
+		schedule = append(schedule, VersionUpgrade{Height: height, Version: parts[1]})
+	}
+	sort.SliceStable(schedule, func(i, j int) bool {
+		return schedule[i].Height < schedule[j].Height
+	})
+	for i := 1; i < len(schedule); i++ {
+		if schedule[i].Height == schedule[i-1].Height {
+			return nil, fmt.Errorf("more than one version at height %d", schedule[i].Height)
+		}
+	}
+	return schedule, nil
+}
+
+// VersionAt returns the version of the last upgrade at or below height.
+// Heights before the first upgrade run the first version.
+func (schedule VersionSchedule) VersionAt(height int64) string {
+	version := schedule[0].Version
+	for _, upgrade := range schedule {
+		if upgrade.Height > height {
+			break
+		}
+		version = upgrade.Version
+	}
+	return version
+}
+
+// With returns schedule with upgrades added, replacing an upgrade at the same height
+func (schedule VersionSchedule) With(upgrades map[int64]string) VersionSchedule {
+	merged := make(VersionSchedule, 0, len(schedule)+len(upgrades))
+	for _, upgrade := range schedule {
+		if _, ok := upgrades[upgrade.Height]; !ok {
+			merged = append(merged, upgrade)
+		}
+	}
+	for height, version := range upgrades {
+		merged = append(merged, VersionUpgrade{Height: height, Version: version})
+	}
+	sort.SliceStable(merged, func(i, j int) bool {
+		return merged[i].Height < merged[j].Height
+	})
+	return merged
+}
+
+func (schedule VersionSchedule) String() string {
+	pairs := make([]string, 0, len(schedule))
+	for _, upgrade := range schedule {
+		pairs = append(pairs, strconv.FormatInt(upgrade.Height, 10)+":"+upgrade.Version)
+	}
+	return strings.Join(pairs, ",")
+}
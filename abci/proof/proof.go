@@ -0,0 +1,198 @@
+/**
+ * Copyright (c) 2018, 2019 National Digital ID COMPANY LIMITED
+ *
+ * This file is part of NDID software.
+ *
+ * NDID is the free software: you can redistribute it and/or modify it under
+ * the terms of the Affero GNU General Public License as published by the
+ * Free Software Foundation, either version 3 of the License, or any later
+ * version.
+ *
+ * NDID is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.
+ * See the Affero GNU General Public License for more details.
+ *
+ * You should have received a copy of the Affero GNU General Public License
+ * along with the NDID source code. If not, see https://www.gnu.org/licenses/agpl.txt.
+ *
+ * Please contact info@ndid.co.th for any further questions
+ *
+ */
+
+// Package proof verifies the Merkle proofs returned in ResponseQuery.Proof
+// when a query is made with prove set.
+package proof
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	"github.com/tendermint/iavl"
+)
+
+var kvPairPrefixKey = []byte("kvPairKey:")
+
+// Proofs is the proof of a query, made of the proofs of the state keys and of
+// the key ranges the query read.
+type Proofs struct {
+	KeyProofs   []KeyProof   `json:"key_proofs"`
+	RangeProofs []RangeProof `json:"range_proofs"`
+}
+
+// KeyProof proves the value of a state key at the queried height. Value is
+// nil when the proof shows that the key does not exist.
+type KeyProof struct {
+	Key   []byte           `json:"key"`
+	Value []byte           `json:"value"`
+	Proof *iavl.RangeProof `json:"proof"`
+}
+
+// RangeProof proves that Keys are every state key in [Start, End) at the
+// queried height, with their Values.
+type RangeProof struct {
+	Start  []byte           `json:"start"`
+	End    []byte           `json:"end"`
+	Keys   [][]byte         `json:"keys"`
+	Values [][]byte         `json:"values"`
+	Proof  *iavl.RangeProof `json:"proof"`
+}
+
+// Verify checks every proof in proofs against appHash and returns the proven
+// keys, values and ranges. appHash must be the application hash committed at
+// the queried height, i.e. the AppHash in the header of the next block.
+func Verify(proofs []byte, appHash []byte) (*Proofs, error) {
+	var proven Proofs
+	err := json.Unmarshal(proofs, &proven)
+	if err != nil {
+		return nil, err
+	}
+	for _, keyProof := range proven.KeyProofs {
+		if keyProof.Proof == nil {
+			return nil, fmt.Errorf("Missing proof of key %s", keyProof.Key)
+		}
+		err = keyProof.Proof.Verify(appHash)
+		if err != nil {
+			return nil, fmt.Errorf("Invalid proof of key %s: %s", keyProof.Key, err.Error())
+		}
+		if keyProof.Value != nil {
+			err = keyProof.Proof.VerifyItem(keyProof.Key, keyProof.Value)
+		} else {
+			err = keyProof.Proof.VerifyAbsence(keyProof.Key)
+		}
+		if err != nil {
+			return nil, fmt.Errorf("Invalid proof of key %s: %s", keyProof.Key, err.Error())
+		}
+	}
+	for _, rangeProof := range proven.RangeProofs {
+		err = verifyRange(rangeProof, appHash)
+		if err != nil {
+			return nil, fmt.Errorf("Invalid proof of range %s to %s: %s", rangeProof.Start, rangeProof.End, err.Error())
+		}
+	}
+	return &proven, nil
+}
+
+// verifyRange checks that the leaves of the proof cover the whole range and
+// that the keys of the proof in the range are exactly the given keys
+func verifyRange(rangeProof RangeProof, appHash []byte) error {
+	if rangeProof.Proof == nil {
+		return errors.New("missing proof")
+	}
+	if len(rangeProof.Keys) != len(rangeProof.Values) {
+		return errors.New("keys and values do not match")
+	}
+	err := rangeProof.Proof.Verify(appHash)
+	if err != nil {
+		return err
+	}
+	// Leaves are contiguous, so the range is covered when both of its ends
+	// are leaves or fall between leaves (or beyond the first or last key of
+	// the tree). A range read up to a key ends right after that key, where no
+	// other key can be.
+	err = verifyCovered(rangeProof.Proof, rangeProof.Start, false)
+	if err != nil {
+		return err
+	}
+	err = verifyCovered(rangeProof.Proof, rangeProof.End, true)
+	if err != nil {
+		return err
+	}
+	var keys [][]byte
+	for _, key := range rangeProof.Proof.Keys() {
+		if bytes.Compare(key, rangeProof.Start) >= 0 && bytes.Compare(key, rangeProof.End) < 0 {
+			keys = append(keys, key)
+		}
+	}
+	if len(keys) != len(rangeProof.Keys) {
+		return fmt.Errorf("%d keys in range, %d given", len(keys), len(rangeProof.Keys))
+	}
+	for index, key := range keys {
+		if !bytes.Equal(key, rangeProof.Keys[index]) {
+			return fmt.Errorf("key %s is missing", key)
+		}
+		err = rangeProof.Proof.VerifyItem(key, rangeProof.Values[index])
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func verifyCovered(proof *iavl.RangeProof, key []byte, end bool) error {
+	for _, leafKey := range proof.Keys() {
+		if bytes.Equal(leafKey, key) {
+			return nil
+		}
+		if end && bytes.Equal(append(append([]byte{}, leafKey...), 0), key) {
+			return nil
+		}
+	}
+	return proof.VerifyAbsence(key)
+}
+
+// Lookup returns the proven value of a state key such as "NodeID|node1".
+// found is false when key is not covered by proofs.
+func Lookup(proofs *Proofs, key string) (value []byte, found bool) {
+	stateKey := append(append([]byte{}, kvPairPrefixKey...), key...)
+	for _, keyProof := range proofs.KeyProofs {
+		if bytes.Equal(keyProof.Key, stateKey) {
+			return keyProof.Value, true
+		}
+	}
+	for _, rangeProof := range proofs.RangeProofs {
+		if bytes.Compare(stateKey, rangeProof.Start) < 0 || bytes.Compare(stateKey, rangeProof.End) >= 0 {
+			continue
+		}
+		for index, rangeKey := range rangeProof.Keys {
+			if bytes.Equal(rangeKey, stateKey) {
+				return rangeProof.Values[index], true
+			}
+		}
+		return nil, true
+	}
+	return nil, false
+}
+
+// LookupRange returns the proven keys, without the state key prefix, and
+// values of state keys in [start, end). found is false when the range is not
+// covered by a single range proof in proofs.
+func LookupRange(proofs *Proofs, start string, end string) (keys []string, values [][]byte, found bool) {
+	startKey := append(append([]byte{}, kvPairPrefixKey...), start...)
+	endKey := append(append([]byte{}, kvPairPrefixKey...), end...)
+	for _, rangeProof := range proofs.RangeProofs {
+		if bytes.Compare(startKey, rangeProof.Start) < 0 || bytes.Compare(endKey, rangeProof.End) > 0 {
+			continue
+		}
+		for index, rangeKey := range rangeProof.Keys {
+			if bytes.Compare(rangeKey, startKey) >= 0 && bytes.Compare(rangeKey, endKey) < 0 {
+				keys = append(keys, string(rangeKey[len(kvPairPrefixKey):]))
+				values = append(values, rangeProof.Values[index])
+			}
+		}
+		return keys, values, true
+	}
+	return nil, nil, false
+}
@@ -14,7 +14,6 @@ import (
 
 	did "github.com/ndidplatform/smart-contract/abci/did/v1"
 	"github.com/ndidplatform/smart-contract/migrate/utils"
-	"github.com/tendermint/tendermint/libs/common"
 )
 
 func main() {
@@ -89,7 +88,7 @@ func initNDID(ndidKey *rsa.PrivateKey, ndidMasterKey *rsa.PrivateKey) {
 		fmt.Println("error:", err)
 	}
 	fnName := "InitNDID"
-	nonce := base64.StdEncoding.EncodeToString([]byte(common.RandStr(12)))
+	nonce := utils.NewNonce()
 	tempPSSmessage := append([]byte(fnName), paramJSON...)
 	tempPSSmessage = append(tempPSSmessage, []byte(nonce)...)
 	PSSmessage := []byte(base64.StdEncoding.EncodeToString(tempPSSmessage))
@@ -109,7 +108,7 @@ func setInitData(param did.SetInitDataParam, ndidKey *rsa.PrivateKey) {
 		fmt.Println("error:", err)
 	}
 	fnName := "SetInitData"
-	nonce := base64.StdEncoding.EncodeToString([]byte(common.RandStr(12)))
+	nonce := utils.NewNonce()
 	tempPSSmessage := append([]byte(fnName), paramJSON...)
 	tempPSSmessage = append(tempPSSmessage, []byte(nonce)...)
 	PSSmessage := []byte(base64.StdEncoding.EncodeToString(tempPSSmessage))
@@ -131,7 +130,7 @@ func endInit(ndidKey *rsa.PrivateKey) {
 	}
 	fnName := "EndInit"
 	nodeID := "NDID"
-	nonce := base64.StdEncoding.EncodeToString([]byte(common.RandStr(12)))
+	nonce := utils.NewNonce()
 	tempPSSmessage := append([]byte(fnName), paramJSON...)
 	tempPSSmessage = append(tempPSSmessage, []byte(nonce)...)
 	PSSmessage := []byte(base64.StdEncoding.EncodeToString(tempPSSmessage))
@@ -17,7 +17,6 @@ import (
 	did "github.com/ndidplatform/smart-contract/abci/did/v1"
 	"github.com/ndidplatform/smart-contract/migrate/utils"
 	"github.com/tendermint/tendermint/abci/types"
-	"github.com/tendermint/tendermint/libs/common"
 )
 
 func main() {
@@ -66,7 +65,7 @@ func SetValidator(param did.SetValidatorParam, ndidKey *rsa.PrivateKey) {
 		fmt.Println("error:", err)
 	}
 	fnName := "SetValidator"
-	nonce := base64.StdEncoding.EncodeToString([]byte(common.RandStr(12)))
+	nonce := utils.NewNonce()
 	tempPSSmessage := append([]byte(fnName), paramJSON...)
 	tempPSSmessage = append(tempPSSmessage, []byte(nonce)...)
 	PSSmessage := []byte(base64.StdEncoding.EncodeToString(tempPSSmessage))
@@ -107,7 +106,7 @@ func after(value string, a string) string {
 // 		fmt.Println("error:", err)
 // 	}
 // 	fnName := "InitNDID"
-// 	nonce := base64.StdEncoding.EncodeToString([]byte(common.RandStr(12)))
+// 	nonce := utils.NewNonce()
 // 	tempPSSmessage := append([]byte(fnName), paramJSON...)
 // 	tempPSSmessage = append(tempPSSmessage, []byte(nonce)...)
 // 	PSSmessage := []byte(base64.StdEncoding.EncodeToString(tempPSSmessage))
@@ -127,7 +126,7 @@ func after(value string, a string) string {
 // 		fmt.Println("error:", err)
 // 	}
 // 	fnName := "SetInitData"
-// 	nonce := base64.StdEncoding.EncodeToString([]byte(common.RandStr(12)))
+// 	nonce := utils.NewNonce()
 // 	tempPSSmessage := append([]byte(fnName), paramJSON...)
 // 	tempPSSmessage = append(tempPSSmessage, []byte(nonce)...)
 // 	PSSmessage := []byte(base64.StdEncoding.EncodeToString(tempPSSmessage))
@@ -149,7 +148,7 @@ func after(value string, a string) string {
 // 	}
 // 	fnName := "EndInit"
 // 	nodeID := "NDID"
-// 	nonce := base64.StdEncoding.EncodeToString([]byte(common.RandStr(12)))
+// 	nonce := utils.NewNonce()
 // 	tempPSSmessage := append([]byte(fnName), paramJSON...)
 // 	tempPSSmessage = append(tempPSSmessage, []byte(nonce)...)
 // 	PSSmessage := []byte(base64.StdEncoding.EncodeToString(tempPSSmessage))
@@ -25,6 +25,7 @@ package utils
 import (
 	"crypto/rsa"
 	"crypto/x509"
+	"encoding/base64"
 	"encoding/hex"
 	"encoding/json"
 	"encoding/pem"
@@ -75,6 +76,34 @@ func GeneratePublicKey(publicKey *rsa.PublicKey) ([]byte, error) {
 	return publicPEM, nil
 }
 
+// NewNonce returns a nonce bound to the latest committed block height
+func NewNonce() string {
+	var URL *url.URL
+	URL, err := url.Parse(tendermintAddr)
+	if err != nil {
+		panic("boom")
+	}
+	URL.Path += "/abci_info"
+	resp, err := http.Get(URL.String())
+	if err != nil {
+		panic(err)
+	}
+	defer resp.Body.Close()
+	var body struct {
+		Result struct {
+			Response struct {
+				LastBlockHeight string `json:"last_block_height"`
+			} `json:"response"`
+		} `json:"result"`
+	}
+	json.NewDecoder(resp.Body).Decode(&body)
+	height := body.Result.Response.LastBlockHeight
+	if height == "" {
+		height = "0"
+	}
+	return height + ":" + base64.StdEncoding.EncodeToString([]byte(common.RandStr(12)))
+}
+
 func CallTendermint(fnName []byte, param []byte, nonce []byte, signature []byte, nodeID []byte) (interface{}, error) {
 
 	var tx protoTm.Tx
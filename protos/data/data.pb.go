@@ -29,6 +29,7 @@ type NodeDetail struct {
 	MaxAal               float64  `protobuf:"fixed64,6,opt,name=max_aal,json=maxAal,proto3" json:"max_aal,omitempty"`
 	Mq                   []*MQ    `protobuf:"bytes,7,rep,name=mq,proto3" json:"mq,omitempty"`
 	Active               bool     `protobuf:"varint,8,opt,name=active,proto3" json:"active,omitempty"`
+	SignatureScheme      string   `protobuf:"bytes,9,opt,name=signature_scheme,json=signatureScheme,proto3" json:"signature_scheme,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -115,6 +116,13 @@ func (m *NodeDetail) GetActive() bool {
 	return false
 }
 
+func (m *NodeDetail) GetSignatureScheme() string {
+	if m != nil {
+		return m.SignatureScheme
+	}
+	return ""
+}
+
 type MQ struct {
 	Ip                   string   `protobuf:"bytes,1,opt,name=ip,proto3" json:"ip,omitempty"`
 	Port                 int64    `protobuf:"varint,2,opt,name=port,proto3" json:"port,omitempty"`
@@ -587,6 +595,7 @@ type Request struct {
 	UseCount             int64          `protobuf:"varint,15,opt,name=use_count,json=useCount,proto3" json:"use_count,omitempty"`
 	CreationBlockHeight  int64          `protobuf:"varint,16,opt,name=creation_block_height,json=creationBlockHeight,proto3" json:"creation_block_height,omitempty"`
 	ChainId              string         `protobuf:"bytes,17,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"`
+	ExpireBlockHeight    int64          `protobuf:"varint,18,opt,name=expire_block_height,json=expireBlockHeight,proto3" json:"expire_block_height,omitempty"`
 	XXX_NoUnkeyedLiteral struct{}       `json:"-"`
 	XXX_unrecognized     []byte         `json:"-"`
 	XXX_sizecache        int32          `json:"-"`
@@ -736,6 +745,13 @@ func (m *Request) GetChainId() string {
 	return ""
 }
 
+func (m *Request) GetExpireBlockHeight() int64 {
+	if m != nil {
+		return m.ExpireBlockHeight
+	}
+	return 0
+}
+
 type DataRequest struct {
 	ServiceId            string   `protobuf:"bytes,1,opt,name=service_id,json=serviceId,proto3" json:"service_id,omitempty"`
 	AsIdList             []string `protobuf:"bytes,2,rep,name=as_id_list,json=asIdList,proto3" json:"as_id_list,omitempty"`
@@ -1579,6 +1595,10 @@ func (m *AccessorInGroup) GetAccessors() []string {
 
 type Token struct {
 	Amount               float64  `protobuf:"fixed64,1,opt,name=amount,proto3" json:"amount,omitempty"`
+	MinorAmount          int64    `protobuf:"varint,2,opt,name=minor_amount,json=minorAmount,proto3" json:"minor_amount,omitempty"`
+	CreditLimit          int64    `protobuf:"varint,3,opt,name=credit_limit,json=creditLimit,proto3" json:"credit_limit,omitempty"`
+	LowBalanceAlert      bool     `protobuf:"varint,4,opt,name=low_balance_alert,json=lowBalanceAlert,proto3" json:"low_balance_alert,omitempty"`
+	LowBalanceThreshold  int64    `protobuf:"varint,5,opt,name=low_balance_threshold,json=lowBalanceThreshold,proto3" json:"low_balance_threshold,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -1616,8 +1636,37 @@ func (m *Token) GetAmount() float64 {
 	return 0
 }
 
+func (m *Token) GetMinorAmount() int64 {
+	if m != nil {
+		return m.MinorAmount
+	}
+	return 0
+}
+
+func (m *Token) GetCreditLimit() int64 {
+	if m != nil {
+		return m.CreditLimit
+	}
+	return 0
+}
+
+func (m *Token) GetLowBalanceAlert() bool {
+	if m != nil {
+		return m.LowBalanceAlert
+	}
+	return false
+}
+
+func (m *Token) GetLowBalanceThreshold() int64 {
+	if m != nil {
+		return m.LowBalanceThreshold
+	}
+	return 0
+}
+
 type TokenPrice struct {
 	Price                float64  `protobuf:"fixed64,1,opt,name=price,proto3" json:"price,omitempty"`
+	MinorPrice           int64    `protobuf:"varint,2,opt,name=minor_price,json=minorPrice,proto3" json:"minor_price,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -1655,6 +1704,751 @@ func (m *TokenPrice) GetPrice() float64 {
 	return 0
 }
 
+func (m *TokenPrice) GetMinorPrice() int64 {
+	if m != nil {
+		return m.MinorPrice
+	}
+	return 0
+}
+
+type GovernanceKey struct {
+	KeyId                string   `protobuf:"bytes,1,opt,name=key_id,json=keyId,proto3" json:"key_id,omitempty"`
+	PublicKey            string   `protobuf:"bytes,2,opt,name=public_key,json=publicKey,proto3" json:"public_key,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *GovernanceKey) Reset()         { *m = GovernanceKey{} }
+func (m *GovernanceKey) String() string { return proto.CompactTextString(m) }
+func (*GovernanceKey) ProtoMessage()    {}
+func (*GovernanceKey) Descriptor() ([]byte, []int) {
+	return fileDescriptor_492be2f0ffbab25c, []int{29}
+}
+
+func (m *GovernanceKey) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GovernanceKey.Unmarshal(m, b)
+}
+func (m *GovernanceKey) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GovernanceKey.Marshal(b, m, deterministic)
+}
+func (m *GovernanceKey) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GovernanceKey.Merge(m, src)
+}
+func (m *GovernanceKey) XXX_Size() int {
+	return xxx_messageInfo_GovernanceKey.Size(m)
+}
+func (m *GovernanceKey) XXX_DiscardUnknown() {
+	xxx_messageInfo_GovernanceKey.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GovernanceKey proto.InternalMessageInfo
+
+func (m *GovernanceKey) GetKeyId() string {
+	if m != nil {
+		return m.KeyId
+	}
+	return ""
+}
+
+func (m *GovernanceKey) GetPublicKey() string {
+	if m != nil {
+		return m.PublicKey
+	}
+	return ""
+}
+
+type Governance struct {
+	Keys                 []*GovernanceKey `protobuf:"bytes,1,rep,name=keys,proto3" json:"keys,omitempty"`
+	Threshold            int64            `protobuf:"varint,2,opt,name=threshold,proto3" json:"threshold,omitempty"`
+	ProposalTimeoutBlock int64            `protobuf:"varint,3,opt,name=proposal_timeout_block,json=proposalTimeoutBlock,proto3" json:"proposal_timeout_block,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}         `json:"-"`
+	XXX_unrecognized     []byte           `json:"-"`
+	XXX_sizecache        int32            `json:"-"`
+}
+
+func (m *Governance) Reset()         { *m = Governance{} }
+func (m *Governance) String() string { return proto.CompactTextString(m) }
+func (*Governance) ProtoMessage()    {}
+func (*Governance) Descriptor() ([]byte, []int) {
+	return fileDescriptor_492be2f0ffbab25c, []int{30}
+}
+
+func (m *Governance) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_Governance.Unmarshal(m, b)
+}
+func (m *Governance) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_Governance.Marshal(b, m, deterministic)
+}
+func (m *Governance) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Governance.Merge(m, src)
+}
+func (m *Governance) XXX_Size() int {
+	return xxx_messageInfo_Governance.Size(m)
+}
+func (m *Governance) XXX_DiscardUnknown() {
+	xxx_messageInfo_Governance.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Governance proto.InternalMessageInfo
+
+func (m *Governance) GetKeys() []*GovernanceKey {
+	if m != nil {
+		return m.Keys
+	}
+	return nil
+}
+
+func (m *Governance) GetThreshold() int64 {
+	if m != nil {
+		return m.Threshold
+	}
+	return 0
+}
+
+func (m *Governance) GetProposalTimeoutBlock() int64 {
+	if m != nil {
+		return m.ProposalTimeoutBlock
+	}
+	return 0
+}
+
+type Proposal struct {
+	ProposalId           string   `protobuf:"bytes,1,opt,name=proposal_id,json=proposalId,proto3" json:"proposal_id,omitempty"`
+	Method               string   `protobuf:"bytes,2,opt,name=method,proto3" json:"method,omitempty"`
+	Params               string   `protobuf:"bytes,3,opt,name=params,proto3" json:"params,omitempty"`
+	Proposer             string   `protobuf:"bytes,4,opt,name=proposer,proto3" json:"proposer,omitempty"`
+	Approvers            []string `protobuf:"bytes,5,rep,name=approvers,proto3" json:"approvers,omitempty"`
+	ExpireBlock          int64    `protobuf:"varint,6,opt,name=expire_block,json=expireBlock,proto3" json:"expire_block,omitempty"`
+	Status               string   `protobuf:"bytes,7,opt,name=status,proto3" json:"status,omitempty"`
+	ResultCode           uint32   `protobuf:"varint,8,opt,name=result_code,json=resultCode,proto3" json:"result_code,omitempty"`
+	ResultLog            string   `protobuf:"bytes,9,opt,name=result_log,json=resultLog,proto3" json:"result_log,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *Proposal) Reset()         { *m = Proposal{} }
+func (m *Proposal) String() string { return proto.CompactTextString(m) }
+func (*Proposal) ProtoMessage()    {}
+func (*Proposal) Descriptor() ([]byte, []int) {
+	return fileDescriptor_492be2f0ffbab25c, []int{31}
+}
+
+func (m *Proposal) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_Proposal.Unmarshal(m, b)
+}
+func (m *Proposal) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_Proposal.Marshal(b, m, deterministic)
+}
+func (m *Proposal) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Proposal.Merge(m, src)
+}
+func (m *Proposal) XXX_Size() int {
+	return xxx_messageInfo_Proposal.Size(m)
+}
+func (m *Proposal) XXX_DiscardUnknown() {
+	xxx_messageInfo_Proposal.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Proposal proto.InternalMessageInfo
+
+func (m *Proposal) GetProposalId() string {
+	if m != nil {
+		return m.ProposalId
+	}
+	return ""
+}
+
+func (m *Proposal) GetMethod() string {
+	if m != nil {
+		return m.Method
+	}
+	return ""
+}
+
+func (m *Proposal) GetParams() string {
+	if m != nil {
+		return m.Params
+	}
+	return ""
+}
+
+func (m *Proposal) GetProposer() string {
+	if m != nil {
+		return m.Proposer
+	}
+	return ""
+}
+
+func (m *Proposal) GetApprovers() []string {
+	if m != nil {
+		return m.Approvers
+	}
+	return nil
+}
+
+func (m *Proposal) GetExpireBlock() int64 {
+	if m != nil {
+		return m.ExpireBlock
+	}
+	return 0
+}
+
+func (m *Proposal) GetStatus() string {
+	if m != nil {
+		return m.Status
+	}
+	return ""
+}
+
+func (m *Proposal) GetResultCode() uint32 {
+	if m != nil {
+		return m.ResultCode
+	}
+	return 0
+}
+
+func (m *Proposal) GetResultLog() string {
+	if m != nil {
+		return m.ResultLog
+	}
+	return ""
+}
+
+type NodeKey struct {
+	PublicKey            string   `protobuf:"bytes,1,opt,name=public_key,json=publicKey,proto3" json:"public_key,omitempty"`
+	ActivationBlock      int64    `protobuf:"varint,2,opt,name=activation_block,json=activationBlock,proto3" json:"activation_block,omitempty"`
+	RevocationBlock      int64    `protobuf:"varint,3,opt,name=revocation_block,json=revocationBlock,proto3" json:"revocation_block,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *NodeKey) Reset()         { *m = NodeKey{} }
+func (m *NodeKey) String() string { return proto.CompactTextString(m) }
+func (*NodeKey) ProtoMessage()    {}
+func (*NodeKey) Descriptor() ([]byte, []int) {
+	return fileDescriptor_492be2f0ffbab25c, []int{32}
+}
+
+func (m *NodeKey) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_NodeKey.Unmarshal(m, b)
+}
+func (m *NodeKey) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_NodeKey.Marshal(b, m, deterministic)
+}
+func (m *NodeKey) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_NodeKey.Merge(m, src)
+}
+func (m *NodeKey) XXX_Size() int {
+	return xxx_messageInfo_NodeKey.Size(m)
+}
+func (m *NodeKey) XXX_DiscardUnknown() {
+	xxx_messageInfo_NodeKey.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_NodeKey proto.InternalMessageInfo
+
+func (m *NodeKey) GetPublicKey() string {
+	if m != nil {
+		return m.PublicKey
+	}
+	return ""
+}
+
+func (m *NodeKey) GetActivationBlock() int64 {
+	if m != nil {
+		return m.ActivationBlock
+	}
+	return 0
+}
+
+func (m *NodeKey) GetRevocationBlock() int64 {
+	if m != nil {
+		return m.RevocationBlock
+	}
+	return 0
+}
+
+type NodeKeyHistory struct {
+	Keys                 []*NodeKey `protobuf:"bytes,1,rep,name=keys,proto3" json:"keys,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}   `json:"-"`
+	XXX_unrecognized     []byte     `json:"-"`
+	XXX_sizecache        int32      `json:"-"`
+}
+
+func (m *NodeKeyHistory) Reset()         { *m = NodeKeyHistory{} }
+func (m *NodeKeyHistory) String() string { return proto.CompactTextString(m) }
+func (*NodeKeyHistory) ProtoMessage()    {}
+func (*NodeKeyHistory) Descriptor() ([]byte, []int) {
+	return fileDescriptor_492be2f0ffbab25c, []int{33}
+}
+
+func (m *NodeKeyHistory) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_NodeKeyHistory.Unmarshal(m, b)
+}
+func (m *NodeKeyHistory) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_NodeKeyHistory.Marshal(b, m, deterministic)
+}
+func (m *NodeKeyHistory) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_NodeKeyHistory.Merge(m, src)
+}
+func (m *NodeKeyHistory) XXX_Size() int {
+	return xxx_messageInfo_NodeKeyHistory.Size(m)
+}
+func (m *NodeKeyHistory) XXX_DiscardUnknown() {
+	xxx_messageInfo_NodeKeyHistory.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_NodeKeyHistory proto.InternalMessageInfo
+
+func (m *NodeKeyHistory) GetKeys() []*NodeKey {
+	if m != nil {
+		return m.Keys
+	}
+	return nil
+}
+
+type MasterKeyRecovery struct {
+	NewMasterPublicKey   string   `protobuf:"bytes,1,opt,name=new_master_public_key,json=newMasterPublicKey,proto3" json:"new_master_public_key,omitempty"`
+	InitiatedBlock       int64    `protobuf:"varint,2,opt,name=initiated_block,json=initiatedBlock,proto3" json:"initiated_block,omitempty"`
+	ExecuteBlock         int64    `protobuf:"varint,3,opt,name=execute_block,json=executeBlock,proto3" json:"execute_block,omitempty"`
+	Status               string   `protobuf:"bytes,4,opt,name=status,proto3" json:"status,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *MasterKeyRecovery) Reset()         { *m = MasterKeyRecovery{} }
+func (m *MasterKeyRecovery) String() string { return proto.CompactTextString(m) }
+func (*MasterKeyRecovery) ProtoMessage()    {}
+func (*MasterKeyRecovery) Descriptor() ([]byte, []int) {
+	return fileDescriptor_492be2f0ffbab25c, []int{34}
+}
+
+func (m *MasterKeyRecovery) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_MasterKeyRecovery.Unmarshal(m, b)
+}
+func (m *MasterKeyRecovery) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_MasterKeyRecovery.Marshal(b, m, deterministic)
+}
+func (m *MasterKeyRecovery) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MasterKeyRecovery.Merge(m, src)
+}
+func (m *MasterKeyRecovery) XXX_Size() int {
+	return xxx_messageInfo_MasterKeyRecovery.Size(m)
+}
+func (m *MasterKeyRecovery) XXX_DiscardUnknown() {
+	xxx_messageInfo_MasterKeyRecovery.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MasterKeyRecovery proto.InternalMessageInfo
+
+func (m *MasterKeyRecovery) GetNewMasterPublicKey() string {
+	if m != nil {
+		return m.NewMasterPublicKey
+	}
+	return ""
+}
+
+func (m *MasterKeyRecovery) GetInitiatedBlock() int64 {
+	if m != nil {
+		return m.InitiatedBlock
+	}
+	return 0
+}
+
+func (m *MasterKeyRecovery) GetExecuteBlock() int64 {
+	if m != nil {
+		return m.ExecuteBlock
+	}
+	return 0
+}
+
+func (m *MasterKeyRecovery) GetStatus() string {
+	if m != nil {
+		return m.Status
+	}
+	return ""
+}
+
+type ScheduledUpgrade struct {
+	Height               int64    `protobuf:"varint,1,opt,name=height,proto3" json:"height,omitempty"`
+	Version              string   `protobuf:"bytes,2,opt,name=version,proto3" json:"version,omitempty"`
+	ScheduledBlock       int64    `protobuf:"varint,3,opt,name=scheduled_block,json=scheduledBlock,proto3" json:"scheduled_block,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ScheduledUpgrade) Reset()         { *m = ScheduledUpgrade{} }
+func (m *ScheduledUpgrade) String() string { return proto.CompactTextString(m) }
+func (*ScheduledUpgrade) ProtoMessage()    {}
+func (*ScheduledUpgrade) Descriptor() ([]byte, []int) {
+	return fileDescriptor_492be2f0ffbab25c, []int{35}
+}
+
+func (m *ScheduledUpgrade) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ScheduledUpgrade.Unmarshal(m, b)
+}
+func (m *ScheduledUpgrade) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ScheduledUpgrade.Marshal(b, m, deterministic)
+}
+func (m *ScheduledUpgrade) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ScheduledUpgrade.Merge(m, src)
+}
+func (m *ScheduledUpgrade) XXX_Size() int {
+	return xxx_messageInfo_ScheduledUpgrade.Size(m)
+}
+func (m *ScheduledUpgrade) XXX_DiscardUnknown() {
+	xxx_messageInfo_ScheduledUpgrade.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ScheduledUpgrade proto.InternalMessageInfo
+
+func (m *ScheduledUpgrade) GetHeight() int64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+func (m *ScheduledUpgrade) GetVersion() string {
+	if m != nil {
+		return m.Version
+	}
+	return ""
+}
+
+func (m *ScheduledUpgrade) GetScheduledBlock() int64 {
+	if m != nil {
+		return m.ScheduledBlock
+	}
+	return 0
+}
+
+type UpgradeSchedule struct {
+	Upgrades             []*ScheduledUpgrade `protobuf:"bytes,1,rep,name=upgrades,proto3" json:"upgrades,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}            `json:"-"`
+	XXX_unrecognized     []byte              `json:"-"`
+	XXX_sizecache        int32               `json:"-"`
+}
+
+func (m *UpgradeSchedule) Reset()         { *m = UpgradeSchedule{} }
+func (m *UpgradeSchedule) String() string { return proto.CompactTextString(m) }
+func (*UpgradeSchedule) ProtoMessage()    {}
+func (*UpgradeSchedule) Descriptor() ([]byte, []int) {
+	return fileDescriptor_492be2f0ffbab25c, []int{36}
+}
+
+func (m *UpgradeSchedule) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_UpgradeSchedule.Unmarshal(m, b)
+}
+func (m *UpgradeSchedule) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_UpgradeSchedule.Marshal(b, m, deterministic)
+}
+func (m *UpgradeSchedule) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UpgradeSchedule.Merge(m, src)
+}
+func (m *UpgradeSchedule) XXX_Size() int {
+	return xxx_messageInfo_UpgradeSchedule.Size(m)
+}
+func (m *UpgradeSchedule) XXX_DiscardUnknown() {
+	xxx_messageInfo_UpgradeSchedule.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_UpgradeSchedule proto.InternalMessageInfo
+
+func (m *UpgradeSchedule) GetUpgrades() []*ScheduledUpgrade {
+	if m != nil {
+		return m.Upgrades
+	}
+	return nil
+}
+
+type TokenTransferWhitelist struct {
+	NodeIdList           []string `protobuf:"bytes,1,rep,name=node_id_list,json=nodeIdList,proto3" json:"node_id_list,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *TokenTransferWhitelist) Reset()         { *m = TokenTransferWhitelist{} }
+func (m *TokenTransferWhitelist) String() string { return proto.CompactTextString(m) }
+func (*TokenTransferWhitelist) ProtoMessage()    {}
+func (*TokenTransferWhitelist) Descriptor() ([]byte, []int) {
+	return fileDescriptor_492be2f0ffbab25c, []int{37}
+}
+
+func (m *TokenTransferWhitelist) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_TokenTransferWhitelist.Unmarshal(m, b)
+}
+func (m *TokenTransferWhitelist) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_TokenTransferWhitelist.Marshal(b, m, deterministic)
+}
+func (m *TokenTransferWhitelist) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TokenTransferWhitelist.Merge(m, src)
+}
+func (m *TokenTransferWhitelist) XXX_Size() int {
+	return xxx_messageInfo_TokenTransferWhitelist.Size(m)
+}
+func (m *TokenTransferWhitelist) XXX_DiscardUnknown() {
+	xxx_messageInfo_TokenTransferWhitelist.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TokenTransferWhitelist proto.InternalMessageInfo
+
+func (m *TokenTransferWhitelist) GetNodeIdList() []string {
+	if m != nil {
+		return m.NodeIdList
+	}
+	return nil
+}
+
+type TokenLedgerEntry struct {
+	Method               string   `protobuf:"bytes,1,opt,name=method,proto3" json:"method,omitempty"`
+	Amount               int64    `protobuf:"varint,2,opt,name=amount,proto3" json:"amount,omitempty"`
+	RequestId            string   `protobuf:"bytes,3,opt,name=request_id,json=requestId,proto3" json:"request_id,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *TokenLedgerEntry) Reset()         { *m = TokenLedgerEntry{} }
+func (m *TokenLedgerEntry) String() string { return proto.CompactTextString(m) }
+func (*TokenLedgerEntry) ProtoMessage()    {}
+func (*TokenLedgerEntry) Descriptor() ([]byte, []int) {
+	return fileDescriptor_492be2f0ffbab25c, []int{38}
+}
+
+func (m *TokenLedgerEntry) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_TokenLedgerEntry.Unmarshal(m, b)
+}
+func (m *TokenLedgerEntry) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_TokenLedgerEntry.Marshal(b, m, deterministic)
+}
+func (m *TokenLedgerEntry) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TokenLedgerEntry.Merge(m, src)
+}
+func (m *TokenLedgerEntry) XXX_Size() int {
+	return xxx_messageInfo_TokenLedgerEntry.Size(m)
+}
+func (m *TokenLedgerEntry) XXX_DiscardUnknown() {
+	xxx_messageInfo_TokenLedgerEntry.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TokenLedgerEntry proto.InternalMessageInfo
+
+func (m *TokenLedgerEntry) GetMethod() string {
+	if m != nil {
+		return m.Method
+	}
+	return ""
+}
+
+func (m *TokenLedgerEntry) GetAmount() int64 {
+	if m != nil {
+		return m.Amount
+	}
+	return 0
+}
+
+func (m *TokenLedgerEntry) GetRequestId() string {
+	if m != nil {
+		return m.RequestId
+	}
+	return ""
+}
+
+type ServicePrice struct {
+	ServiceId            string   `protobuf:"bytes,1,opt,name=service_id,json=serviceId,proto3" json:"service_id,omitempty"`
+	Price                int64    `protobuf:"varint,2,opt,name=price,proto3" json:"price,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ServicePrice) Reset()         { *m = ServicePrice{} }
+func (m *ServicePrice) String() string { return proto.CompactTextString(m) }
+func (*ServicePrice) ProtoMessage()    {}
+func (*ServicePrice) Descriptor() ([]byte, []int) {
+	return fileDescriptor_492be2f0ffbab25c, []int{39}
+}
+
+func (m *ServicePrice) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ServicePrice.Unmarshal(m, b)
+}
+func (m *ServicePrice) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ServicePrice.Marshal(b, m, deterministic)
+}
+func (m *ServicePrice) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ServicePrice.Merge(m, src)
+}
+func (m *ServicePrice) XXX_Size() int {
+	return xxx_messageInfo_ServicePrice.Size(m)
+}
+func (m *ServicePrice) XXX_DiscardUnknown() {
+	xxx_messageInfo_ServicePrice.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ServicePrice proto.InternalMessageInfo
+
+func (m *ServicePrice) GetServiceId() string {
+	if m != nil {
+		return m.ServiceId
+	}
+	return ""
+}
+
+func (m *ServicePrice) GetPrice() int64 {
+	if m != nil {
+		return m.Price
+	}
+	return 0
+}
+
+type TokenPriceRule struct {
+	PerIdpPrice          int64           `protobuf:"varint,1,opt,name=per_idp_price,json=perIdpPrice,proto3" json:"per_idp_price,omitempty"`
+	PerAsPrice           int64           `protobuf:"varint,2,opt,name=per_as_price,json=perAsPrice,proto3" json:"per_as_price,omitempty"`
+	ServicePriceList     []*ServicePrice `protobuf:"bytes,3,rep,name=service_price_list,json=servicePriceList,proto3" json:"service_price_list,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}        `json:"-"`
+	XXX_unrecognized     []byte          `json:"-"`
+	XXX_sizecache        int32           `json:"-"`
+}
+
+func (m *TokenPriceRule) Reset()         { *m = TokenPriceRule{} }
+func (m *TokenPriceRule) String() string { return proto.CompactTextString(m) }
+func (*TokenPriceRule) ProtoMessage()    {}
+func (*TokenPriceRule) Descriptor() ([]byte, []int) {
+	return fileDescriptor_492be2f0ffbab25c, []int{40}
+}
+
+func (m *TokenPriceRule) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_TokenPriceRule.Unmarshal(m, b)
+}
+func (m *TokenPriceRule) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_TokenPriceRule.Marshal(b, m, deterministic)
+}
+func (m *TokenPriceRule) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TokenPriceRule.Merge(m, src)
+}
+func (m *TokenPriceRule) XXX_Size() int {
+	return xxx_messageInfo_TokenPriceRule.Size(m)
+}
+func (m *TokenPriceRule) XXX_DiscardUnknown() {
+	xxx_messageInfo_TokenPriceRule.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TokenPriceRule proto.InternalMessageInfo
+
+func (m *TokenPriceRule) GetPerIdpPrice() int64 {
+	if m != nil {
+		return m.PerIdpPrice
+	}
+	return 0
+}
+
+func (m *TokenPriceRule) GetPerAsPrice() int64 {
+	if m != nil {
+		return m.PerAsPrice
+	}
+	return 0
+}
+
+func (m *TokenPriceRule) GetServicePriceList() []*ServicePrice {
+	if m != nil {
+		return m.ServicePriceList
+	}
+	return nil
+}
+
+type RequestEscrow struct {
+	Amount               int64    `protobuf:"varint,1,opt,name=amount,proto3" json:"amount,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *RequestEscrow) Reset()         { *m = RequestEscrow{} }
+func (m *RequestEscrow) String() string { return proto.CompactTextString(m) }
+func (*RequestEscrow) ProtoMessage()    {}
+func (*RequestEscrow) Descriptor() ([]byte, []int) {
+	return fileDescriptor_492be2f0ffbab25c, []int{41}
+}
+
+func (m *RequestEscrow) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_RequestEscrow.Unmarshal(m, b)
+}
+func (m *RequestEscrow) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_RequestEscrow.Marshal(b, m, deterministic)
+}
+func (m *RequestEscrow) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RequestEscrow.Merge(m, src)
+}
+func (m *RequestEscrow) XXX_Size() int {
+	return xxx_messageInfo_RequestEscrow.Size(m)
+}
+func (m *RequestEscrow) XXX_DiscardUnknown() {
+	xxx_messageInfo_RequestEscrow.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RequestEscrow proto.InternalMessageInfo
+
+func (m *RequestEscrow) GetAmount() int64 {
+	if m != nil {
+		return m.Amount
+	}
+	return 0
+}
+
+type RevenueShareRule struct {
+	IdpPercent           int64    `protobuf:"varint,1,opt,name=idp_percent,json=idpPercent,proto3" json:"idp_percent,omitempty"`
+	AsPercent            int64    `protobuf:"varint,2,opt,name=as_percent,json=asPercent,proto3" json:"as_percent,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *RevenueShareRule) Reset()         { *m = RevenueShareRule{} }
+func (m *RevenueShareRule) String() string { return proto.CompactTextString(m) }
+func (*RevenueShareRule) ProtoMessage()    {}
+func (*RevenueShareRule) Descriptor() ([]byte, []int) {
+	return fileDescriptor_492be2f0ffbab25c, []int{42}
+}
+
+func (m *RevenueShareRule) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_RevenueShareRule.Unmarshal(m, b)
+}
+func (m *RevenueShareRule) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_RevenueShareRule.Marshal(b, m, deterministic)
+}
+func (m *RevenueShareRule) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RevenueShareRule.Merge(m, src)
+}
+func (m *RevenueShareRule) XXX_Size() int {
+	return xxx_messageInfo_RevenueShareRule.Size(m)
+}
+func (m *RevenueShareRule) XXX_DiscardUnknown() {
+	xxx_messageInfo_RevenueShareRule.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RevenueShareRule proto.InternalMessageInfo
+
+func (m *RevenueShareRule) GetIdpPercent() int64 {
+	if m != nil {
+		return m.IdpPercent
+	}
+	return 0
+}
+
+func (m *RevenueShareRule) GetAsPercent() int64 {
+	if m != nil {
+		return m.AsPercent
+	}
+	return 0
+}
+
 func init() {
 	proto.RegisterType((*NodeDetail)(nil), "NodeDetail")
 	proto.RegisterType((*MQ)(nil), "MQ")
@@ -1685,98 +2479,154 @@ func init() {
 	proto.RegisterType((*AccessorInGroup)(nil), "AccessorInGroup")
 	proto.RegisterType((*Token)(nil), "Token")
 	proto.RegisterType((*TokenPrice)(nil), "TokenPrice")
+	proto.RegisterType((*GovernanceKey)(nil), "GovernanceKey")
+	proto.RegisterType((*Governance)(nil), "Governance")
+	proto.RegisterType((*Proposal)(nil), "Proposal")
+	proto.RegisterType((*NodeKey)(nil), "NodeKey")
+	proto.RegisterType((*NodeKeyHistory)(nil), "NodeKeyHistory")
+	proto.RegisterType((*MasterKeyRecovery)(nil), "MasterKeyRecovery")
+	proto.RegisterType((*ScheduledUpgrade)(nil), "ScheduledUpgrade")
+	proto.RegisterType((*UpgradeSchedule)(nil), "UpgradeSchedule")
+	proto.RegisterType((*TokenTransferWhitelist)(nil), "TokenTransferWhitelist")
+	proto.RegisterType((*TokenLedgerEntry)(nil), "TokenLedgerEntry")
+	proto.RegisterType((*ServicePrice)(nil), "ServicePrice")
+	proto.RegisterType((*TokenPriceRule)(nil), "TokenPriceRule")
+	proto.RegisterType((*RequestEscrow)(nil), "RequestEscrow")
+	proto.RegisterType((*RevenueShareRule)(nil), "RevenueShareRule")
 }
 
 func init() { proto.RegisterFile("protos/data/data.proto", fileDescriptor_492be2f0ffbab25c) }
 
 var fileDescriptor_492be2f0ffbab25c = []byte{
-	// 1394 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x57, 0xdd, 0x6e, 0xdc, 0xb6,
-	0x12, 0x86, 0x76, 0xbd, 0x7f, 0xb3, 0xf6, 0xda, 0x96, 0xf3, 0xa3, 0x83, 0xe4, 0x9c, 0xd8, 0x3a,
-	0x39, 0xa7, 0x6e, 0xd0, 0x6c, 0x0a, 0x07, 0x05, 0x0a, 0xf4, 0xa2, 0x70, 0x62, 0xb4, 0xd9, 0xb6,
-	0x4e, 0x1c, 0xd9, 0xe8, 0xad, 0xc0, 0x48, 0xb4, 0x97, 0x88, 0x24, 0xca, 0x22, 0xb5, 0x89, 0xef,
-	0x7b, 0xd5, 0xab, 0xbe, 0x4d, 0xd1, 0x02, 0x7d, 0x9e, 0x3e, 0x42, 0x6f, 0x8b, 0x19, 0x92, 0x5a,
-	0x6d, 0x7e, 0xd1, 0x1b, 0x83, 0xf3, 0x7d, 0xa3, 0x25, 0x67, 0xe6, 0x1b, 0x0e, 0x0d, 0x37, 0xca,
-	0x4a, 0x6a, 0xa9, 0x1e, 0xa4, 0x4c, 0x33, 0xfa, 0x33, 0x25, 0x20, 0xfc, 0xd3, 0x03, 0x78, 0x2a,
-	0x53, 0x7e, 0xc4, 0x35, 0x13, 0x99, 0xff, 0x6f, 0x80, 0xb2, 0x7e, 0x91, 0x89, 0x24, 0x7e, 0xc9,
-	0xaf, 0x02, 0x6f, 0xd7, 0xdb, 0x1f, 0x45, 0x23, 0x83, 0x7c, 0xcf, 0xaf, 0xfc, 0x7b, 0xb0, 0x9d,
-	0x33, 0xa5, 0x79, 0x15, 0xb7, 0xbc, 0x3a, 0xe4, 0xb5, 0x69, 0x88, 0x93, 0xc6, 0xf7, 0x16, 0x8c,
-	0x0a, 0x99, 0xf2, 0xb8, 0x60, 0x39, 0x0f, 0xba, 0xe4, 0x33, 0x44, 0xe0, 0x29, 0xcb, 0xb9, 0xef,
-	0xc3, 0x5a, 0x25, 0x33, 0x1e, 0xac, 0x11, 0x4e, 0x6b, 0xff, 0x26, 0x0c, 0x72, 0xf6, 0x3a, 0x16,
-	0x2c, 0x0b, 0x7a, 0xbb, 0xde, 0xbe, 0x17, 0xf5, 0x73, 0xf6, 0x7a, 0xc6, 0x32, 0x47, 0x30, 0x96,
-	0x05, 0xfd, 0x86, 0x38, 0x64, 0x99, 0xbf, 0x03, 0x9d, 0xfc, 0x32, 0x18, 0xec, 0x76, 0xf7, 0xc7,
-	0x07, 0xdd, 0xe9, 0xf1, 0xf3, 0xa8, 0x93, 0x5f, 0xfa, 0x37, 0xa0, 0xcf, 0x12, 0x2d, 0x16, 0x3c,
-	0x18, 0xee, 0x7a, 0xfb, 0xc3, 0xc8, 0x5a, 0xe1, 0x3e, 0x74, 0x8e, 0x9f, 0xfb, 0x13, 0xe8, 0x88,
-	0xd2, 0x06, 0xd6, 0x11, 0x25, 0x1e, 0xa4, 0x94, 0x95, 0xa6, 0x20, 0xba, 0x11, 0xad, 0xc3, 0x10,
-	0x06, 0xb3, 0xf4, 0xe4, 0x07, 0xa1, 0x34, 0x6e, 0x4d, 0x41, 0x88, 0x34, 0xf0, 0x76, 0xbb, 0xfb,
-	0xa3, 0xa8, 0x8f, 0xe6, 0x2c, 0x0d, 0xbf, 0x82, 0x0d, 0x0c, 0x44, 0x95, 0x2c, 0xe1, 0xe4, 0x79,
-	0x0f, 0xa0, 0x70, 0x80, 0x22, 0xe7, 0xf1, 0x01, 0x4c, 0x1b, 0x9f, 0xa8, 0xc5, 0x86, 0x09, 0x8c,
-	0x1a, 0xc2, 0xbf, 0x0d, 0xa3, 0x86, 0x72, 0x19, 0x6f, 0x00, 0x7f, 0x17, 0xc6, 0x29, 0x57, 0x49,
-	0x25, 0x4a, 0x2d, 0x64, 0x61, 0x73, 0xdd, 0x86, 0x5a, 0xf1, 0x76, 0x57, 0xe2, 0xfd, 0x1a, 0xb6,
-	0x4f, 0x79, 0xb5, 0x10, 0x89, 0xad, 0xad, 0x3d, 0xe5, 0x50, 0x19, 0xd0, 0x9d, 0x71, 0x32, 0x5d,
-	0xf1, 0x8a, 0x1a, 0x3e, 0xfc, 0xdd, 0x83, 0x8d, 0x15, 0x0e, 0xd5, 0x61, 0x59, 0x93, 0x10, 0x3a,
-	0xab, 0x45, 0x66, 0xa9, 0xbf, 0x07, 0xeb, 0x8e, 0xa6, 0xa2, 0xdb, 0xc3, 0x5a, 0x8c, 0xea, 0x7e,
-	0x07, 0xc6, 0x28, 0xbe, 0x58, 0x25, 0x73, 0x9e, 0x33, 0x2b, 0x0b, 0x40, 0xe8, 0x94, 0x10, 0x7f,
-	0x0a, 0x3b, 0x2d, 0x87, 0x78, 0xc1, 0x2b, 0x85, 0x71, 0x1b, 0x9d, 0x6c, 0x2f, 0x1d, 0x7f, 0x34,
-	0x44, 0x2b, 0xfa, 0xde, 0x1b, 0xd5, 0x9e, 0x1c, 0x96, 0x65, 0x25, 0x17, 0xdc, 0x86, 0xd0, 0xf2,
-	0xf4, 0x56, 0x3c, 0x8f, 0xe0, 0xf6, 0x99, 0xc8, 0xf9, 0xb3, 0x5a, 0x3f, 0xca, 0x64, 0xf2, 0x32,
-	0xe2, 0x17, 0x02, 0x85, 0x3c, 0x4b, 0x79, 0xa1, 0x85, 0xbe, 0xf2, 0xef, 0xc2, 0x44, 0x8b, 0x9c,
-	0xc7, 0xb2, 0xd6, 0xf1, 0x0b, 0xf4, 0xa0, 0xef, 0xbb, 0xd1, 0xba, 0x6e, 0x7d, 0x15, 0x3e, 0x86,
-	0xde, 0x49, 0x25, 0x5f, 0x5f, 0xf9, 0x21, 0x6c, 0x94, 0xb8, 0x88, 0x97, 0xba, 0xa1, 0x2c, 0x10,
-	0xf8, 0x94, 0xc4, 0x83, 0x47, 0x49, 0x64, 0x71, 0x2e, 0x2e, 0x6c, 0x8a, 0xac, 0x15, 0xfe, 0x1f,
-	0x26, 0x8f, 0xf8, 0x5c, 0x14, 0x29, 0xfa, 0x51, 0xbd, 0xae, 0x41, 0x0f, 0x7f, 0x47, 0x59, 0xf5,
-	0x19, 0x23, 0xfc, 0x63, 0x0d, 0x06, 0x11, 0xbf, 0xac, 0xb9, 0xd2, 0x58, 0x93, 0xca, 0x2c, 0x5b,
-	0x35, 0xb1, 0xc8, 0x2c, 0xa5, 0xde, 0x11, 0x45, 0x2c, 0xd2, 0xd2, 0x4a, 0xbc, 0x9f, 0x8b, 0x62,
-	0x96, 0x96, 0x8e, 0xc0, 0xa6, 0xea, 0xda, 0xa6, 0x12, 0xc5, 0xa1, 0xed, 0x36, 0xfc, 0x82, 0x65,
-	0x94, 0x75, 0x43, 0x60, 0x1b, 0x7e, 0x02, 0x9b, 0x6e, 0x27, 0x0c, 0x5d, 0xd6, 0x9a, 0x72, 0xde,
-	0x8d, 0x26, 0x16, 0x3e, 0x33, 0xa8, 0xff, 0x1f, 0x18, 0x8b, 0xb4, 0x8c, 0x45, 0x1a, 0x67, 0x42,
-	0xe9, 0xa0, 0x4f, 0x47, 0x1f, 0x89, 0xb4, 0x9c, 0xa5, 0x14, 0xd4, 0x97, 0x40, 0x85, 0x8c, 0xdd,
-	0xaf, 0x91, 0x97, 0xe9, 0xe2, 0xf5, 0xe9, 0x11, 0xd3, 0xcc, 0xc6, 0x16, 0x6d, 0xa6, 0x4b, 0x83,
-	0xbe, 0xfc, 0x1c, 0xae, 0xb9, 0x8f, 0x72, 0xae, 0x14, 0xbb, 0xe0, 0xf1, 0x9c, 0xa9, 0x39, 0x75,
-	0xfa, 0x28, 0xf2, 0x2d, 0x77, 0x6c, 0xa8, 0x27, 0x4c, 0xcd, 0xfd, 0x29, 0x6c, 0x54, 0x5c, 0x95,
-	0xb2, 0x50, 0xdc, 0xec, 0x33, 0xa2, 0x7d, 0x46, 0xd3, 0xc8, 0xa2, 0xd1, 0xba, 0xe3, 0x69, 0x07,
-	0x2c, 0x4d, 0x26, 0x15, 0x4f, 0x03, 0x30, 0x2a, 0x31, 0x16, 0xde, 0x66, 0x18, 0x74, 0x8a, 0x32,
-	0x08, 0xc6, 0x44, 0x0d, 0x09, 0x78, 0x56, 0x6b, 0x3f, 0x80, 0x41, 0x59, 0x57, 0xa5, 0x54, 0x3c,
-	0x58, 0xa7, 0x93, 0x38, 0x13, 0xeb, 0x27, 0x5f, 0x15, 0xbc, 0x0a, 0x36, 0x08, 0x37, 0x06, 0x5e,
-	0x3a, 0xb9, 0x4c, 0x79, 0x30, 0x31, 0x97, 0x0e, 0xae, 0x71, 0x83, 0x5a, 0xf1, 0x38, 0x91, 0x75,
-	0xa1, 0x83, 0x4d, 0x22, 0x86, 0xb5, 0xe2, 0x8f, 0xd1, 0xf6, 0x0f, 0xe0, 0x7a, 0x52, 0x71, 0x86,
-	0xfd, 0x6e, 0x34, 0x18, 0xcf, 0xb9, 0xb8, 0x98, 0xeb, 0x60, 0x8b, 0x1c, 0x77, 0x1c, 0x49, 0x5a,
-	0x7c, 0x42, 0x94, 0xff, 0x2f, 0x18, 0x26, 0x73, 0x46, 0xb5, 0x0f, 0xb6, 0xcd, 0xa9, 0xc8, 0x9e,
-	0xa5, 0xe1, 0x5f, 0x1e, 0x8c, 0x5b, 0x79, 0xfe, 0x58, 0x5f, 0xdf, 0x06, 0x60, 0xaa, 0x29, 0x67,
-	0x87, 0xca, 0x39, 0x64, 0xca, 0x56, 0xf3, 0x3a, 0xf4, 0x49, 0x48, 0x8a, 0x74, 0xd4, 0x8d, 0x7a,
-	0xa8, 0x23, 0x85, 0x8d, 0xec, 0x4a, 0x55, 0xb2, 0x8a, 0xe5, 0xca, 0x54, 0xca, 0x36, 0xb2, 0xa5,
-	0x4e, 0x88, 0xa1, 0x42, 0xdd, 0x87, 0x1d, 0x56, 0xa8, 0x57, 0xbc, 0xe2, 0x69, 0xdc, 0xda, 0xad,
-	0x47, 0xbb, 0x6d, 0x39, 0xea, 0xd0, 0xed, 0xfa, 0x05, 0xdc, 0xac, 0x78, 0xc2, 0xc5, 0x82, 0xa7,
-	0x31, 0x89, 0xe9, 0xbc, 0x92, 0x79, 0x5b, 0x6f, 0xd7, 0x1c, 0x8d, 0x81, 0x7e, 0x53, 0xc9, 0x1c,
-	0x3f, 0x0b, 0x7f, 0xed, 0xc0, 0xd0, 0x55, 0xde, 0xdf, 0x82, 0x2e, 0xaa, 0xdc, 0x23, 0x95, 0xe3,
-	0x12, 0x11, 0x6c, 0x88, 0x8e, 0x41, 0x18, 0xcb, 0x50, 0x0f, 0x4a, 0x33, 0x5d, 0x2b, 0x7b, 0x57,
-	0x59, 0x0b, 0x6f, 0x6d, 0x25, 0x2e, 0x0a, 0xa6, 0xeb, 0xca, 0x4d, 0xb1, 0x25, 0xe0, 0xff, 0x0f,
-	0x26, 0xc2, 0xde, 0x1f, 0x71, 0x59, 0x49, 0x79, 0x4e, 0x9d, 0x32, 0x8a, 0x36, 0x1c, 0x7a, 0x82,
-	0xa0, 0xff, 0x19, 0xf8, 0x65, 0x25, 0x16, 0x4c, 0x73, 0xe3, 0x65, 0x52, 0xd4, 0x27, 0xd7, 0x2d,
-	0xcb, 0x90, 0x27, 0x65, 0xe8, 0x3a, 0xf4, 0x4d, 0x5b, 0x05, 0x03, 0x23, 0x26, 0xea, 0x28, 0xbc,
-	0x52, 0x17, 0x2c, 0x13, 0xa9, 0xdd, 0xc8, 0xb4, 0x02, 0x10, 0x64, 0x76, 0xb9, 0x05, 0x23, 0xe3,
-	0x80, 0xc1, 0x8e, 0xcc, 0x20, 0x26, 0xc0, 0x36, 0xb5, 0x21, 0x97, 0xd1, 0x00, 0xb9, 0x4c, 0x08,
-	0x3e, 0x75, 0x68, 0xf8, 0x00, 0x20, 0xe2, 0x38, 0x1e, 0x29, 0xfd, 0x7b, 0x30, 0xa8, 0xc8, 0x72,
-	0x63, 0x64, 0x30, 0x35, 0x6c, 0xe4, 0xf0, 0xf0, 0x3b, 0xe8, 0x1b, 0x08, 0x73, 0x98, 0x73, 0x3d,
-	0x97, 0x4e, 0x5a, 0xd6, 0xc2, 0xe6, 0x28, 0x2b, 0x91, 0x70, 0x9b, 0x6f, 0x63, 0x60, 0x73, 0x60,
-	0x41, 0x6d, 0xbe, 0x69, 0x1d, 0xfe, 0xe6, 0xc1, 0xf0, 0x30, 0x49, 0xb8, 0x52, 0xb2, 0xf2, 0xff,
-	0x0b, 0x1b, 0xcc, 0xae, 0x63, 0x7d, 0x55, 0xba, 0xa1, 0xb9, 0xee, 0xc0, 0xb3, 0xab, 0x92, 0xa3,
-	0xfc, 0x1a, 0xa7, 0xb7, 0xde, 0x2a, 0xdb, 0x8e, 0x3a, 0x69, 0xbf, 0x6c, 0x1a, 0xff, 0x8b, 0x4a,
-	0xd6, 0x94, 0x67, 0x73, 0x84, 0x4d, 0x47, 0x7c, 0x8b, 0xb8, 0xb9, 0xbe, 0xed, 0x24, 0x59, 0x6b,
-	0x4f, 0x92, 0x65, 0xb3, 0xf7, 0x5a, 0xcd, 0x1e, 0x7e, 0x0a, 0x70, 0xac, 0x2e, 0x8f, 0xb8, 0xa2,
-	0xc4, 0xdd, 0x6a, 0x5f, 0xe8, 0xe3, 0x83, 0xde, 0x14, 0xaf, 0x7a, 0x77, 0xaf, 0xff, 0xe4, 0xc1,
-	0x1a, 0xda, 0xef, 0x50, 0x66, 0xeb, 0x21, 0x62, 0x67, 0x46, 0xd1, 0xcc, 0x92, 0x77, 0x8d, 0x7f,
-	0x3c, 0xcc, 0xb9, 0xa8, 0x94, 0xb6, 0x67, 0x34, 0x06, 0xe6, 0xce, 0xde, 0xdd, 0x76, 0x96, 0xf5,
-	0x96, 0xb3, 0x4c, 0xba, 0x59, 0xf6, 0x10, 0xc6, 0x76, 0x68, 0xd2, 0x91, 0xef, 0xbe, 0xf5, 0x66,
-	0x18, 0xba, 0x37, 0x43, 0xeb, 0xb5, 0xf0, 0x8b, 0x07, 0x03, 0x37, 0x6a, 0x3f, 0x72, 0x9f, 0xb4,
-	0x26, 0x4c, 0x67, 0x65, 0xc2, 0xbc, 0x77, 0x26, 0xbd, 0x2f, 0xe3, 0xd8, 0x85, 0xb5, 0x2a, 0x79,
-	0x91, 0xf2, 0xd4, 0x3e, 0x00, 0x96, 0x40, 0x78, 0x1f, 0x26, 0xcd, 0xfb, 0xc5, 0x65, 0x7f, 0x0d,
-	0xd3, 0xd6, 0x68, 0xf6, 0xf0, 0x94, 0xd2, 0x4f, 0x60, 0xf8, 0xb3, 0x07, 0x7d, 0x03, 0xac, 0x3e,
-	0xfb, 0xda, 0xd9, 0xfe, 0xe7, 0x47, 0x5f, 0xcd, 0xc5, 0xda, 0x9b, 0xb9, 0x78, 0xdf, 0xfb, 0x65,
-	0x0f, 0xfa, 0xd1, 0x47, 0x9e, 0xa0, 0x7b, 0x78, 0xdc, 0x0f, 0xbb, 0x84, 0x30, 0x38, 0xcc, 0xb2,
-	0x0f, 0xfb, 0x3c, 0x80, 0x4d, 0xd7, 0x5a, 0xb3, 0x82, 0x24, 0x8e, 0x69, 0x75, 0x9a, 0x77, 0x2f,
-	0x8f, 0x25, 0x10, 0xde, 0x81, 0xde, 0x99, 0x7c, 0xc9, 0xcd, 0xdb, 0x2b, 0xa7, 0x79, 0x65, 0x84,
-	0x6a, 0xad, 0x30, 0x04, 0x20, 0x87, 0x13, 0xea, 0xe7, 0xa6, 0xcb, 0xbd, 0x56, 0x97, 0xbf, 0xe8,
-	0xd3, 0xbf, 0x1f, 0x0f, 0xff, 0x0e, 0x00, 0x00, 0xff, 0xff, 0x80, 0xf9, 0x2c, 0xa3, 0x98, 0x0c,
-	0x00, 0x00,
+	// 2071 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x58, 0x4b, 0x73, 0x1c, 0x49,
+	0x11, 0x8e, 0x99, 0xd1, 0xbc, 0x72, 0x34, 0x0f, 0xb5, 0x1f, 0x3b, 0x60, 0x2f, 0x96, 0x7b, 0x17,
+	0x2c, 0x6f, 0xe0, 0x31, 0x78, 0x21, 0x82, 0x60, 0x0f, 0x20, 0x3f, 0x58, 0x0f, 0x58, 0x5e, 0x6d,
+	0x5b, 0xc0, 0xb1, 0xa3, 0xdc, 0x9d, 0xd6, 0x54, 0xa8, 0x5f, 0xae, 0xea, 0x91, 0x3c, 0x17, 0x4e,
+	0x9c, 0x38, 0x6d, 0xc0, 0xdf, 0xe0, 0x4e, 0xc0, 0x9d, 0x9f, 0xc3, 0x99, 0x2b, 0x91, 0x59, 0x55,
+	0xdd, 0x3d, 0xf2, 0x7a, 0x15, 0x5c, 0x26, 0x3a, 0xbf, 0xcc, 0xee, 0xca, 0x77, 0x66, 0x0d, 0xdc,
+	0x2c, 0x54, 0x5e, 0xe6, 0xfa, 0x61, 0x2c, 0x4a, 0xc1, 0x3f, 0x0b, 0x06, 0xfc, 0xbf, 0xb6, 0x01,
+	0x5e, 0xe6, 0x31, 0x3e, 0xc5, 0x52, 0xc8, 0xc4, 0xfb, 0x18, 0xa0, 0x58, 0xbf, 0x4e, 0x64, 0x14,
+	0x9e, 0xe1, 0x66, 0xde, 0xda, 0x6f, 0x1d, 0x0c, 0x83, 0xa1, 0x41, 0x7e, 0x87, 0x1b, 0xef, 0x33,
+	0xd8, 0x4b, 0x85, 0x2e, 0x51, 0x85, 0x0d, 0xa9, 0x36, 0x4b, 0x4d, 0x0d, 0xe3, 0xb8, 0x92, 0xbd,
+	0x05, 0xc3, 0x2c, 0x8f, 0x31, 0xcc, 0x44, 0x8a, 0xf3, 0x0e, 0xcb, 0x0c, 0x08, 0x78, 0x29, 0x52,
+	0xf4, 0x3c, 0xd8, 0x51, 0x79, 0x82, 0xf3, 0x1d, 0xc6, 0xf9, 0xd9, 0xfb, 0x08, 0xfa, 0xa9, 0x78,
+	0x17, 0x4a, 0x91, 0xcc, 0xbb, 0xfb, 0xad, 0x83, 0x56, 0xd0, 0x4b, 0xc5, 0xbb, 0xa5, 0x48, 0x1c,
+	0x43, 0x88, 0x64, 0xde, 0xab, 0x18, 0x87, 0x22, 0xf1, 0xae, 0x41, 0x3b, 0x7d, 0x3b, 0xef, 0xef,
+	0x77, 0x0e, 0x46, 0x8f, 0x3a, 0x8b, 0xa3, 0xaf, 0x83, 0x76, 0xfa, 0xd6, 0xbb, 0x09, 0x3d, 0x11,
+	0x95, 0xf2, 0x1c, 0xe7, 0x83, 0xfd, 0xd6, 0xc1, 0x20, 0xb0, 0x94, 0x77, 0x1f, 0x66, 0x5a, 0x9e,
+	0x66, 0xa2, 0x5c, 0x2b, 0x0c, 0x75, 0xb4, 0xc2, 0x14, 0xe7, 0x43, 0xa3, 0x7a, 0x85, 0xbf, 0x62,
+	0xd8, 0x3f, 0x80, 0xf6, 0xd1, 0xd7, 0xde, 0x04, 0xda, 0xb2, 0xb0, 0x3e, 0x68, 0xcb, 0x82, 0x74,
+	0x2e, 0x72, 0x55, 0xb2, 0xbd, 0x9d, 0x80, 0x9f, 0x7d, 0x1f, 0xfa, 0xcb, 0xf8, 0xf8, 0x85, 0xd4,
+	0x25, 0x69, 0xc9, 0xf6, 0xca, 0x78, 0xde, 0xda, 0xef, 0x1c, 0x0c, 0x83, 0x1e, 0x91, 0xcb, 0xd8,
+	0xff, 0x02, 0xc6, 0x64, 0xb3, 0x2e, 0x44, 0x84, 0x2c, 0xf9, 0x19, 0x40, 0xe6, 0x00, 0xcd, 0xc2,
+	0xa3, 0x47, 0xb0, 0xa8, 0x64, 0x82, 0x06, 0xd7, 0x8f, 0x60, 0x58, 0x31, 0xbc, 0xdb, 0x30, 0xac,
+	0x58, 0x2e, 0x38, 0x15, 0xe0, 0xed, 0xc3, 0x28, 0x46, 0x1d, 0x29, 0x59, 0x94, 0x32, 0xcf, 0x6c,
+	0x58, 0x9a, 0x50, 0xc3, 0x35, 0x9d, 0xa6, 0x6b, 0xfc, 0x5f, 0xc1, 0xde, 0x2b, 0x54, 0xe7, 0x32,
+	0xb2, 0x69, 0x60, 0xb5, 0x1c, 0x68, 0x03, 0x3a, 0x1d, 0x27, 0x8b, 0x2d, 0xa9, 0xa0, 0xe2, 0xfb,
+	0xff, 0x6a, 0xc1, 0x78, 0x8b, 0x47, 0x89, 0x64, 0xb9, 0xc6, 0x21, 0xac, 0xab, 0x45, 0x96, 0xb1,
+	0x77, 0x17, 0x76, 0x1d, 0x9b, 0xf3, 0xc3, 0x2a, 0x6b, 0x31, 0x4e, 0x91, 0x3b, 0x30, 0xa2, 0x3c,
+	0x35, 0xa1, 0x12, 0x36, 0x83, 0x80, 0x20, 0x8e, 0x92, 0xf0, 0x16, 0x70, 0xad, 0x21, 0x10, 0x9e,
+	0xa3, 0xd2, 0x64, 0xb7, 0x49, 0xa9, 0xbd, 0x5a, 0xf0, 0x0f, 0x86, 0xd1, 0xb0, 0xbe, 0xbb, 0x65,
+	0xfd, 0x01, 0x4c, 0x0e, 0x8b, 0x42, 0xe5, 0xe7, 0x68, 0x4d, 0x68, 0x48, 0xb6, 0xb6, 0x24, 0x9f,
+	0xc2, 0xed, 0x13, 0x99, 0xe2, 0x57, 0xeb, 0xf2, 0x71, 0x92, 0x47, 0x67, 0x01, 0x9e, 0x4a, 0xca,
+	0xf9, 0x65, 0x8c, 0x59, 0x29, 0xcb, 0x8d, 0xf7, 0x29, 0x4c, 0x4a, 0x99, 0x62, 0x98, 0xaf, 0xcb,
+	0xf0, 0x35, 0x49, 0xf0, 0xfb, 0x9d, 0x60, 0xb7, 0x6c, 0xbc, 0xe5, 0x3f, 0x81, 0xee, 0xb1, 0xca,
+	0xdf, 0x6d, 0x3c, 0x1f, 0xc6, 0x05, 0x3d, 0x84, 0x75, 0xde, 0xb0, 0x17, 0x18, 0x7c, 0xc9, 0xc9,
+	0x43, 0xaa, 0x44, 0x79, 0xf6, 0x46, 0x9e, 0x5a, 0x17, 0x59, 0xca, 0xff, 0x11, 0x4c, 0x1e, 0xe3,
+	0x4a, 0x66, 0x31, 0xc9, 0x71, 0xbc, 0xae, 0x43, 0x97, 0xbe, 0xa3, 0x6d, 0xf6, 0x19, 0xc2, 0xff,
+	0xcf, 0x0e, 0xf4, 0x03, 0x7c, 0xbb, 0x46, 0x5d, 0x52, 0x4c, 0x94, 0x79, 0x6c, 0xc4, 0xc4, 0x22,
+	0xcb, 0x98, 0xcb, 0x4c, 0x66, 0xa1, 0x8c, 0x0b, 0x9b, 0xe2, 0xbd, 0x54, 0x66, 0xcb, 0xb8, 0x70,
+	0x0c, 0xaa, 0xbf, 0x8e, 0xad, 0x3f, 0x99, 0x1d, 0x8a, 0xa4, 0x7a, 0x43, 0x24, 0xf3, 0x9d, 0x8a,
+	0x41, 0x15, 0x7b, 0x0f, 0xa6, 0xee, 0x24, 0x32, 0x3d, 0x5f, 0x97, 0xec, 0xf3, 0x4e, 0x30, 0xb1,
+	0xf0, 0x89, 0x41, 0xbd, 0x1f, 0xc0, 0x48, 0xc6, 0x45, 0x28, 0xe3, 0x30, 0x91, 0xba, 0x9c, 0xf7,
+	0x58, 0xf5, 0xa1, 0x8c, 0x8b, 0x65, 0xcc, 0x46, 0xfd, 0x02, 0x38, 0x90, 0xa1, 0xfb, 0x1a, 0x4b,
+	0x99, 0x82, 0xdf, 0x5d, 0x3c, 0x15, 0xa5, 0xb0, 0xb6, 0x05, 0xd3, 0xb8, 0x26, 0xf8, 0xcd, 0x9f,
+	0xc0, 0x75, 0xf7, 0x52, 0x8a, 0x5a, 0x8b, 0x53, 0x0c, 0x57, 0x42, 0xaf, 0xb8, 0x29, 0x0c, 0x03,
+	0xcf, 0xf2, 0x8e, 0x0c, 0xeb, 0xb9, 0xd0, 0x2b, 0x6f, 0x01, 0x63, 0x85, 0xba, 0xc8, 0x33, 0x8d,
+	0xe6, 0x9c, 0x21, 0x9f, 0x33, 0x5c, 0x04, 0x16, 0x0d, 0x76, 0x1d, 0x9f, 0x4f, 0xa0, 0xd0, 0x24,
+	0xb9, 0xc6, 0x78, 0x0e, 0x26, 0x4b, 0x0c, 0x45, 0x8d, 0x8f, 0x8c, 0x8e, 0x29, 0x0d, 0xe6, 0x23,
+	0x66, 0x0d, 0x18, 0xf8, 0x6a, 0x5d, 0x7a, 0x73, 0xe8, 0x17, 0x6b, 0x55, 0xe4, 0x1a, 0xe7, 0xbb,
+	0xac, 0x89, 0x23, 0x29, 0x7e, 0xf9, 0x45, 0x86, 0x6a, 0x3e, 0x66, 0xdc, 0x10, 0xd4, 0x74, 0xd2,
+	0x3c, 0xc6, 0xf9, 0xc4, 0x34, 0x1d, 0x7a, 0xa6, 0x03, 0xd6, 0x1a, 0xc3, 0x28, 0x5f, 0x67, 0xe5,
+	0x7c, 0xca, 0x8c, 0xc1, 0x5a, 0xe3, 0x13, 0xa2, 0xbd, 0x47, 0x70, 0x23, 0x52, 0x28, 0xa8, 0xde,
+	0x4d, 0x0e, 0x86, 0x2b, 0x94, 0xa7, 0xab, 0x72, 0x3e, 0x63, 0xc1, 0x6b, 0x8e, 0xc9, 0xb9, 0xf8,
+	0x9c, 0x59, 0xde, 0xf7, 0x60, 0x10, 0xad, 0x04, 0xc7, 0x7e, 0xbe, 0x67, 0xb4, 0x62, 0x7a, 0x19,
+	0x53, 0x91, 0xe1, 0xbb, 0x42, 0x2a, 0xdc, 0xfe, 0x98, 0xc7, 0x1f, 0xdb, 0x33, 0xac, 0xc6, 0xa7,
+	0xfc, 0xff, 0xb6, 0x60, 0xd4, 0x88, 0xcb, 0x55, 0x7d, 0xe0, 0x36, 0x80, 0xd0, 0x55, 0xf8, 0xdb,
+	0x1c, 0xfe, 0x81, 0xd0, 0x36, 0xfa, 0x37, 0xa0, 0xc7, 0x89, 0xa7, 0x39, 0xef, 0x3a, 0x41, 0x97,
+	0xf2, 0x4e, 0x93, 0x4e, 0x2e, 0xb4, 0x85, 0x50, 0x22, 0xd5, 0x26, 0xb2, 0xb6, 0xf0, 0x2d, 0xeb,
+	0x98, 0x39, 0x1c, 0xd8, 0x07, 0x70, 0x4d, 0x64, 0xfa, 0x02, 0x15, 0xc6, 0x61, 0xe3, 0xb4, 0x2e,
+	0x9f, 0x36, 0x73, 0xac, 0x43, 0x77, 0xea, 0xcf, 0xe1, 0x23, 0x85, 0x11, 0xca, 0x73, 0x8c, 0x43,
+	0x4e, 0xbe, 0x37, 0x2a, 0x4f, 0x9b, 0xf9, 0x79, 0xdd, 0xb1, 0xc9, 0xd0, 0xdf, 0xa8, 0x3c, 0xa5,
+	0xd7, 0xfc, 0x7f, 0xb4, 0x61, 0xe0, 0x32, 0xc5, 0x9b, 0x41, 0x87, 0xaa, 0xa2, 0xc5, 0x55, 0x41,
+	0x8f, 0x84, 0x50, 0x01, 0xb5, 0x0d, 0x22, 0x44, 0x42, 0xf9, 0xa3, 0x4b, 0x51, 0xae, 0xb5, 0xed,
+	0x6d, 0x96, 0xa2, 0x2e, 0x5f, 0x0d, 0x24, 0x6b, 0x54, 0x0d, 0x78, 0x3f, 0x84, 0x89, 0xb4, 0xfd,
+	0x26, 0x2c, 0x54, 0x9e, 0xbf, 0xe1, 0xca, 0x1a, 0x06, 0x63, 0x87, 0x1e, 0x13, 0xe8, 0xfd, 0x18,
+	0xbc, 0x42, 0xc9, 0x73, 0x51, 0xa2, 0x91, 0x32, 0x2e, 0xea, 0xb1, 0xe8, 0xcc, 0x72, 0x58, 0x92,
+	0x3d, 0x74, 0x03, 0x7a, 0xa6, 0x0c, 0xe7, 0x7d, 0x93, 0x7c, 0x5c, 0x81, 0xd4, 0x82, 0xcf, 0x45,
+	0x22, 0x63, 0x7b, 0x90, 0x29, 0x1d, 0x60, 0xc8, 0x9c, 0x72, 0x0b, 0x86, 0x46, 0x80, 0x8c, 0x35,
+	0xc3, 0x74, 0xc0, 0x80, 0x6d, 0x02, 0x86, 0x59, 0x5b, 0x03, 0x2c, 0x32, 0x61, 0xf8, 0x95, 0x43,
+	0xfd, 0x87, 0x00, 0x01, 0xd2, 0x38, 0x65, 0xf7, 0xdf, 0x85, 0xbe, 0x62, 0xca, 0x8d, 0x9d, 0xfe,
+	0xc2, 0x70, 0x03, 0x87, 0xfb, 0xbf, 0x85, 0x9e, 0x81, 0xc8, 0x87, 0x29, 0x96, 0xab, 0xdc, 0xa5,
+	0x96, 0xa5, 0xa8, 0x98, 0x0a, 0x25, 0x23, 0xb4, 0xfe, 0x36, 0x04, 0x15, 0x13, 0x05, 0xd4, 0xfa,
+	0x9b, 0x9f, 0xfd, 0x7f, 0xb6, 0x60, 0x70, 0x18, 0x45, 0xa8, 0x75, 0xae, 0xbc, 0x4f, 0x60, 0x2c,
+	0xec, 0x73, 0x58, 0x6e, 0x0a, 0x37, 0x64, 0x77, 0x1d, 0x78, 0xb2, 0x29, 0x90, 0xd2, 0xaf, 0x12,
+	0x7a, 0x6f, 0x0d, 0xda, 0x73, 0xac, 0xe3, 0xe6, 0xd2, 0x54, 0xc9, 0x9f, 0xaa, 0x7c, 0xcd, 0x7e,
+	0x36, 0x2a, 0x4c, 0x1d, 0xe3, 0x4b, 0xc2, 0x4d, 0xbb, 0xb7, 0x93, 0x67, 0x67, 0x6b, 0x79, 0xa9,
+	0x9a, 0x43, 0xb7, 0xd1, 0x1c, 0xfc, 0xfb, 0x00, 0x47, 0xfa, 0xed, 0x53, 0xd4, 0xec, 0xb8, 0x5b,
+	0xcd, 0x01, 0x30, 0x7a, 0xd4, 0x5d, 0xd0, 0x68, 0x70, 0x73, 0xe0, 0xcf, 0x2d, 0xd8, 0x21, 0xfa,
+	0x5b, 0x32, 0xb3, 0xb1, 0xb8, 0xd8, 0x19, 0x93, 0x55, 0xb3, 0xe7, 0xdb, 0xd6, 0x05, 0x52, 0xe6,
+	0x8d, 0x54, 0xba, 0xb4, 0x3a, 0x1a, 0x82, 0x7c, 0x67, 0x7b, 0xbd, 0x9d, 0x7d, 0xdd, 0x7a, 0xf6,
+	0xe5, 0x6e, 0xf6, 0x7d, 0x0e, 0x23, 0x3b, 0x64, 0x59, 0xe5, 0x4f, 0xdf, 0xdb, 0x31, 0x06, 0x6e,
+	0xc7, 0x68, 0x6c, 0x17, 0xdf, 0xb4, 0xa0, 0x6f, 0xd1, 0xab, 0xfa, 0x49, 0x63, 0x22, 0xb5, 0xb7,
+	0x26, 0xd2, 0x07, 0x67, 0xd8, 0x87, 0x3c, 0x4e, 0x55, 0xb8, 0xd6, 0x05, 0x66, 0x31, 0xc6, 0x76,
+	0x61, 0xa8, 0x01, 0xff, 0x01, 0x4c, 0xaa, 0x7d, 0xc7, 0x79, 0x7f, 0x87, 0xdc, 0x56, 0xe5, 0xec,
+	0xe1, 0x2b, 0x76, 0x3f, 0x83, 0xfe, 0x5f, 0x5a, 0xd0, 0x33, 0xc0, 0xf6, 0x9a, 0xd8, 0xf4, 0xf6,
+	0xff, 0xaf, 0xfa, 0xb6, 0x2f, 0x76, 0x2e, 0xfb, 0xe2, 0x43, 0xfb, 0xce, 0x5d, 0xe8, 0x05, 0x57,
+	0xac, 0xac, 0x77, 0x49, 0xdd, 0xef, 0x16, 0xf1, 0xa1, 0x7f, 0x98, 0x24, 0xdf, 0x2d, 0xf3, 0x10,
+	0xa6, 0xae, 0xb4, 0x96, 0x19, 0xa7, 0x38, 0xb9, 0xd5, 0xe5, 0xbc, 0xdb, 0x54, 0x6a, 0xc0, 0xff,
+	0x77, 0x0b, 0xba, 0x27, 0xf9, 0x19, 0x9a, 0x65, 0x2d, 0xe5, 0x01, 0x67, 0x32, 0xd5, 0x52, 0xb4,
+	0x38, 0xa6, 0x32, 0xcb, 0x55, 0x68, 0xb9, 0x66, 0x53, 0x19, 0x31, 0x76, 0x58, 0x89, 0x44, 0x0a,
+	0x63, 0x49, 0xdb, 0x42, 0x2a, 0x4b, 0x3b, 0x3b, 0x46, 0x06, 0x7b, 0x41, 0x10, 0x95, 0x64, 0x92,
+	0x5f, 0x84, 0xaf, 0x45, 0x22, 0xb2, 0x08, 0x43, 0x91, 0xa0, 0x72, 0xd9, 0x3c, 0x4d, 0xf2, 0x8b,
+	0xc7, 0x06, 0x3f, 0x24, 0x98, 0x06, 0x6a, 0x53, 0xb6, 0x5c, 0x29, 0xd4, 0xab, 0x3c, 0x89, 0x6d,
+	0x7e, 0x5f, 0xab, 0xe5, 0x4f, 0x1c, 0xcb, 0x7f, 0x02, 0xc0, 0x66, 0x1c, 0x73, 0xdb, 0xa9, 0x9a,
+	0x51, 0xab, 0xd9, 0x8c, 0xee, 0x80, 0xd1, 0x3a, 0xac, 0x1b, 0x55, 0x27, 0x00, 0x86, 0xf8, 0x35,
+	0xff, 0x19, 0x8c, 0xbf, 0xcc, 0xcf, 0x51, 0x65, 0xf4, 0x6d, 0x6a, 0x24, 0x37, 0xa0, 0x77, 0x86,
+	0x9b, 0x3a, 0x73, 0xba, 0x67, 0xb8, 0x59, 0xc6, 0x97, 0xee, 0x6c, 0xed, 0x4b, 0x77, 0x36, 0xaa,
+	0x7c, 0xa8, 0xbf, 0xe3, 0xf9, 0xb0, 0x73, 0x86, 0x9b, 0x7a, 0xa5, 0xdf, 0x3a, 0x22, 0x60, 0x1e,
+	0x05, 0xa9, 0x36, 0xd3, 0x28, 0x56, 0x03, 0xde, 0xcf, 0xf8, 0x32, 0x59, 0xe4, 0x5a, 0x24, 0xe1,
+	0x76, 0xc5, 0x1b, 0x4f, 0x5f, 0x77, 0xdc, 0x93, 0x66, 0xe5, 0x7f, 0xd3, 0x86, 0xc1, 0xb1, 0x65,
+	0x90, 0xed, 0xd5, 0x27, 0x2a, 0x73, 0xc0, 0x41, 0x26, 0x77, 0x6d, 0x5f, 0x6f, 0x6f, 0xf5, 0xf5,
+	0x9b, 0xd0, 0x33, 0x23, 0xdf, 0xcd, 0x4c, 0x43, 0x79, 0xdf, 0x87, 0x81, 0x79, 0x1b, 0x95, 0x2d,
+	0x84, 0x8a, 0xe6, 0x94, 0x33, 0xfb, 0xbd, 0xd2, 0x76, 0xe8, 0xd7, 0x00, 0x65, 0x4b, 0x73, 0xc1,
+	0xe1, 0x11, 0xd9, 0x09, 0x46, 0x8d, 0xcd, 0xa6, 0x31, 0xa8, 0xfb, 0x5b, 0x83, 0xfa, 0x0e, 0x8c,
+	0x14, 0xea, 0x75, 0x52, 0x86, 0x11, 0x55, 0x3e, 0x8d, 0xc7, 0x71, 0x00, 0x06, 0x7a, 0x42, 0xb5,
+	0xfe, 0x31, 0x58, 0x2a, 0x4c, 0xf2, 0x53, 0x3b, 0x1f, 0x87, 0x06, 0x79, 0x91, 0x9f, 0xfa, 0x7f,
+	0x82, 0x3e, 0xb5, 0x04, 0x0a, 0xed, 0x15, 0xf7, 0xee, 0xfb, 0x30, 0xe3, 0xe2, 0x6d, 0xac, 0x75,
+	0x36, 0x2e, 0xd3, 0x1a, 0x37, 0xca, 0xde, 0x87, 0x99, 0xc2, 0xf3, 0x3c, 0x6a, 0x8a, 0x9a, 0xb8,
+	0x4c, 0x6b, 0xdc, 0x84, 0x64, 0x01, 0x13, 0x7b, 0xfe, 0x73, 0xa9, 0xcb, 0x5c, 0x6d, 0xbc, 0xdb,
+	0x5b, 0xc9, 0x31, 0x58, 0x58, 0xb6, 0x49, 0x0b, 0xff, 0xef, 0x2d, 0xd8, 0x3b, 0xe2, 0x5b, 0x3e,
+	0x61, 0x18, 0x91, 0x03, 0x37, 0xde, 0x4f, 0xe1, 0x46, 0x86, 0x17, 0xe1, 0xfb, 0xff, 0x0b, 0x18,
+	0x2b, 0xbc, 0x0c, 0x2f, 0x8e, 0x2e, 0xfd, 0x35, 0x70, 0x0f, 0xa6, 0x32, 0x93, 0xa5, 0x14, 0x25,
+	0xc6, 0x5b, 0xd6, 0x4c, 0x2a, 0xd8, 0x18, 0xf3, 0x09, 0x8c, 0xf1, 0x1d, 0x46, 0xeb, 0x12, 0xb7,
+	0x2c, 0xd9, 0xb5, 0xe0, 0xe5, 0xf0, 0xec, 0x34, 0xc3, 0xe3, 0xa7, 0x30, 0xa3, 0x0b, 0x60, 0xbc,
+	0x4e, 0x30, 0xfe, 0x7d, 0x71, 0xaa, 0x44, 0xcc, 0x37, 0x3b, 0xbb, 0xc1, 0x9a, 0x9b, 0x99, 0xa5,
+	0x68, 0x2d, 0x77, 0xf7, 0x47, 0x93, 0x70, 0x8e, 0x24, 0x5d, 0xb5, 0xfb, 0xca, 0x96, 0x12, 0x93,
+	0x0a, 0x36, 0xde, 0xfc, 0x35, 0x4c, 0xed, 0x29, 0xee, 0x54, 0xef, 0x01, 0x0c, 0xd6, 0x06, 0x72,
+	0x2e, 0xdd, 0x5b, 0x5c, 0x56, 0x29, 0xa8, 0x44, 0xfc, 0x5f, 0xc2, 0x4d, 0xee, 0x1a, 0x27, 0x4a,
+	0x64, 0xfa, 0x0d, 0xaa, 0x3f, 0xae, 0x64, 0x89, 0xb4, 0x77, 0x7a, 0xfb, 0xb0, 0x6b, 0x3b, 0xac,
+	0xd9, 0x43, 0x4d, 0xe3, 0x04, 0xd3, 0x66, 0x79, 0xfb, 0x14, 0x30, 0xe3, 0x77, 0x5f, 0x60, 0x7c,
+	0x8a, 0xea, 0x59, 0x56, 0xaa, 0xcd, 0x07, 0x97, 0xa3, 0xba, 0xb7, 0xda, 0x7b, 0x9e, 0xa1, 0x2e,
+	0xdd, 0x0f, 0x3b, 0x97, 0xee, 0x87, 0xfe, 0x13, 0xd8, 0xb5, 0x33, 0xcf, 0xb4, 0xb5, 0x2b, 0x46,
+	0xf1, 0xd6, 0x0a, 0xd6, 0xb1, 0x5d, 0xcf, 0xff, 0x5b, 0x0b, 0x26, 0x75, 0x6b, 0x0c, 0xc8, 0x4b,
+	0x74, 0x0d, 0x46, 0x45, 0xf7, 0xce, 0xb0, 0x6e, 0x93, 0x9d, 0x60, 0x54, 0xd0, 0xcd, 0xba, 0x30,
+	0x67, 0xed, 0xc3, 0x2e, 0xc9, 0x08, 0xbd, 0xdd, 0x2d, 0x0b, 0x54, 0x87, 0xda, 0x48, 0x7c, 0x01,
+	0x9e, 0xd3, 0x86, 0x45, 0x8c, 0xa3, 0x3a, 0xec, 0xf5, 0xf1, 0xa2, 0xa9, 0x78, 0x30, 0xd3, 0x0d,
+	0x8a, 0xbd, 0x77, 0x0f, 0xc6, 0xf6, 0xc2, 0xf2, 0x4c, 0x47, 0x2a, 0xbf, 0xb8, 0x34, 0x7e, 0x2a,
+	0x17, 0xf9, 0x01, 0xcc, 0x02, 0x3c, 0xc7, 0x6c, 0x8d, 0xaf, 0x56, 0x42, 0x19, 0xfd, 0xef, 0x98,
+	0x3b, 0x6c, 0x81, 0x2a, 0xc2, 0xea, 0x05, 0x90, 0x71, 0x71, 0x6c, 0x10, 0x72, 0x14, 0x29, 0x6e,
+	0xf9, 0xb6, 0x9f, 0x0a, 0x6d, 0xd9, 0xaf, 0x7b, 0xfc, 0x4f, 0xdc, 0xe7, 0xff, 0x1b, 0x00, 0x43,
+	0x1c, 0xd7, 0x37, 0xa3, 0x13, 0x00, 0x00,
 }
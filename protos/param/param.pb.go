@@ -0,0 +1,2933 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// source: protos/param/param.proto
+
+package param
+
+import (
+	fmt "fmt"
+	proto "github.com/golang/protobuf/proto"
+	math "math"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.ProtoPackageIsVersion2 // please upgrade the proto package
+
+type OptionalBool struct {
+	Value                bool     `protobuf:"varint,1,opt,name=value,proto3" json:"value,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *OptionalBool) Reset()         { *m = OptionalBool{} }
+func (m *OptionalBool) String() string { return proto.CompactTextString(m) }
+func (*OptionalBool) ProtoMessage()    {}
+func (*OptionalBool) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cebd89e7a20b4de6, []int{0}
+}
+
+func (m *OptionalBool) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_OptionalBool.Unmarshal(m, b)
+}
+func (m *OptionalBool) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_OptionalBool.Marshal(b, m, deterministic)
+}
+func (m *OptionalBool) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_OptionalBool.Merge(m, src)
+}
+func (m *OptionalBool) XXX_Size() int {
+	return xxx_messageInfo_OptionalBool.Size(m)
+}
+func (m *OptionalBool) XXX_DiscardUnknown() {
+	xxx_messageInfo_OptionalBool.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_OptionalBool proto.InternalMessageInfo
+
+func (m *OptionalBool) GetValue() bool {
+	if m != nil {
+		return m.Value
+	}
+	return false
+}
+
+// An amount of token in minor units, 1000000 minor units to a token. It
+// takes the place of the decimal number of tokens of JSON params.
+type TokenAmount struct {
+	MinorUnits           int64    `protobuf:"varint,1,opt,name=minor_units,json=minorUnits,proto3" json:"minor_units,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *TokenAmount) Reset()         { *m = TokenAmount{} }
+func (m *TokenAmount) String() string { return proto.CompactTextString(m) }
+func (*TokenAmount) ProtoMessage()    {}
+func (*TokenAmount) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cebd89e7a20b4de6, []int{1}
+}
+
+func (m *TokenAmount) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_TokenAmount.Unmarshal(m, b)
+}
+func (m *TokenAmount) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_TokenAmount.Marshal(b, m, deterministic)
+}
+func (m *TokenAmount) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TokenAmount.Merge(m, src)
+}
+func (m *TokenAmount) XXX_Size() int {
+	return xxx_messageInfo_TokenAmount.Size(m)
+}
+func (m *TokenAmount) XXX_DiscardUnknown() {
+	xxx_messageInfo_TokenAmount.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TokenAmount proto.InternalMessageInfo
+
+func (m *TokenAmount) GetMinorUnits() int64 {
+	if m != nil {
+		return m.MinorUnits
+	}
+	return 0
+}
+
+type InitNDIDParams struct {
+	NodeId               string   `protobuf:"bytes,1,opt,name=node_id,json=nodeId,proto3" json:"node_id,omitempty"`
+	PublicKey            string   `protobuf:"bytes,2,opt,name=public_key,json=publicKey,proto3" json:"public_key,omitempty"`
+	MasterPublicKey      string   `protobuf:"bytes,3,opt,name=master_public_key,json=masterPublicKey,proto3" json:"master_public_key,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *InitNDIDParams) Reset()         { *m = InitNDIDParams{} }
+func (m *InitNDIDParams) String() string { return proto.CompactTextString(m) }
+func (*InitNDIDParams) ProtoMessage()    {}
+func (*InitNDIDParams) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cebd89e7a20b4de6, []int{2}
+}
+
+func (m *InitNDIDParams) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_InitNDIDParams.Unmarshal(m, b)
+}
+func (m *InitNDIDParams) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_InitNDIDParams.Marshal(b, m, deterministic)
+}
+func (m *InitNDIDParams) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_InitNDIDParams.Merge(m, src)
+}
+func (m *InitNDIDParams) XXX_Size() int {
+	return xxx_messageInfo_InitNDIDParams.Size(m)
+}
+func (m *InitNDIDParams) XXX_DiscardUnknown() {
+	xxx_messageInfo_InitNDIDParams.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_InitNDIDParams proto.InternalMessageInfo
+
+func (m *InitNDIDParams) GetNodeId() string {
+	if m != nil {
+		return m.NodeId
+	}
+	return ""
+}
+
+func (m *InitNDIDParams) GetPublicKey() string {
+	if m != nil {
+		return m.PublicKey
+	}
+	return ""
+}
+
+func (m *InitNDIDParams) GetMasterPublicKey() string {
+	if m != nil {
+		return m.MasterPublicKey
+	}
+	return ""
+}
+
+type RegisterNodeParams struct {
+	NodeId               string   `protobuf:"bytes,1,opt,name=node_id,json=nodeId,proto3" json:"node_id,omitempty"`
+	PublicKey            string   `protobuf:"bytes,2,opt,name=public_key,json=publicKey,proto3" json:"public_key,omitempty"`
+	MasterPublicKey      string   `protobuf:"bytes,3,opt,name=master_public_key,json=masterPublicKey,proto3" json:"master_public_key,omitempty"`
+	NodeName             string   `protobuf:"bytes,4,opt,name=node_name,json=nodeName,proto3" json:"node_name,omitempty"`
+	Role                 string   `protobuf:"bytes,5,opt,name=role,proto3" json:"role,omitempty"`
+	MaxIal               float64  `protobuf:"fixed64,6,opt,name=max_ial,json=maxIal,proto3" json:"max_ial,omitempty"`
+	MaxAal               float64  `protobuf:"fixed64,7,opt,name=max_aal,json=maxAal,proto3" json:"max_aal,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *RegisterNodeParams) Reset()         { *m = RegisterNodeParams{} }
+func (m *RegisterNodeParams) String() string { return proto.CompactTextString(m) }
+func (*RegisterNodeParams) ProtoMessage()    {}
+func (*RegisterNodeParams) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cebd89e7a20b4de6, []int{3}
+}
+
+func (m *RegisterNodeParams) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_RegisterNodeParams.Unmarshal(m, b)
+}
+func (m *RegisterNodeParams) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_RegisterNodeParams.Marshal(b, m, deterministic)
+}
+func (m *RegisterNodeParams) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RegisterNodeParams.Merge(m, src)
+}
+func (m *RegisterNodeParams) XXX_Size() int {
+	return xxx_messageInfo_RegisterNodeParams.Size(m)
+}
+func (m *RegisterNodeParams) XXX_DiscardUnknown() {
+	xxx_messageInfo_RegisterNodeParams.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RegisterNodeParams proto.InternalMessageInfo
+
+func (m *RegisterNodeParams) GetNodeId() string {
+	if m != nil {
+		return m.NodeId
+	}
+	return ""
+}
+
+func (m *RegisterNodeParams) GetPublicKey() string {
+	if m != nil {
+		return m.PublicKey
+	}
+	return ""
+}
+
+func (m *RegisterNodeParams) GetMasterPublicKey() string {
+	if m != nil {
+		return m.MasterPublicKey
+	}
+	return ""
+}
+
+func (m *RegisterNodeParams) GetNodeName() string {
+	if m != nil {
+		return m.NodeName
+	}
+	return ""
+}
+
+func (m *RegisterNodeParams) GetRole() string {
+	if m != nil {
+		return m.Role
+	}
+	return ""
+}
+
+func (m *RegisterNodeParams) GetMaxIal() float64 {
+	if m != nil {
+		return m.MaxIal
+	}
+	return 0
+}
+
+func (m *RegisterNodeParams) GetMaxAal() float64 {
+	if m != nil {
+		return m.MaxAal
+	}
+	return 0
+}
+
+type NodeTokenParams struct {
+	NodeId               string       `protobuf:"bytes,1,opt,name=node_id,json=nodeId,proto3" json:"node_id,omitempty"`
+	Amount               *TokenAmount `protobuf:"bytes,2,opt,name=amount,proto3" json:"amount,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}     `json:"-"`
+	XXX_unrecognized     []byte       `json:"-"`
+	XXX_sizecache        int32        `json:"-"`
+}
+
+func (m *NodeTokenParams) Reset()         { *m = NodeTokenParams{} }
+func (m *NodeTokenParams) String() string { return proto.CompactTextString(m) }
+func (*NodeTokenParams) ProtoMessage()    {}
+func (*NodeTokenParams) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cebd89e7a20b4de6, []int{4}
+}
+
+func (m *NodeTokenParams) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_NodeTokenParams.Unmarshal(m, b)
+}
+func (m *NodeTokenParams) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_NodeTokenParams.Marshal(b, m, deterministic)
+}
+func (m *NodeTokenParams) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_NodeTokenParams.Merge(m, src)
+}
+func (m *NodeTokenParams) XXX_Size() int {
+	return xxx_messageInfo_NodeTokenParams.Size(m)
+}
+func (m *NodeTokenParams) XXX_DiscardUnknown() {
+	xxx_messageInfo_NodeTokenParams.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_NodeTokenParams proto.InternalMessageInfo
+
+func (m *NodeTokenParams) GetNodeId() string {
+	if m != nil {
+		return m.NodeId
+	}
+	return ""
+}
+
+func (m *NodeTokenParams) GetAmount() *TokenAmount {
+	if m != nil {
+		return m.Amount
+	}
+	return nil
+}
+
+type SetPriceFuncParams struct {
+	Func                 string       `protobuf:"bytes,1,opt,name=func,proto3" json:"func,omitempty"`
+	Price                *TokenAmount `protobuf:"bytes,2,opt,name=price,proto3" json:"price,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}     `json:"-"`
+	XXX_unrecognized     []byte       `json:"-"`
+	XXX_sizecache        int32        `json:"-"`
+}
+
+func (m *SetPriceFuncParams) Reset()         { *m = SetPriceFuncParams{} }
+func (m *SetPriceFuncParams) String() string { return proto.CompactTextString(m) }
+func (*SetPriceFuncParams) ProtoMessage()    {}
+func (*SetPriceFuncParams) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cebd89e7a20b4de6, []int{5}
+}
+
+func (m *SetPriceFuncParams) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SetPriceFuncParams.Unmarshal(m, b)
+}
+func (m *SetPriceFuncParams) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SetPriceFuncParams.Marshal(b, m, deterministic)
+}
+func (m *SetPriceFuncParams) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SetPriceFuncParams.Merge(m, src)
+}
+func (m *SetPriceFuncParams) XXX_Size() int {
+	return xxx_messageInfo_SetPriceFuncParams.Size(m)
+}
+func (m *SetPriceFuncParams) XXX_DiscardUnknown() {
+	xxx_messageInfo_SetPriceFuncParams.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SetPriceFuncParams proto.InternalMessageInfo
+
+func (m *SetPriceFuncParams) GetFunc() string {
+	if m != nil {
+		return m.Func
+	}
+	return ""
+}
+
+func (m *SetPriceFuncParams) GetPrice() *TokenAmount {
+	if m != nil {
+		return m.Price
+	}
+	return nil
+}
+
+type AddNamespaceParams struct {
+	Namespace            string   `protobuf:"bytes,1,opt,name=namespace,proto3" json:"namespace,omitempty"`
+	Description          string   `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *AddNamespaceParams) Reset()         { *m = AddNamespaceParams{} }
+func (m *AddNamespaceParams) String() string { return proto.CompactTextString(m) }
+func (*AddNamespaceParams) ProtoMessage()    {}
+func (*AddNamespaceParams) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cebd89e7a20b4de6, []int{6}
+}
+
+func (m *AddNamespaceParams) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_AddNamespaceParams.Unmarshal(m, b)
+}
+func (m *AddNamespaceParams) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_AddNamespaceParams.Marshal(b, m, deterministic)
+}
+func (m *AddNamespaceParams) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AddNamespaceParams.Merge(m, src)
+}
+func (m *AddNamespaceParams) XXX_Size() int {
+	return xxx_messageInfo_AddNamespaceParams.Size(m)
+}
+func (m *AddNamespaceParams) XXX_DiscardUnknown() {
+	xxx_messageInfo_AddNamespaceParams.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AddNamespaceParams proto.InternalMessageInfo
+
+func (m *AddNamespaceParams) GetNamespace() string {
+	if m != nil {
+		return m.Namespace
+	}
+	return ""
+}
+
+func (m *AddNamespaceParams) GetDescription() string {
+	if m != nil {
+		return m.Description
+	}
+	return ""
+}
+
+type SetValidatorParams struct {
+	PublicKey            string   `protobuf:"bytes,1,opt,name=public_key,json=publicKey,proto3" json:"public_key,omitempty"`
+	Power                int64    `protobuf:"varint,2,opt,name=power,proto3" json:"power,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *SetValidatorParams) Reset()         { *m = SetValidatorParams{} }
+func (m *SetValidatorParams) String() string { return proto.CompactTextString(m) }
+func (*SetValidatorParams) ProtoMessage()    {}
+func (*SetValidatorParams) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cebd89e7a20b4de6, []int{7}
+}
+
+func (m *SetValidatorParams) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SetValidatorParams.Unmarshal(m, b)
+}
+func (m *SetValidatorParams) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SetValidatorParams.Marshal(b, m, deterministic)
+}
+func (m *SetValidatorParams) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SetValidatorParams.Merge(m, src)
+}
+func (m *SetValidatorParams) XXX_Size() int {
+	return xxx_messageInfo_SetValidatorParams.Size(m)
+}
+func (m *SetValidatorParams) XXX_DiscardUnknown() {
+	xxx_messageInfo_SetValidatorParams.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SetValidatorParams proto.InternalMessageInfo
+
+func (m *SetValidatorParams) GetPublicKey() string {
+	if m != nil {
+		return m.PublicKey
+	}
+	return ""
+}
+
+func (m *SetValidatorParams) GetPower() int64 {
+	if m != nil {
+		return m.Power
+	}
+	return 0
+}
+
+type ServiceParams struct {
+	ServiceId            string   `protobuf:"bytes,1,opt,name=service_id,json=serviceId,proto3" json:"service_id,omitempty"`
+	ServiceName          string   `protobuf:"bytes,2,opt,name=service_name,json=serviceName,proto3" json:"service_name,omitempty"`
+	DataSchema           string   `protobuf:"bytes,3,opt,name=data_schema,json=dataSchema,proto3" json:"data_schema,omitempty"`
+	DataSchemaVersion    string   `protobuf:"bytes,4,opt,name=data_schema_version,json=dataSchemaVersion,proto3" json:"data_schema_version,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ServiceParams) Reset()         { *m = ServiceParams{} }
+func (m *ServiceParams) String() string { return proto.CompactTextString(m) }
+func (*ServiceParams) ProtoMessage()    {}
+func (*ServiceParams) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cebd89e7a20b4de6, []int{8}
+}
+
+func (m *ServiceParams) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ServiceParams.Unmarshal(m, b)
+}
+func (m *ServiceParams) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ServiceParams.Marshal(b, m, deterministic)
+}
+func (m *ServiceParams) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ServiceParams.Merge(m, src)
+}
+func (m *ServiceParams) XXX_Size() int {
+	return xxx_messageInfo_ServiceParams.Size(m)
+}
+func (m *ServiceParams) XXX_DiscardUnknown() {
+	xxx_messageInfo_ServiceParams.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ServiceParams proto.InternalMessageInfo
+
+func (m *ServiceParams) GetServiceId() string {
+	if m != nil {
+		return m.ServiceId
+	}
+	return ""
+}
+
+func (m *ServiceParams) GetServiceName() string {
+	if m != nil {
+		return m.ServiceName
+	}
+	return ""
+}
+
+func (m *ServiceParams) GetDataSchema() string {
+	if m != nil {
+		return m.DataSchema
+	}
+	return ""
+}
+
+func (m *ServiceParams) GetDataSchemaVersion() string {
+	if m != nil {
+		return m.DataSchemaVersion
+	}
+	return ""
+}
+
+type UpdateNodeByNDIDParams struct {
+	NodeId               string   `protobuf:"bytes,1,opt,name=node_id,json=nodeId,proto3" json:"node_id,omitempty"`
+	MaxIal               float64  `protobuf:"fixed64,2,opt,name=max_ial,json=maxIal,proto3" json:"max_ial,omitempty"`
+	MaxAal               float64  `protobuf:"fixed64,3,opt,name=max_aal,json=maxAal,proto3" json:"max_aal,omitempty"`
+	NodeName             string   `protobuf:"bytes,4,opt,name=node_name,json=nodeName,proto3" json:"node_name,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *UpdateNodeByNDIDParams) Reset()         { *m = UpdateNodeByNDIDParams{} }
+func (m *UpdateNodeByNDIDParams) String() string { return proto.CompactTextString(m) }
+func (*UpdateNodeByNDIDParams) ProtoMessage()    {}
+func (*UpdateNodeByNDIDParams) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cebd89e7a20b4de6, []int{9}
+}
+
+func (m *UpdateNodeByNDIDParams) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_UpdateNodeByNDIDParams.Unmarshal(m, b)
+}
+func (m *UpdateNodeByNDIDParams) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_UpdateNodeByNDIDParams.Marshal(b, m, deterministic)
+}
+func (m *UpdateNodeByNDIDParams) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UpdateNodeByNDIDParams.Merge(m, src)
+}
+func (m *UpdateNodeByNDIDParams) XXX_Size() int {
+	return xxx_messageInfo_UpdateNodeByNDIDParams.Size(m)
+}
+func (m *UpdateNodeByNDIDParams) XXX_DiscardUnknown() {
+	xxx_messageInfo_UpdateNodeByNDIDParams.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_UpdateNodeByNDIDParams proto.InternalMessageInfo
+
+func (m *UpdateNodeByNDIDParams) GetNodeId() string {
+	if m != nil {
+		return m.NodeId
+	}
+	return ""
+}
+
+func (m *UpdateNodeByNDIDParams) GetMaxIal() float64 {
+	if m != nil {
+		return m.MaxIal
+	}
+	return 0
+}
+
+func (m *UpdateNodeByNDIDParams) GetMaxAal() float64 {
+	if m != nil {
+		return m.MaxAal
+	}
+	return 0
+}
+
+func (m *UpdateNodeByNDIDParams) GetNodeName() string {
+	if m != nil {
+		return m.NodeName
+	}
+	return ""
+}
+
+type ServiceDestinationByNDIDParams struct {
+	ServiceId            string   `protobuf:"bytes,1,opt,name=service_id,json=serviceId,proto3" json:"service_id,omitempty"`
+	NodeId               string   `protobuf:"bytes,2,opt,name=node_id,json=nodeId,proto3" json:"node_id,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ServiceDestinationByNDIDParams) Reset()         { *m = ServiceDestinationByNDIDParams{} }
+func (m *ServiceDestinationByNDIDParams) String() string { return proto.CompactTextString(m) }
+func (*ServiceDestinationByNDIDParams) ProtoMessage()    {}
+func (*ServiceDestinationByNDIDParams) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cebd89e7a20b4de6, []int{10}
+}
+
+func (m *ServiceDestinationByNDIDParams) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ServiceDestinationByNDIDParams.Unmarshal(m, b)
+}
+func (m *ServiceDestinationByNDIDParams) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ServiceDestinationByNDIDParams.Marshal(b, m, deterministic)
+}
+func (m *ServiceDestinationByNDIDParams) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ServiceDestinationByNDIDParams.Merge(m, src)
+}
+func (m *ServiceDestinationByNDIDParams) XXX_Size() int {
+	return xxx_messageInfo_ServiceDestinationByNDIDParams.Size(m)
+}
+func (m *ServiceDestinationByNDIDParams) XXX_DiscardUnknown() {
+	xxx_messageInfo_ServiceDestinationByNDIDParams.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ServiceDestinationByNDIDParams proto.InternalMessageInfo
+
+func (m *ServiceDestinationByNDIDParams) GetServiceId() string {
+	if m != nil {
+		return m.ServiceId
+	}
+	return ""
+}
+
+func (m *ServiceDestinationByNDIDParams) GetNodeId() string {
+	if m != nil {
+		return m.NodeId
+	}
+	return ""
+}
+
+type NodeIDParams struct {
+	NodeId               string   `protobuf:"bytes,1,opt,name=node_id,json=nodeId,proto3" json:"node_id,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *NodeIDParams) Reset()         { *m = NodeIDParams{} }
+func (m *NodeIDParams) String() string { return proto.CompactTextString(m) }
+func (*NodeIDParams) ProtoMessage()    {}
+func (*NodeIDParams) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cebd89e7a20b4de6, []int{11}
+}
+
+func (m *NodeIDParams) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_NodeIDParams.Unmarshal(m, b)
+}
+func (m *NodeIDParams) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_NodeIDParams.Marshal(b, m, deterministic)
+}
+func (m *NodeIDParams) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_NodeIDParams.Merge(m, src)
+}
+func (m *NodeIDParams) XXX_Size() int {
+	return xxx_messageInfo_NodeIDParams.Size(m)
+}
+func (m *NodeIDParams) XXX_DiscardUnknown() {
+	xxx_messageInfo_NodeIDParams.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_NodeIDParams proto.InternalMessageInfo
+
+func (m *NodeIDParams) GetNodeId() string {
+	if m != nil {
+		return m.NodeId
+	}
+	return ""
+}
+
+type NamespaceParams struct {
+	Namespace            string   `protobuf:"bytes,1,opt,name=namespace,proto3" json:"namespace,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *NamespaceParams) Reset()         { *m = NamespaceParams{} }
+func (m *NamespaceParams) String() string { return proto.CompactTextString(m) }
+func (*NamespaceParams) ProtoMessage()    {}
+func (*NamespaceParams) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cebd89e7a20b4de6, []int{12}
+}
+
+func (m *NamespaceParams) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_NamespaceParams.Unmarshal(m, b)
+}
+func (m *NamespaceParams) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_NamespaceParams.Marshal(b, m, deterministic)
+}
+func (m *NamespaceParams) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_NamespaceParams.Merge(m, src)
+}
+func (m *NamespaceParams) XXX_Size() int {
+	return xxx_messageInfo_NamespaceParams.Size(m)
+}
+func (m *NamespaceParams) XXX_DiscardUnknown() {
+	xxx_messageInfo_NamespaceParams.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_NamespaceParams proto.InternalMessageInfo
+
+func (m *NamespaceParams) GetNamespace() string {
+	if m != nil {
+		return m.Namespace
+	}
+	return ""
+}
+
+type ServiceIDParams struct {
+	ServiceId            string   `protobuf:"bytes,1,opt,name=service_id,json=serviceId,proto3" json:"service_id,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ServiceIDParams) Reset()         { *m = ServiceIDParams{} }
+func (m *ServiceIDParams) String() string { return proto.CompactTextString(m) }
+func (*ServiceIDParams) ProtoMessage()    {}
+func (*ServiceIDParams) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cebd89e7a20b4de6, []int{13}
+}
+
+func (m *ServiceIDParams) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ServiceIDParams.Unmarshal(m, b)
+}
+func (m *ServiceIDParams) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ServiceIDParams.Marshal(b, m, deterministic)
+}
+func (m *ServiceIDParams) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ServiceIDParams.Merge(m, src)
+}
+func (m *ServiceIDParams) XXX_Size() int {
+	return xxx_messageInfo_ServiceIDParams.Size(m)
+}
+func (m *ServiceIDParams) XXX_DiscardUnknown() {
+	xxx_messageInfo_ServiceIDParams.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ServiceIDParams proto.InternalMessageInfo
+
+func (m *ServiceIDParams) GetServiceId() string {
+	if m != nil {
+		return m.ServiceId
+	}
+	return ""
+}
+
+type UserParam struct {
+	HashId               string   `protobuf:"bytes,1,opt,name=hash_id,json=hashId,proto3" json:"hash_id,omitempty"`
+	Ial                  float64  `protobuf:"fixed64,2,opt,name=ial,proto3" json:"ial,omitempty"`
+	First                bool     `protobuf:"varint,3,opt,name=first,proto3" json:"first,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *UserParam) Reset()         { *m = UserParam{} }
+func (m *UserParam) String() string { return proto.CompactTextString(m) }
+func (*UserParam) ProtoMessage()    {}
+func (*UserParam) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cebd89e7a20b4de6, []int{14}
+}
+
+func (m *UserParam) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_UserParam.Unmarshal(m, b)
+}
+func (m *UserParam) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_UserParam.Marshal(b, m, deterministic)
+}
+func (m *UserParam) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UserParam.Merge(m, src)
+}
+func (m *UserParam) XXX_Size() int {
+	return xxx_messageInfo_UserParam.Size(m)
+}
+func (m *UserParam) XXX_DiscardUnknown() {
+	xxx_messageInfo_UserParam.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_UserParam proto.InternalMessageInfo
+
+func (m *UserParam) GetHashId() string {
+	if m != nil {
+		return m.HashId
+	}
+	return ""
+}
+
+func (m *UserParam) GetIal() float64 {
+	if m != nil {
+		return m.Ial
+	}
+	return 0
+}
+
+func (m *UserParam) GetFirst() bool {
+	if m != nil {
+		return m.First
+	}
+	return false
+}
+
+type RegisterIdentityParams struct {
+	Users                []*UserParam `protobuf:"bytes,1,rep,name=users,proto3" json:"users,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}     `json:"-"`
+	XXX_unrecognized     []byte       `json:"-"`
+	XXX_sizecache        int32        `json:"-"`
+}
+
+func (m *RegisterIdentityParams) Reset()         { *m = RegisterIdentityParams{} }
+func (m *RegisterIdentityParams) String() string { return proto.CompactTextString(m) }
+func (*RegisterIdentityParams) ProtoMessage()    {}
+func (*RegisterIdentityParams) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cebd89e7a20b4de6, []int{15}
+}
+
+func (m *RegisterIdentityParams) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_RegisterIdentityParams.Unmarshal(m, b)
+}
+func (m *RegisterIdentityParams) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_RegisterIdentityParams.Marshal(b, m, deterministic)
+}
+func (m *RegisterIdentityParams) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RegisterIdentityParams.Merge(m, src)
+}
+func (m *RegisterIdentityParams) XXX_Size() int {
+	return xxx_messageInfo_RegisterIdentityParams.Size(m)
+}
+func (m *RegisterIdentityParams) XXX_DiscardUnknown() {
+	xxx_messageInfo_RegisterIdentityParams.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RegisterIdentityParams proto.InternalMessageInfo
+
+func (m *RegisterIdentityParams) GetUsers() []*UserParam {
+	if m != nil {
+		return m.Users
+	}
+	return nil
+}
+
+type AccessorMethodParams struct {
+	AccessorId           string   `protobuf:"bytes,1,opt,name=accessor_id,json=accessorId,proto3" json:"accessor_id,omitempty"`
+	AccessorType         string   `protobuf:"bytes,2,opt,name=accessor_type,json=accessorType,proto3" json:"accessor_type,omitempty"`
+	AccessorPublicKey    string   `protobuf:"bytes,3,opt,name=accessor_public_key,json=accessorPublicKey,proto3" json:"accessor_public_key,omitempty"`
+	AccessorGroupId      string   `protobuf:"bytes,4,opt,name=accessor_group_id,json=accessorGroupId,proto3" json:"accessor_group_id,omitempty"`
+	RequestId            string   `protobuf:"bytes,5,opt,name=request_id,json=requestId,proto3" json:"request_id,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *AccessorMethodParams) Reset()         { *m = AccessorMethodParams{} }
+func (m *AccessorMethodParams) String() string { return proto.CompactTextString(m) }
+func (*AccessorMethodParams) ProtoMessage()    {}
+func (*AccessorMethodParams) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cebd89e7a20b4de6, []int{16}
+}
+
+func (m *AccessorMethodParams) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_AccessorMethodParams.Unmarshal(m, b)
+}
+func (m *AccessorMethodParams) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_AccessorMethodParams.Marshal(b, m, deterministic)
+}
+func (m *AccessorMethodParams) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AccessorMethodParams.Merge(m, src)
+}
+func (m *AccessorMethodParams) XXX_Size() int {
+	return xxx_messageInfo_AccessorMethodParams.Size(m)
+}
+func (m *AccessorMethodParams) XXX_DiscardUnknown() {
+	xxx_messageInfo_AccessorMethodParams.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AccessorMethodParams proto.InternalMessageInfo
+
+func (m *AccessorMethodParams) GetAccessorId() string {
+	if m != nil {
+		return m.AccessorId
+	}
+	return ""
+}
+
+func (m *AccessorMethodParams) GetAccessorType() string {
+	if m != nil {
+		return m.AccessorType
+	}
+	return ""
+}
+
+func (m *AccessorMethodParams) GetAccessorPublicKey() string {
+	if m != nil {
+		return m.AccessorPublicKey
+	}
+	return ""
+}
+
+func (m *AccessorMethodParams) GetAccessorGroupId() string {
+	if m != nil {
+		return m.AccessorGroupId
+	}
+	return ""
+}
+
+func (m *AccessorMethodParams) GetRequestId() string {
+	if m != nil {
+		return m.RequestId
+	}
+	return ""
+}
+
+type CreateIdpResponseParams struct {
+	RequestId            string   `protobuf:"bytes,1,opt,name=request_id,json=requestId,proto3" json:"request_id,omitempty"`
+	Ial                  float64  `protobuf:"fixed64,2,opt,name=ial,proto3" json:"ial,omitempty"`
+	Aal                  float64  `protobuf:"fixed64,3,opt,name=aal,proto3" json:"aal,omitempty"`
+	Status               string   `protobuf:"bytes,4,opt,name=status,proto3" json:"status,omitempty"`
+	Signature            string   `protobuf:"bytes,5,opt,name=signature,proto3" json:"signature,omitempty"`
+	IdentityProof        string   `protobuf:"bytes,6,opt,name=identity_proof,json=identityProof,proto3" json:"identity_proof,omitempty"`
+	PrivateProofHash     string   `protobuf:"bytes,7,opt,name=private_proof_hash,json=privateProofHash,proto3" json:"private_proof_hash,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *CreateIdpResponseParams) Reset()         { *m = CreateIdpResponseParams{} }
+func (m *CreateIdpResponseParams) String() string { return proto.CompactTextString(m) }
+func (*CreateIdpResponseParams) ProtoMessage()    {}
+func (*CreateIdpResponseParams) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cebd89e7a20b4de6, []int{17}
+}
+
+func (m *CreateIdpResponseParams) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CreateIdpResponseParams.Unmarshal(m, b)
+}
+func (m *CreateIdpResponseParams) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CreateIdpResponseParams.Marshal(b, m, deterministic)
+}
+func (m *CreateIdpResponseParams) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CreateIdpResponseParams.Merge(m, src)
+}
+func (m *CreateIdpResponseParams) XXX_Size() int {
+	return xxx_messageInfo_CreateIdpResponseParams.Size(m)
+}
+func (m *CreateIdpResponseParams) XXX_DiscardUnknown() {
+	xxx_messageInfo_CreateIdpResponseParams.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CreateIdpResponseParams proto.InternalMessageInfo
+
+func (m *CreateIdpResponseParams) GetRequestId() string {
+	if m != nil {
+		return m.RequestId
+	}
+	return ""
+}
+
+func (m *CreateIdpResponseParams) GetIal() float64 {
+	if m != nil {
+		return m.Ial
+	}
+	return 0
+}
+
+func (m *CreateIdpResponseParams) GetAal() float64 {
+	if m != nil {
+		return m.Aal
+	}
+	return 0
+}
+
+func (m *CreateIdpResponseParams) GetStatus() string {
+	if m != nil {
+		return m.Status
+	}
+	return ""
+}
+
+func (m *CreateIdpResponseParams) GetSignature() string {
+	if m != nil {
+		return m.Signature
+	}
+	return ""
+}
+
+func (m *CreateIdpResponseParams) GetIdentityProof() string {
+	if m != nil {
+		return m.IdentityProof
+	}
+	return ""
+}
+
+func (m *CreateIdpResponseParams) GetPrivateProofHash() string {
+	if m != nil {
+		return m.PrivateProofHash
+	}
+	return ""
+}
+
+type RegisterAccessorParams struct {
+	AccessorId           string   `protobuf:"bytes,1,opt,name=accessor_id,json=accessorId,proto3" json:"accessor_id,omitempty"`
+	AccessorType         string   `protobuf:"bytes,2,opt,name=accessor_type,json=accessorType,proto3" json:"accessor_type,omitempty"`
+	AccessorPublicKey    string   `protobuf:"bytes,3,opt,name=accessor_public_key,json=accessorPublicKey,proto3" json:"accessor_public_key,omitempty"`
+	AccessorGroupId      string   `protobuf:"bytes,4,opt,name=accessor_group_id,json=accessorGroupId,proto3" json:"accessor_group_id,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *RegisterAccessorParams) Reset()         { *m = RegisterAccessorParams{} }
+func (m *RegisterAccessorParams) String() string { return proto.CompactTextString(m) }
+func (*RegisterAccessorParams) ProtoMessage()    {}
+func (*RegisterAccessorParams) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cebd89e7a20b4de6, []int{18}
+}
+
+func (m *RegisterAccessorParams) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_RegisterAccessorParams.Unmarshal(m, b)
+}
+func (m *RegisterAccessorParams) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_RegisterAccessorParams.Marshal(b, m, deterministic)
+}
+func (m *RegisterAccessorParams) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RegisterAccessorParams.Merge(m, src)
+}
+func (m *RegisterAccessorParams) XXX_Size() int {
+	return xxx_messageInfo_RegisterAccessorParams.Size(m)
+}
+func (m *RegisterAccessorParams) XXX_DiscardUnknown() {
+	xxx_messageInfo_RegisterAccessorParams.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RegisterAccessorParams proto.InternalMessageInfo
+
+func (m *RegisterAccessorParams) GetAccessorId() string {
+	if m != nil {
+		return m.AccessorId
+	}
+	return ""
+}
+
+func (m *RegisterAccessorParams) GetAccessorType() string {
+	if m != nil {
+		return m.AccessorType
+	}
+	return ""
+}
+
+func (m *RegisterAccessorParams) GetAccessorPublicKey() string {
+	if m != nil {
+		return m.AccessorPublicKey
+	}
+	return ""
+}
+
+func (m *RegisterAccessorParams) GetAccessorGroupId() string {
+	if m != nil {
+		return m.AccessorGroupId
+	}
+	return ""
+}
+
+type UpdateIdentityParams struct {
+	HashId               string   `protobuf:"bytes,1,opt,name=hash_id,json=hashId,proto3" json:"hash_id,omitempty"`
+	Ial                  float64  `protobuf:"fixed64,2,opt,name=ial,proto3" json:"ial,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *UpdateIdentityParams) Reset()         { *m = UpdateIdentityParams{} }
+func (m *UpdateIdentityParams) String() string { return proto.CompactTextString(m) }
+func (*UpdateIdentityParams) ProtoMessage()    {}
+func (*UpdateIdentityParams) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cebd89e7a20b4de6, []int{19}
+}
+
+func (m *UpdateIdentityParams) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_UpdateIdentityParams.Unmarshal(m, b)
+}
+func (m *UpdateIdentityParams) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_UpdateIdentityParams.Marshal(b, m, deterministic)
+}
+func (m *UpdateIdentityParams) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UpdateIdentityParams.Merge(m, src)
+}
+func (m *UpdateIdentityParams) XXX_Size() int {
+	return xxx_messageInfo_UpdateIdentityParams.Size(m)
+}
+func (m *UpdateIdentityParams) XXX_DiscardUnknown() {
+	xxx_messageInfo_UpdateIdentityParams.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_UpdateIdentityParams proto.InternalMessageInfo
+
+func (m *UpdateIdentityParams) GetHashId() string {
+	if m != nil {
+		return m.HashId
+	}
+	return ""
+}
+
+func (m *UpdateIdentityParams) GetIal() float64 {
+	if m != nil {
+		return m.Ial
+	}
+	return 0
+}
+
+type DeclareIdentityProofParams struct {
+	IdentityProof        string   `protobuf:"bytes,1,opt,name=identity_proof,json=identityProof,proto3" json:"identity_proof,omitempty"`
+	RequestId            string   `protobuf:"bytes,2,opt,name=request_id,json=requestId,proto3" json:"request_id,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *DeclareIdentityProofParams) Reset()         { *m = DeclareIdentityProofParams{} }
+func (m *DeclareIdentityProofParams) String() string { return proto.CompactTextString(m) }
+func (*DeclareIdentityProofParams) ProtoMessage()    {}
+func (*DeclareIdentityProofParams) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cebd89e7a20b4de6, []int{20}
+}
+
+func (m *DeclareIdentityProofParams) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_DeclareIdentityProofParams.Unmarshal(m, b)
+}
+func (m *DeclareIdentityProofParams) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_DeclareIdentityProofParams.Marshal(b, m, deterministic)
+}
+func (m *DeclareIdentityProofParams) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DeclareIdentityProofParams.Merge(m, src)
+}
+func (m *DeclareIdentityProofParams) XXX_Size() int {
+	return xxx_messageInfo_DeclareIdentityProofParams.Size(m)
+}
+func (m *DeclareIdentityProofParams) XXX_DiscardUnknown() {
+	xxx_messageInfo_DeclareIdentityProofParams.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DeclareIdentityProofParams proto.InternalMessageInfo
+
+func (m *DeclareIdentityProofParams) GetIdentityProof() string {
+	if m != nil {
+		return m.IdentityProof
+	}
+	return ""
+}
+
+func (m *DeclareIdentityProofParams) GetRequestId() string {
+	if m != nil {
+		return m.RequestId
+	}
+	return ""
+}
+
+type SignDataParams struct {
+	ServiceId            string   `protobuf:"bytes,1,opt,name=service_id,json=serviceId,proto3" json:"service_id,omitempty"`
+	RequestId            string   `protobuf:"bytes,2,opt,name=request_id,json=requestId,proto3" json:"request_id,omitempty"`
+	Signature            string   `protobuf:"bytes,3,opt,name=signature,proto3" json:"signature,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *SignDataParams) Reset()         { *m = SignDataParams{} }
+func (m *SignDataParams) String() string { return proto.CompactTextString(m) }
+func (*SignDataParams) ProtoMessage()    {}
+func (*SignDataParams) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cebd89e7a20b4de6, []int{21}
+}
+
+func (m *SignDataParams) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SignDataParams.Unmarshal(m, b)
+}
+func (m *SignDataParams) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SignDataParams.Marshal(b, m, deterministic)
+}
+func (m *SignDataParams) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SignDataParams.Merge(m, src)
+}
+func (m *SignDataParams) XXX_Size() int {
+	return xxx_messageInfo_SignDataParams.Size(m)
+}
+func (m *SignDataParams) XXX_DiscardUnknown() {
+	xxx_messageInfo_SignDataParams.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SignDataParams proto.InternalMessageInfo
+
+func (m *SignDataParams) GetServiceId() string {
+	if m != nil {
+		return m.ServiceId
+	}
+	return ""
+}
+
+func (m *SignDataParams) GetRequestId() string {
+	if m != nil {
+		return m.RequestId
+	}
+	return ""
+}
+
+func (m *SignDataParams) GetSignature() string {
+	if m != nil {
+		return m.Signature
+	}
+	return ""
+}
+
+type ServiceDestinationParams struct {
+	ServiceId            string   `protobuf:"bytes,1,opt,name=service_id,json=serviceId,proto3" json:"service_id,omitempty"`
+	MinIal               float64  `protobuf:"fixed64,2,opt,name=min_ial,json=minIal,proto3" json:"min_ial,omitempty"`
+	MinAal               float64  `protobuf:"fixed64,3,opt,name=min_aal,json=minAal,proto3" json:"min_aal,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ServiceDestinationParams) Reset()         { *m = ServiceDestinationParams{} }
+func (m *ServiceDestinationParams) String() string { return proto.CompactTextString(m) }
+func (*ServiceDestinationParams) ProtoMessage()    {}
+func (*ServiceDestinationParams) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cebd89e7a20b4de6, []int{22}
+}
+
+func (m *ServiceDestinationParams) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ServiceDestinationParams.Unmarshal(m, b)
+}
+func (m *ServiceDestinationParams) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ServiceDestinationParams.Marshal(b, m, deterministic)
+}
+func (m *ServiceDestinationParams) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ServiceDestinationParams.Merge(m, src)
+}
+func (m *ServiceDestinationParams) XXX_Size() int {
+	return xxx_messageInfo_ServiceDestinationParams.Size(m)
+}
+func (m *ServiceDestinationParams) XXX_DiscardUnknown() {
+	xxx_messageInfo_ServiceDestinationParams.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ServiceDestinationParams proto.InternalMessageInfo
+
+func (m *ServiceDestinationParams) GetServiceId() string {
+	if m != nil {
+		return m.ServiceId
+	}
+	return ""
+}
+
+func (m *ServiceDestinationParams) GetMinIal() float64 {
+	if m != nil {
+		return m.MinIal
+	}
+	return 0
+}
+
+func (m *ServiceDestinationParams) GetMinAal() float64 {
+	if m != nil {
+		return m.MinAal
+	}
+	return 0
+}
+
+type DataRequestParam struct {
+	ServiceId            string   `protobuf:"bytes,1,opt,name=service_id,json=serviceId,proto3" json:"service_id,omitempty"`
+	AsIdList             []string `protobuf:"bytes,2,rep,name=as_id_list,json=asIdList,proto3" json:"as_id_list,omitempty"`
+	MinAs                int64    `protobuf:"varint,3,opt,name=min_as,json=minAs,proto3" json:"min_as,omitempty"`
+	RequestParamsHash    string   `protobuf:"bytes,4,opt,name=request_params_hash,json=requestParamsHash,proto3" json:"request_params_hash,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *DataRequestParam) Reset()         { *m = DataRequestParam{} }
+func (m *DataRequestParam) String() string { return proto.CompactTextString(m) }
+func (*DataRequestParam) ProtoMessage()    {}
+func (*DataRequestParam) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cebd89e7a20b4de6, []int{23}
+}
+
+func (m *DataRequestParam) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_DataRequestParam.Unmarshal(m, b)
+}
+func (m *DataRequestParam) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_DataRequestParam.Marshal(b, m, deterministic)
+}
+func (m *DataRequestParam) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DataRequestParam.Merge(m, src)
+}
+func (m *DataRequestParam) XXX_Size() int {
+	return xxx_messageInfo_DataRequestParam.Size(m)
+}
+func (m *DataRequestParam) XXX_DiscardUnknown() {
+	xxx_messageInfo_DataRequestParam.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DataRequestParam proto.InternalMessageInfo
+
+func (m *DataRequestParam) GetServiceId() string {
+	if m != nil {
+		return m.ServiceId
+	}
+	return ""
+}
+
+func (m *DataRequestParam) GetAsIdList() []string {
+	if m != nil {
+		return m.AsIdList
+	}
+	return nil
+}
+
+func (m *DataRequestParam) GetMinAs() int64 {
+	if m != nil {
+		return m.MinAs
+	}
+	return 0
+}
+
+func (m *DataRequestParam) GetRequestParamsHash() string {
+	if m != nil {
+		return m.RequestParamsHash
+	}
+	return ""
+}
+
+type CreateRequestParams struct {
+	RequestId            string              `protobuf:"bytes,1,opt,name=request_id,json=requestId,proto3" json:"request_id,omitempty"`
+	MinIdp               int64               `protobuf:"varint,2,opt,name=min_idp,json=minIdp,proto3" json:"min_idp,omitempty"`
+	MinAal               float64             `protobuf:"fixed64,3,opt,name=min_aal,json=minAal,proto3" json:"min_aal,omitempty"`
+	MinIal               float64             `protobuf:"fixed64,4,opt,name=min_ial,json=minIal,proto3" json:"min_ial,omitempty"`
+	RequestTimeout       int64               `protobuf:"varint,5,opt,name=request_timeout,json=requestTimeout,proto3" json:"request_timeout,omitempty"`
+	IdpIdList            []string            `protobuf:"bytes,6,rep,name=idp_id_list,json=idpIdList,proto3" json:"idp_id_list,omitempty"`
+	DataRequestList      []*DataRequestParam `protobuf:"bytes,7,rep,name=data_request_list,json=dataRequestList,proto3" json:"data_request_list,omitempty"`
+	RequestMessageHash   string              `protobuf:"bytes,8,opt,name=request_message_hash,json=requestMessageHash,proto3" json:"request_message_hash,omitempty"`
+	Purpose              string              `protobuf:"bytes,9,opt,name=purpose,proto3" json:"purpose,omitempty"`
+	Mode                 int64               `protobuf:"varint,10,opt,name=mode,proto3" json:"mode,omitempty"`
+	RequestTimeoutBlock  int64               `protobuf:"varint,11,opt,name=request_timeout_block,json=requestTimeoutBlock,proto3" json:"request_timeout_block,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}            `json:"-"`
+	XXX_unrecognized     []byte              `json:"-"`
+	XXX_sizecache        int32               `json:"-"`
+}
+
+func (m *CreateRequestParams) Reset()         { *m = CreateRequestParams{} }
+func (m *CreateRequestParams) String() string { return proto.CompactTextString(m) }
+func (*CreateRequestParams) ProtoMessage()    {}
+func (*CreateRequestParams) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cebd89e7a20b4de6, []int{24}
+}
+
+func (m *CreateRequestParams) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CreateRequestParams.Unmarshal(m, b)
+}
+func (m *CreateRequestParams) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CreateRequestParams.Marshal(b, m, deterministic)
+}
+func (m *CreateRequestParams) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CreateRequestParams.Merge(m, src)
+}
+func (m *CreateRequestParams) XXX_Size() int {
+	return xxx_messageInfo_CreateRequestParams.Size(m)
+}
+func (m *CreateRequestParams) XXX_DiscardUnknown() {
+	xxx_messageInfo_CreateRequestParams.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CreateRequestParams proto.InternalMessageInfo
+
+func (m *CreateRequestParams) GetRequestId() string {
+	if m != nil {
+		return m.RequestId
+	}
+	return ""
+}
+
+func (m *CreateRequestParams) GetMinIdp() int64 {
+	if m != nil {
+		return m.MinIdp
+	}
+	return 0
+}
+
+func (m *CreateRequestParams) GetMinAal() float64 {
+	if m != nil {
+		return m.MinAal
+	}
+	return 0
+}
+
+func (m *CreateRequestParams) GetMinIal() float64 {
+	if m != nil {
+		return m.MinIal
+	}
+	return 0
+}
+
+func (m *CreateRequestParams) GetRequestTimeout() int64 {
+	if m != nil {
+		return m.RequestTimeout
+	}
+	return 0
+}
+
+func (m *CreateRequestParams) GetIdpIdList() []string {
+	if m != nil {
+		return m.IdpIdList
+	}
+	return nil
+}
+
+func (m *CreateRequestParams) GetDataRequestList() []*DataRequestParam {
+	if m != nil {
+		return m.DataRequestList
+	}
+	return nil
+}
+
+func (m *CreateRequestParams) GetRequestMessageHash() string {
+	if m != nil {
+		return m.RequestMessageHash
+	}
+	return ""
+}
+
+func (m *CreateRequestParams) GetPurpose() string {
+	if m != nil {
+		return m.Purpose
+	}
+	return ""
+}
+
+func (m *CreateRequestParams) GetMode() int64 {
+	if m != nil {
+		return m.Mode
+	}
+	return 0
+}
+
+func (m *CreateRequestParams) GetRequestTimeoutBlock() int64 {
+	if m != nil {
+		return m.RequestTimeoutBlock
+	}
+	return 0
+}
+
+type MsqAddressParam struct {
+	Ip                   string   `protobuf:"bytes,1,opt,name=ip,proto3" json:"ip,omitempty"`
+	Port                 int64    `protobuf:"varint,2,opt,name=port,proto3" json:"port,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *MsqAddressParam) Reset()         { *m = MsqAddressParam{} }
+func (m *MsqAddressParam) String() string { return proto.CompactTextString(m) }
+func (*MsqAddressParam) ProtoMessage()    {}
+func (*MsqAddressParam) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cebd89e7a20b4de6, []int{25}
+}
+
+func (m *MsqAddressParam) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_MsqAddressParam.Unmarshal(m, b)
+}
+func (m *MsqAddressParam) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_MsqAddressParam.Marshal(b, m, deterministic)
+}
+func (m *MsqAddressParam) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsqAddressParam.Merge(m, src)
+}
+func (m *MsqAddressParam) XXX_Size() int {
+	return xxx_messageInfo_MsqAddressParam.Size(m)
+}
+func (m *MsqAddressParam) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsqAddressParam.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsqAddressParam proto.InternalMessageInfo
+
+func (m *MsqAddressParam) GetIp() string {
+	if m != nil {
+		return m.Ip
+	}
+	return ""
+}
+
+func (m *MsqAddressParam) GetPort() int64 {
+	if m != nil {
+		return m.Port
+	}
+	return 0
+}
+
+type SetMqAddressesParams struct {
+	Addresses            []*MsqAddressParam `protobuf:"bytes,1,rep,name=addresses,proto3" json:"addresses,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}           `json:"-"`
+	XXX_unrecognized     []byte             `json:"-"`
+	XXX_sizecache        int32              `json:"-"`
+}
+
+func (m *SetMqAddressesParams) Reset()         { *m = SetMqAddressesParams{} }
+func (m *SetMqAddressesParams) String() string { return proto.CompactTextString(m) }
+func (*SetMqAddressesParams) ProtoMessage()    {}
+func (*SetMqAddressesParams) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cebd89e7a20b4de6, []int{26}
+}
+
+func (m *SetMqAddressesParams) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SetMqAddressesParams.Unmarshal(m, b)
+}
+func (m *SetMqAddressesParams) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SetMqAddressesParams.Marshal(b, m, deterministic)
+}
+func (m *SetMqAddressesParams) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SetMqAddressesParams.Merge(m, src)
+}
+func (m *SetMqAddressesParams) XXX_Size() int {
+	return xxx_messageInfo_SetMqAddressesParams.Size(m)
+}
+func (m *SetMqAddressesParams) XXX_DiscardUnknown() {
+	xxx_messageInfo_SetMqAddressesParams.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SetMqAddressesParams proto.InternalMessageInfo
+
+func (m *SetMqAddressesParams) GetAddresses() []*MsqAddressParam {
+	if m != nil {
+		return m.Addresses
+	}
+	return nil
+}
+
+type UpdateNodeParams struct {
+	PublicKey            string   `protobuf:"bytes,1,opt,name=public_key,json=publicKey,proto3" json:"public_key,omitempty"`
+	MasterPublicKey      string   `protobuf:"bytes,2,opt,name=master_public_key,json=masterPublicKey,proto3" json:"master_public_key,omitempty"`
+	SignatureScheme      string   `protobuf:"bytes,3,opt,name=signature_scheme,json=signatureScheme,proto3" json:"signature_scheme,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *UpdateNodeParams) Reset()         { *m = UpdateNodeParams{} }
+func (m *UpdateNodeParams) String() string { return proto.CompactTextString(m) }
+func (*UpdateNodeParams) ProtoMessage()    {}
+func (*UpdateNodeParams) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cebd89e7a20b4de6, []int{27}
+}
+
+func (m *UpdateNodeParams) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_UpdateNodeParams.Unmarshal(m, b)
+}
+func (m *UpdateNodeParams) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_UpdateNodeParams.Marshal(b, m, deterministic)
+}
+func (m *UpdateNodeParams) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UpdateNodeParams.Merge(m, src)
+}
+func (m *UpdateNodeParams) XXX_Size() int {
+	return xxx_messageInfo_UpdateNodeParams.Size(m)
+}
+func (m *UpdateNodeParams) XXX_DiscardUnknown() {
+	xxx_messageInfo_UpdateNodeParams.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_UpdateNodeParams proto.InternalMessageInfo
+
+func (m *UpdateNodeParams) GetPublicKey() string {
+	if m != nil {
+		return m.PublicKey
+	}
+	return ""
+}
+
+func (m *UpdateNodeParams) GetMasterPublicKey() string {
+	if m != nil {
+		return m.MasterPublicKey
+	}
+	return ""
+}
+
+func (m *UpdateNodeParams) GetSignatureScheme() string {
+	if m != nil {
+		return m.SignatureScheme
+	}
+	return ""
+}
+
+type ResponseValidParam struct {
+	IdpId                string        `protobuf:"bytes,1,opt,name=idp_id,json=idpId,proto3" json:"idp_id,omitempty"`
+	ValidProof           *OptionalBool `protobuf:"bytes,2,opt,name=valid_proof,json=validProof,proto3" json:"valid_proof,omitempty"`
+	ValidIal             *OptionalBool `protobuf:"bytes,3,opt,name=valid_ial,json=validIal,proto3" json:"valid_ial,omitempty"`
+	ValidSignature       *OptionalBool `protobuf:"bytes,4,opt,name=valid_signature,json=validSignature,proto3" json:"valid_signature,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}      `json:"-"`
+	XXX_unrecognized     []byte        `json:"-"`
+	XXX_sizecache        int32         `json:"-"`
+}
+
+func (m *ResponseValidParam) Reset()         { *m = ResponseValidParam{} }
+func (m *ResponseValidParam) String() string { return proto.CompactTextString(m) }
+func (*ResponseValidParam) ProtoMessage()    {}
+func (*ResponseValidParam) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cebd89e7a20b4de6, []int{28}
+}
+
+func (m *ResponseValidParam) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ResponseValidParam.Unmarshal(m, b)
+}
+func (m *ResponseValidParam) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ResponseValidParam.Marshal(b, m, deterministic)
+}
+func (m *ResponseValidParam) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ResponseValidParam.Merge(m, src)
+}
+func (m *ResponseValidParam) XXX_Size() int {
+	return xxx_messageInfo_ResponseValidParam.Size(m)
+}
+func (m *ResponseValidParam) XXX_DiscardUnknown() {
+	xxx_messageInfo_ResponseValidParam.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ResponseValidParam proto.InternalMessageInfo
+
+func (m *ResponseValidParam) GetIdpId() string {
+	if m != nil {
+		return m.IdpId
+	}
+	return ""
+}
+
+func (m *ResponseValidParam) GetValidProof() *OptionalBool {
+	if m != nil {
+		return m.ValidProof
+	}
+	return nil
+}
+
+func (m *ResponseValidParam) GetValidIal() *OptionalBool {
+	if m != nil {
+		return m.ValidIal
+	}
+	return nil
+}
+
+func (m *ResponseValidParam) GetValidSignature() *OptionalBool {
+	if m != nil {
+		return m.ValidSignature
+	}
+	return nil
+}
+
+type CloseRequestParams struct {
+	RequestId            string                `protobuf:"bytes,1,opt,name=request_id,json=requestId,proto3" json:"request_id,omitempty"`
+	ResponseValidList    []*ResponseValidParam `protobuf:"bytes,2,rep,name=response_valid_list,json=responseValidList,proto3" json:"response_valid_list,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}              `json:"-"`
+	XXX_unrecognized     []byte                `json:"-"`
+	XXX_sizecache        int32                 `json:"-"`
+}
+
+func (m *CloseRequestParams) Reset()         { *m = CloseRequestParams{} }
+func (m *CloseRequestParams) String() string { return proto.CompactTextString(m) }
+func (*CloseRequestParams) ProtoMessage()    {}
+func (*CloseRequestParams) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cebd89e7a20b4de6, []int{29}
+}
+
+func (m *CloseRequestParams) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CloseRequestParams.Unmarshal(m, b)
+}
+func (m *CloseRequestParams) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CloseRequestParams.Marshal(b, m, deterministic)
+}
+func (m *CloseRequestParams) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CloseRequestParams.Merge(m, src)
+}
+func (m *CloseRequestParams) XXX_Size() int {
+	return xxx_messageInfo_CloseRequestParams.Size(m)
+}
+func (m *CloseRequestParams) XXX_DiscardUnknown() {
+	xxx_messageInfo_CloseRequestParams.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CloseRequestParams proto.InternalMessageInfo
+
+func (m *CloseRequestParams) GetRequestId() string {
+	if m != nil {
+		return m.RequestId
+	}
+	return ""
+}
+
+func (m *CloseRequestParams) GetResponseValidList() []*ResponseValidParam {
+	if m != nil {
+		return m.ResponseValidList
+	}
+	return nil
+}
+
+type SetDataReceivedParams struct {
+	RequestId            string   `protobuf:"bytes,1,opt,name=request_id,json=requestId,proto3" json:"request_id,omitempty"`
+	ServiceId            string   `protobuf:"bytes,2,opt,name=service_id,json=serviceId,proto3" json:"service_id,omitempty"`
+	AsId                 string   `protobuf:"bytes,3,opt,name=as_id,json=asId,proto3" json:"as_id,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *SetDataReceivedParams) Reset()         { *m = SetDataReceivedParams{} }
+func (m *SetDataReceivedParams) String() string { return proto.CompactTextString(m) }
+func (*SetDataReceivedParams) ProtoMessage()    {}
+func (*SetDataReceivedParams) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cebd89e7a20b4de6, []int{30}
+}
+
+func (m *SetDataReceivedParams) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SetDataReceivedParams.Unmarshal(m, b)
+}
+func (m *SetDataReceivedParams) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SetDataReceivedParams.Marshal(b, m, deterministic)
+}
+func (m *SetDataReceivedParams) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SetDataReceivedParams.Merge(m, src)
+}
+func (m *SetDataReceivedParams) XXX_Size() int {
+	return xxx_messageInfo_SetDataReceivedParams.Size(m)
+}
+func (m *SetDataReceivedParams) XXX_DiscardUnknown() {
+	xxx_messageInfo_SetDataReceivedParams.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SetDataReceivedParams proto.InternalMessageInfo
+
+func (m *SetDataReceivedParams) GetRequestId() string {
+	if m != nil {
+		return m.RequestId
+	}
+	return ""
+}
+
+func (m *SetDataReceivedParams) GetServiceId() string {
+	if m != nil {
+		return m.ServiceId
+	}
+	return ""
+}
+
+func (m *SetDataReceivedParams) GetAsId() string {
+	if m != nil {
+		return m.AsId
+	}
+	return ""
+}
+
+type HashIDParams struct {
+	HashId               string   `protobuf:"bytes,1,opt,name=hash_id,json=hashId,proto3" json:"hash_id,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *HashIDParams) Reset()         { *m = HashIDParams{} }
+func (m *HashIDParams) String() string { return proto.CompactTextString(m) }
+func (*HashIDParams) ProtoMessage()    {}
+func (*HashIDParams) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cebd89e7a20b4de6, []int{31}
+}
+
+func (m *HashIDParams) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_HashIDParams.Unmarshal(m, b)
+}
+func (m *HashIDParams) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_HashIDParams.Marshal(b, m, deterministic)
+}
+func (m *HashIDParams) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_HashIDParams.Merge(m, src)
+}
+func (m *HashIDParams) XXX_Size() int {
+	return xxx_messageInfo_HashIDParams.Size(m)
+}
+func (m *HashIDParams) XXX_DiscardUnknown() {
+	xxx_messageInfo_HashIDParams.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_HashIDParams proto.InternalMessageInfo
+
+func (m *HashIDParams) GetHashId() string {
+	if m != nil {
+		return m.HashId
+	}
+	return ""
+}
+
+type SetTimeOutBlockRegisterIdentityParams struct {
+	TimeOutBlock         int64    `protobuf:"varint,1,opt,name=time_out_block,json=timeOutBlock,proto3" json:"time_out_block,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *SetTimeOutBlockRegisterIdentityParams) Reset()         { *m = SetTimeOutBlockRegisterIdentityParams{} }
+func (m *SetTimeOutBlockRegisterIdentityParams) String() string { return proto.CompactTextString(m) }
+func (*SetTimeOutBlockRegisterIdentityParams) ProtoMessage()    {}
+func (*SetTimeOutBlockRegisterIdentityParams) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cebd89e7a20b4de6, []int{32}
+}
+
+func (m *SetTimeOutBlockRegisterIdentityParams) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SetTimeOutBlockRegisterIdentityParams.Unmarshal(m, b)
+}
+func (m *SetTimeOutBlockRegisterIdentityParams) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SetTimeOutBlockRegisterIdentityParams.Marshal(b, m, deterministic)
+}
+func (m *SetTimeOutBlockRegisterIdentityParams) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SetTimeOutBlockRegisterIdentityParams.Merge(m, src)
+}
+func (m *SetTimeOutBlockRegisterIdentityParams) XXX_Size() int {
+	return xxx_messageInfo_SetTimeOutBlockRegisterIdentityParams.Size(m)
+}
+func (m *SetTimeOutBlockRegisterIdentityParams) XXX_DiscardUnknown() {
+	xxx_messageInfo_SetTimeOutBlockRegisterIdentityParams.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SetTimeOutBlockRegisterIdentityParams proto.InternalMessageInfo
+
+func (m *SetTimeOutBlockRegisterIdentityParams) GetTimeOutBlock() int64 {
+	if m != nil {
+		return m.TimeOutBlock
+	}
+	return 0
+}
+
+type ProxyNodeParams struct {
+	NodeId               string   `protobuf:"bytes,1,opt,name=node_id,json=nodeId,proto3" json:"node_id,omitempty"`
+	ProxyNodeId          string   `protobuf:"bytes,2,opt,name=proxy_node_id,json=proxyNodeId,proto3" json:"proxy_node_id,omitempty"`
+	Config               string   `protobuf:"bytes,3,opt,name=config,proto3" json:"config,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ProxyNodeParams) Reset()         { *m = ProxyNodeParams{} }
+func (m *ProxyNodeParams) String() string { return proto.CompactTextString(m) }
+func (*ProxyNodeParams) ProtoMessage()    {}
+func (*ProxyNodeParams) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cebd89e7a20b4de6, []int{33}
+}
+
+func (m *ProxyNodeParams) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ProxyNodeParams.Unmarshal(m, b)
+}
+func (m *ProxyNodeParams) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ProxyNodeParams.Marshal(b, m, deterministic)
+}
+func (m *ProxyNodeParams) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ProxyNodeParams.Merge(m, src)
+}
+func (m *ProxyNodeParams) XXX_Size() int {
+	return xxx_messageInfo_ProxyNodeParams.Size(m)
+}
+func (m *ProxyNodeParams) XXX_DiscardUnknown() {
+	xxx_messageInfo_ProxyNodeParams.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ProxyNodeParams proto.InternalMessageInfo
+
+func (m *ProxyNodeParams) GetNodeId() string {
+	if m != nil {
+		return m.NodeId
+	}
+	return ""
+}
+
+func (m *ProxyNodeParams) GetProxyNodeId() string {
+	if m != nil {
+		return m.ProxyNodeId
+	}
+	return ""
+}
+
+func (m *ProxyNodeParams) GetConfig() string {
+	if m != nil {
+		return m.Config
+	}
+	return ""
+}
+
+type RevokeAccessorMethodParams struct {
+	AccessorIdList       []string `protobuf:"bytes,1,rep,name=accessor_id_list,json=accessorIdList,proto3" json:"accessor_id_list,omitempty"`
+	RequestId            string   `protobuf:"bytes,2,opt,name=request_id,json=requestId,proto3" json:"request_id,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *RevokeAccessorMethodParams) Reset()         { *m = RevokeAccessorMethodParams{} }
+func (m *RevokeAccessorMethodParams) String() string { return proto.CompactTextString(m) }
+func (*RevokeAccessorMethodParams) ProtoMessage()    {}
+func (*RevokeAccessorMethodParams) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cebd89e7a20b4de6, []int{34}
+}
+
+func (m *RevokeAccessorMethodParams) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_RevokeAccessorMethodParams.Unmarshal(m, b)
+}
+func (m *RevokeAccessorMethodParams) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_RevokeAccessorMethodParams.Marshal(b, m, deterministic)
+}
+func (m *RevokeAccessorMethodParams) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RevokeAccessorMethodParams.Merge(m, src)
+}
+func (m *RevokeAccessorMethodParams) XXX_Size() int {
+	return xxx_messageInfo_RevokeAccessorMethodParams.Size(m)
+}
+func (m *RevokeAccessorMethodParams) XXX_DiscardUnknown() {
+	xxx_messageInfo_RevokeAccessorMethodParams.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RevokeAccessorMethodParams proto.InternalMessageInfo
+
+func (m *RevokeAccessorMethodParams) GetAccessorIdList() []string {
+	if m != nil {
+		return m.AccessorIdList
+	}
+	return nil
+}
+
+func (m *RevokeAccessorMethodParams) GetRequestId() string {
+	if m != nil {
+		return m.RequestId
+	}
+	return ""
+}
+
+type KeyValueParam struct {
+	Key                  []byte   `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	Value                []byte   `protobuf:"bytes,2,opt,name=value,proto3" json:"value,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *KeyValueParam) Reset()         { *m = KeyValueParam{} }
+func (m *KeyValueParam) String() string { return proto.CompactTextString(m) }
+func (*KeyValueParam) ProtoMessage()    {}
+func (*KeyValueParam) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cebd89e7a20b4de6, []int{35}
+}
+
+func (m *KeyValueParam) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_KeyValueParam.Unmarshal(m, b)
+}
+func (m *KeyValueParam) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_KeyValueParam.Marshal(b, m, deterministic)
+}
+func (m *KeyValueParam) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_KeyValueParam.Merge(m, src)
+}
+func (m *KeyValueParam) XXX_Size() int {
+	return xxx_messageInfo_KeyValueParam.Size(m)
+}
+func (m *KeyValueParam) XXX_DiscardUnknown() {
+	xxx_messageInfo_KeyValueParam.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_KeyValueParam proto.InternalMessageInfo
+
+func (m *KeyValueParam) GetKey() []byte {
+	if m != nil {
+		return m.Key
+	}
+	return nil
+}
+
+func (m *KeyValueParam) GetValue() []byte {
+	if m != nil {
+		return m.Value
+	}
+	return nil
+}
+
+type SetInitDataParams struct {
+	KvList               []*KeyValueParam `protobuf:"bytes,1,rep,name=kv_list,json=kvList,proto3" json:"kv_list,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}         `json:"-"`
+	XXX_unrecognized     []byte           `json:"-"`
+	XXX_sizecache        int32            `json:"-"`
+}
+
+func (m *SetInitDataParams) Reset()         { *m = SetInitDataParams{} }
+func (m *SetInitDataParams) String() string { return proto.CompactTextString(m) }
+func (*SetInitDataParams) ProtoMessage()    {}
+func (*SetInitDataParams) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cebd89e7a20b4de6, []int{36}
+}
+
+func (m *SetInitDataParams) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SetInitDataParams.Unmarshal(m, b)
+}
+func (m *SetInitDataParams) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SetInitDataParams.Marshal(b, m, deterministic)
+}
+func (m *SetInitDataParams) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SetInitDataParams.Merge(m, src)
+}
+func (m *SetInitDataParams) XXX_Size() int {
+	return xxx_messageInfo_SetInitDataParams.Size(m)
+}
+func (m *SetInitDataParams) XXX_DiscardUnknown() {
+	xxx_messageInfo_SetInitDataParams.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SetInitDataParams proto.InternalMessageInfo
+
+func (m *SetInitDataParams) GetKvList() []*KeyValueParam {
+	if m != nil {
+		return m.KvList
+	}
+	return nil
+}
+
+type EmptyParams struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *EmptyParams) Reset()         { *m = EmptyParams{} }
+func (m *EmptyParams) String() string { return proto.CompactTextString(m) }
+func (*EmptyParams) ProtoMessage()    {}
+func (*EmptyParams) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cebd89e7a20b4de6, []int{37}
+}
+
+func (m *EmptyParams) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_EmptyParams.Unmarshal(m, b)
+}
+func (m *EmptyParams) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_EmptyParams.Marshal(b, m, deterministic)
+}
+func (m *EmptyParams) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EmptyParams.Merge(m, src)
+}
+func (m *EmptyParams) XXX_Size() int {
+	return xxx_messageInfo_EmptyParams.Size(m)
+}
+func (m *EmptyParams) XXX_DiscardUnknown() {
+	xxx_messageInfo_EmptyParams.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EmptyParams proto.InternalMessageInfo
+
+type SetLastBlockParams struct {
+	BlockHeight          int64    `protobuf:"varint,1,opt,name=block_height,json=blockHeight,proto3" json:"block_height,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *SetLastBlockParams) Reset()         { *m = SetLastBlockParams{} }
+func (m *SetLastBlockParams) String() string { return proto.CompactTextString(m) }
+func (*SetLastBlockParams) ProtoMessage()    {}
+func (*SetLastBlockParams) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cebd89e7a20b4de6, []int{38}
+}
+
+func (m *SetLastBlockParams) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SetLastBlockParams.Unmarshal(m, b)
+}
+func (m *SetLastBlockParams) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SetLastBlockParams.Marshal(b, m, deterministic)
+}
+func (m *SetLastBlockParams) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SetLastBlockParams.Merge(m, src)
+}
+func (m *SetLastBlockParams) XXX_Size() int {
+	return xxx_messageInfo_SetLastBlockParams.Size(m)
+}
+func (m *SetLastBlockParams) XXX_DiscardUnknown() {
+	xxx_messageInfo_SetLastBlockParams.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SetLastBlockParams proto.InternalMessageInfo
+
+func (m *SetLastBlockParams) GetBlockHeight() int64 {
+	if m != nil {
+		return m.BlockHeight
+	}
+	return 0
+}
+
+type GovernanceKeyParam struct {
+	KeyId                string   `protobuf:"bytes,1,opt,name=key_id,json=keyId,proto3" json:"key_id,omitempty"`
+	PublicKey            string   `protobuf:"bytes,2,opt,name=public_key,json=publicKey,proto3" json:"public_key,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *GovernanceKeyParam) Reset()         { *m = GovernanceKeyParam{} }
+func (m *GovernanceKeyParam) String() string { return proto.CompactTextString(m) }
+func (*GovernanceKeyParam) ProtoMessage()    {}
+func (*GovernanceKeyParam) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cebd89e7a20b4de6, []int{39}
+}
+
+func (m *GovernanceKeyParam) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GovernanceKeyParam.Unmarshal(m, b)
+}
+func (m *GovernanceKeyParam) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GovernanceKeyParam.Marshal(b, m, deterministic)
+}
+func (m *GovernanceKeyParam) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GovernanceKeyParam.Merge(m, src)
+}
+func (m *GovernanceKeyParam) XXX_Size() int {
+	return xxx_messageInfo_GovernanceKeyParam.Size(m)
+}
+func (m *GovernanceKeyParam) XXX_DiscardUnknown() {
+	xxx_messageInfo_GovernanceKeyParam.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GovernanceKeyParam proto.InternalMessageInfo
+
+func (m *GovernanceKeyParam) GetKeyId() string {
+	if m != nil {
+		return m.KeyId
+	}
+	return ""
+}
+
+func (m *GovernanceKeyParam) GetPublicKey() string {
+	if m != nil {
+		return m.PublicKey
+	}
+	return ""
+}
+
+type SetGovernanceParams struct {
+	Keys                 []*GovernanceKeyParam `protobuf:"bytes,1,rep,name=keys,proto3" json:"keys,omitempty"`
+	Threshold            int64                 `protobuf:"varint,2,opt,name=threshold,proto3" json:"threshold,omitempty"`
+	ProposalTimeoutBlock int64                 `protobuf:"varint,3,opt,name=proposal_timeout_block,json=proposalTimeoutBlock,proto3" json:"proposal_timeout_block,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}              `json:"-"`
+	XXX_unrecognized     []byte                `json:"-"`
+	XXX_sizecache        int32                 `json:"-"`
+}
+
+func (m *SetGovernanceParams) Reset()         { *m = SetGovernanceParams{} }
+func (m *SetGovernanceParams) String() string { return proto.CompactTextString(m) }
+func (*SetGovernanceParams) ProtoMessage()    {}
+func (*SetGovernanceParams) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cebd89e7a20b4de6, []int{40}
+}
+
+func (m *SetGovernanceParams) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SetGovernanceParams.Unmarshal(m, b)
+}
+func (m *SetGovernanceParams) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SetGovernanceParams.Marshal(b, m, deterministic)
+}
+func (m *SetGovernanceParams) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SetGovernanceParams.Merge(m, src)
+}
+func (m *SetGovernanceParams) XXX_Size() int {
+	return xxx_messageInfo_SetGovernanceParams.Size(m)
+}
+func (m *SetGovernanceParams) XXX_DiscardUnknown() {
+	xxx_messageInfo_SetGovernanceParams.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SetGovernanceParams proto.InternalMessageInfo
+
+func (m *SetGovernanceParams) GetKeys() []*GovernanceKeyParam {
+	if m != nil {
+		return m.Keys
+	}
+	return nil
+}
+
+func (m *SetGovernanceParams) GetThreshold() int64 {
+	if m != nil {
+		return m.Threshold
+	}
+	return 0
+}
+
+func (m *SetGovernanceParams) GetProposalTimeoutBlock() int64 {
+	if m != nil {
+		return m.ProposalTimeoutBlock
+	}
+	return 0
+}
+
+type CreateProposalParams struct {
+	ProposalId           string   `protobuf:"bytes,1,opt,name=proposal_id,json=proposalId,proto3" json:"proposal_id,omitempty"`
+	Method               string   `protobuf:"bytes,2,opt,name=method,proto3" json:"method,omitempty"`
+	Params               string   `protobuf:"bytes,3,opt,name=params,proto3" json:"params,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *CreateProposalParams) Reset()         { *m = CreateProposalParams{} }
+func (m *CreateProposalParams) String() string { return proto.CompactTextString(m) }
+func (*CreateProposalParams) ProtoMessage()    {}
+func (*CreateProposalParams) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cebd89e7a20b4de6, []int{41}
+}
+
+func (m *CreateProposalParams) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CreateProposalParams.Unmarshal(m, b)
+}
+func (m *CreateProposalParams) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CreateProposalParams.Marshal(b, m, deterministic)
+}
+func (m *CreateProposalParams) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CreateProposalParams.Merge(m, src)
+}
+func (m *CreateProposalParams) XXX_Size() int {
+	return xxx_messageInfo_CreateProposalParams.Size(m)
+}
+func (m *CreateProposalParams) XXX_DiscardUnknown() {
+	xxx_messageInfo_CreateProposalParams.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CreateProposalParams proto.InternalMessageInfo
+
+func (m *CreateProposalParams) GetProposalId() string {
+	if m != nil {
+		return m.ProposalId
+	}
+	return ""
+}
+
+func (m *CreateProposalParams) GetMethod() string {
+	if m != nil {
+		return m.Method
+	}
+	return ""
+}
+
+func (m *CreateProposalParams) GetParams() string {
+	if m != nil {
+		return m.Params
+	}
+	return ""
+}
+
+type ProposalIDParams struct {
+	ProposalId           string   `protobuf:"bytes,1,opt,name=proposal_id,json=proposalId,proto3" json:"proposal_id,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ProposalIDParams) Reset()         { *m = ProposalIDParams{} }
+func (m *ProposalIDParams) String() string { return proto.CompactTextString(m) }
+func (*ProposalIDParams) ProtoMessage()    {}
+func (*ProposalIDParams) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cebd89e7a20b4de6, []int{42}
+}
+
+func (m *ProposalIDParams) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ProposalIDParams.Unmarshal(m, b)
+}
+func (m *ProposalIDParams) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ProposalIDParams.Marshal(b, m, deterministic)
+}
+func (m *ProposalIDParams) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ProposalIDParams.Merge(m, src)
+}
+func (m *ProposalIDParams) XXX_Size() int {
+	return xxx_messageInfo_ProposalIDParams.Size(m)
+}
+func (m *ProposalIDParams) XXX_DiscardUnknown() {
+	xxx_messageInfo_ProposalIDParams.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ProposalIDParams proto.InternalMessageInfo
+
+func (m *ProposalIDParams) GetProposalId() string {
+	if m != nil {
+		return m.ProposalId
+	}
+	return ""
+}
+
+type InitiateMasterKeyRecoveryParams struct {
+	NodeId               string   `protobuf:"bytes,1,opt,name=node_id,json=nodeId,proto3" json:"node_id,omitempty"`
+	NewMasterPublicKey   string   `protobuf:"bytes,2,opt,name=new_master_public_key,json=newMasterPublicKey,proto3" json:"new_master_public_key,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *InitiateMasterKeyRecoveryParams) Reset()         { *m = InitiateMasterKeyRecoveryParams{} }
+func (m *InitiateMasterKeyRecoveryParams) String() string { return proto.CompactTextString(m) }
+func (*InitiateMasterKeyRecoveryParams) ProtoMessage()    {}
+func (*InitiateMasterKeyRecoveryParams) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cebd89e7a20b4de6, []int{43}
+}
+
+func (m *InitiateMasterKeyRecoveryParams) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_InitiateMasterKeyRecoveryParams.Unmarshal(m, b)
+}
+func (m *InitiateMasterKeyRecoveryParams) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_InitiateMasterKeyRecoveryParams.Marshal(b, m, deterministic)
+}
+func (m *InitiateMasterKeyRecoveryParams) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_InitiateMasterKeyRecoveryParams.Merge(m, src)
+}
+func (m *InitiateMasterKeyRecoveryParams) XXX_Size() int {
+	return xxx_messageInfo_InitiateMasterKeyRecoveryParams.Size(m)
+}
+func (m *InitiateMasterKeyRecoveryParams) XXX_DiscardUnknown() {
+	xxx_messageInfo_InitiateMasterKeyRecoveryParams.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_InitiateMasterKeyRecoveryParams proto.InternalMessageInfo
+
+func (m *InitiateMasterKeyRecoveryParams) GetNodeId() string {
+	if m != nil {
+		return m.NodeId
+	}
+	return ""
+}
+
+func (m *InitiateMasterKeyRecoveryParams) GetNewMasterPublicKey() string {
+	if m != nil {
+		return m.NewMasterPublicKey
+	}
+	return ""
+}
+
+type BatchTxParam struct {
+	Method               string   `protobuf:"bytes,1,opt,name=method,proto3" json:"method,omitempty"`
+	Params               string   `protobuf:"bytes,2,opt,name=params,proto3" json:"params,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *BatchTxParam) Reset()         { *m = BatchTxParam{} }
+func (m *BatchTxParam) String() string { return proto.CompactTextString(m) }
+func (*BatchTxParam) ProtoMessage()    {}
+func (*BatchTxParam) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cebd89e7a20b4de6, []int{44}
+}
+
+func (m *BatchTxParam) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_BatchTxParam.Unmarshal(m, b)
+}
+func (m *BatchTxParam) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_BatchTxParam.Marshal(b, m, deterministic)
+}
+func (m *BatchTxParam) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BatchTxParam.Merge(m, src)
+}
+func (m *BatchTxParam) XXX_Size() int {
+	return xxx_messageInfo_BatchTxParam.Size(m)
+}
+func (m *BatchTxParam) XXX_DiscardUnknown() {
+	xxx_messageInfo_BatchTxParam.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BatchTxParam proto.InternalMessageInfo
+
+func (m *BatchTxParam) GetMethod() string {
+	if m != nil {
+		return m.Method
+	}
+	return ""
+}
+
+func (m *BatchTxParam) GetParams() string {
+	if m != nil {
+		return m.Params
+	}
+	return ""
+}
+
+type BatchParams struct {
+	Transactions         []*BatchTxParam `protobuf:"bytes,1,rep,name=transactions,proto3" json:"transactions,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}        `json:"-"`
+	XXX_unrecognized     []byte          `json:"-"`
+	XXX_sizecache        int32           `json:"-"`
+}
+
+func (m *BatchParams) Reset()         { *m = BatchParams{} }
+func (m *BatchParams) String() string { return proto.CompactTextString(m) }
+func (*BatchParams) ProtoMessage()    {}
+func (*BatchParams) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cebd89e7a20b4de6, []int{45}
+}
+
+func (m *BatchParams) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_BatchParams.Unmarshal(m, b)
+}
+func (m *BatchParams) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_BatchParams.Marshal(b, m, deterministic)
+}
+func (m *BatchParams) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BatchParams.Merge(m, src)
+}
+func (m *BatchParams) XXX_Size() int {
+	return xxx_messageInfo_BatchParams.Size(m)
+}
+func (m *BatchParams) XXX_DiscardUnknown() {
+	xxx_messageInfo_BatchParams.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BatchParams proto.InternalMessageInfo
+
+func (m *BatchParams) GetTransactions() []*BatchTxParam {
+	if m != nil {
+		return m.Transactions
+	}
+	return nil
+}
+
+type ScheduleUpgradeParams struct {
+	Version              string   `protobuf:"bytes,1,opt,name=version,proto3" json:"version,omitempty"`
+	Height               int64    `protobuf:"varint,2,opt,name=height,proto3" json:"height,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ScheduleUpgradeParams) Reset()         { *m = ScheduleUpgradeParams{} }
+func (m *ScheduleUpgradeParams) String() string { return proto.CompactTextString(m) }
+func (*ScheduleUpgradeParams) ProtoMessage()    {}
+func (*ScheduleUpgradeParams) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cebd89e7a20b4de6, []int{46}
+}
+
+func (m *ScheduleUpgradeParams) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ScheduleUpgradeParams.Unmarshal(m, b)
+}
+func (m *ScheduleUpgradeParams) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ScheduleUpgradeParams.Marshal(b, m, deterministic)
+}
+func (m *ScheduleUpgradeParams) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ScheduleUpgradeParams.Merge(m, src)
+}
+func (m *ScheduleUpgradeParams) XXX_Size() int {
+	return xxx_messageInfo_ScheduleUpgradeParams.Size(m)
+}
+func (m *ScheduleUpgradeParams) XXX_DiscardUnknown() {
+	xxx_messageInfo_ScheduleUpgradeParams.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ScheduleUpgradeParams proto.InternalMessageInfo
+
+func (m *ScheduleUpgradeParams) GetVersion() string {
+	if m != nil {
+		return m.Version
+	}
+	return ""
+}
+
+func (m *ScheduleUpgradeParams) GetHeight() int64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+type TransferTokenParams struct {
+	ToNodeId             string       `protobuf:"bytes,1,opt,name=to_node_id,json=toNodeId,proto3" json:"to_node_id,omitempty"`
+	Amount               *TokenAmount `protobuf:"bytes,2,opt,name=amount,proto3" json:"amount,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}     `json:"-"`
+	XXX_unrecognized     []byte       `json:"-"`
+	XXX_sizecache        int32        `json:"-"`
+}
+
+func (m *TransferTokenParams) Reset()         { *m = TransferTokenParams{} }
+func (m *TransferTokenParams) String() string { return proto.CompactTextString(m) }
+func (*TransferTokenParams) ProtoMessage()    {}
+func (*TransferTokenParams) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cebd89e7a20b4de6, []int{47}
+}
+
+func (m *TransferTokenParams) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_TransferTokenParams.Unmarshal(m, b)
+}
+func (m *TransferTokenParams) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_TransferTokenParams.Marshal(b, m, deterministic)
+}
+func (m *TransferTokenParams) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TransferTokenParams.Merge(m, src)
+}
+func (m *TransferTokenParams) XXX_Size() int {
+	return xxx_messageInfo_TransferTokenParams.Size(m)
+}
+func (m *TransferTokenParams) XXX_DiscardUnknown() {
+	xxx_messageInfo_TransferTokenParams.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TransferTokenParams proto.InternalMessageInfo
+
+func (m *TransferTokenParams) GetToNodeId() string {
+	if m != nil {
+		return m.ToNodeId
+	}
+	return ""
+}
+
+func (m *TransferTokenParams) GetAmount() *TokenAmount {
+	if m != nil {
+		return m.Amount
+	}
+	return nil
+}
+
+type SetTokenTransferWhitelistParams struct {
+	NodeId               string   `protobuf:"bytes,1,opt,name=node_id,json=nodeId,proto3" json:"node_id,omitempty"`
+	NodeIdList           []string `protobuf:"bytes,2,rep,name=node_id_list,json=nodeIdList,proto3" json:"node_id_list,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *SetTokenTransferWhitelistParams) Reset()         { *m = SetTokenTransferWhitelistParams{} }
+func (m *SetTokenTransferWhitelistParams) String() string { return proto.CompactTextString(m) }
+func (*SetTokenTransferWhitelistParams) ProtoMessage()    {}
+func (*SetTokenTransferWhitelistParams) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cebd89e7a20b4de6, []int{48}
+}
+
+func (m *SetTokenTransferWhitelistParams) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SetTokenTransferWhitelistParams.Unmarshal(m, b)
+}
+func (m *SetTokenTransferWhitelistParams) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SetTokenTransferWhitelistParams.Marshal(b, m, deterministic)
+}
+func (m *SetTokenTransferWhitelistParams) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SetTokenTransferWhitelistParams.Merge(m, src)
+}
+func (m *SetTokenTransferWhitelistParams) XXX_Size() int {
+	return xxx_messageInfo_SetTokenTransferWhitelistParams.Size(m)
+}
+func (m *SetTokenTransferWhitelistParams) XXX_DiscardUnknown() {
+	xxx_messageInfo_SetTokenTransferWhitelistParams.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SetTokenTransferWhitelistParams proto.InternalMessageInfo
+
+func (m *SetTokenTransferWhitelistParams) GetNodeId() string {
+	if m != nil {
+		return m.NodeId
+	}
+	return ""
+}
+
+func (m *SetTokenTransferWhitelistParams) GetNodeIdList() []string {
+	if m != nil {
+		return m.NodeIdList
+	}
+	return nil
+}
+
+type ServicePriceParams struct {
+	ServiceId            string       `protobuf:"bytes,1,opt,name=service_id,json=serviceId,proto3" json:"service_id,omitempty"`
+	Price                *TokenAmount `protobuf:"bytes,2,opt,name=price,proto3" json:"price,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}     `json:"-"`
+	XXX_unrecognized     []byte       `json:"-"`
+	XXX_sizecache        int32        `json:"-"`
+}
+
+func (m *ServicePriceParams) Reset()         { *m = ServicePriceParams{} }
+func (m *ServicePriceParams) String() string { return proto.CompactTextString(m) }
+func (*ServicePriceParams) ProtoMessage()    {}
+func (*ServicePriceParams) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cebd89e7a20b4de6, []int{49}
+}
+
+func (m *ServicePriceParams) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ServicePriceParams.Unmarshal(m, b)
+}
+func (m *ServicePriceParams) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ServicePriceParams.Marshal(b, m, deterministic)
+}
+func (m *ServicePriceParams) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ServicePriceParams.Merge(m, src)
+}
+func (m *ServicePriceParams) XXX_Size() int {
+	return xxx_messageInfo_ServicePriceParams.Size(m)
+}
+func (m *ServicePriceParams) XXX_DiscardUnknown() {
+	xxx_messageInfo_ServicePriceParams.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ServicePriceParams proto.InternalMessageInfo
+
+func (m *ServicePriceParams) GetServiceId() string {
+	if m != nil {
+		return m.ServiceId
+	}
+	return ""
+}
+
+func (m *ServicePriceParams) GetPrice() *TokenAmount {
+	if m != nil {
+		return m.Price
+	}
+	return nil
+}
+
+type SetPriceRuleParams struct {
+	Func                 string                `protobuf:"bytes,1,opt,name=func,proto3" json:"func,omitempty"`
+	PerIdpPrice          *TokenAmount          `protobuf:"bytes,2,opt,name=per_idp_price,json=perIdpPrice,proto3" json:"per_idp_price,omitempty"`
+	PerAsPrice           *TokenAmount          `protobuf:"bytes,3,opt,name=per_as_price,json=perAsPrice,proto3" json:"per_as_price,omitempty"`
+	ServicePriceList     []*ServicePriceParams `protobuf:"bytes,4,rep,name=service_price_list,json=servicePriceList,proto3" json:"service_price_list,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}              `json:"-"`
+	XXX_unrecognized     []byte                `json:"-"`
+	XXX_sizecache        int32                 `json:"-"`
+}
+
+func (m *SetPriceRuleParams) Reset()         { *m = SetPriceRuleParams{} }
+func (m *SetPriceRuleParams) String() string { return proto.CompactTextString(m) }
+func (*SetPriceRuleParams) ProtoMessage()    {}
+func (*SetPriceRuleParams) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cebd89e7a20b4de6, []int{50}
+}
+
+func (m *SetPriceRuleParams) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SetPriceRuleParams.Unmarshal(m, b)
+}
+func (m *SetPriceRuleParams) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SetPriceRuleParams.Marshal(b, m, deterministic)
+}
+func (m *SetPriceRuleParams) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SetPriceRuleParams.Merge(m, src)
+}
+func (m *SetPriceRuleParams) XXX_Size() int {
+	return xxx_messageInfo_SetPriceRuleParams.Size(m)
+}
+func (m *SetPriceRuleParams) XXX_DiscardUnknown() {
+	xxx_messageInfo_SetPriceRuleParams.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SetPriceRuleParams proto.InternalMessageInfo
+
+func (m *SetPriceRuleParams) GetFunc() string {
+	if m != nil {
+		return m.Func
+	}
+	return ""
+}
+
+func (m *SetPriceRuleParams) GetPerIdpPrice() *TokenAmount {
+	if m != nil {
+		return m.PerIdpPrice
+	}
+	return nil
+}
+
+func (m *SetPriceRuleParams) GetPerAsPrice() *TokenAmount {
+	if m != nil {
+		return m.PerAsPrice
+	}
+	return nil
+}
+
+func (m *SetPriceRuleParams) GetServicePriceList() []*ServicePriceParams {
+	if m != nil {
+		return m.ServicePriceList
+	}
+	return nil
+}
+
+type SetRevenueShareRuleParams struct {
+	IdpPercent           int64    `protobuf:"varint,1,opt,name=idp_percent,json=idpPercent,proto3" json:"idp_percent,omitempty"`
+	AsPercent            int64    `protobuf:"varint,2,opt,name=as_percent,json=asPercent,proto3" json:"as_percent,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *SetRevenueShareRuleParams) Reset()         { *m = SetRevenueShareRuleParams{} }
+func (m *SetRevenueShareRuleParams) String() string { return proto.CompactTextString(m) }
+func (*SetRevenueShareRuleParams) ProtoMessage()    {}
+func (*SetRevenueShareRuleParams) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cebd89e7a20b4de6, []int{51}
+}
+
+func (m *SetRevenueShareRuleParams) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SetRevenueShareRuleParams.Unmarshal(m, b)
+}
+func (m *SetRevenueShareRuleParams) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SetRevenueShareRuleParams.Marshal(b, m, deterministic)
+}
+func (m *SetRevenueShareRuleParams) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SetRevenueShareRuleParams.Merge(m, src)
+}
+func (m *SetRevenueShareRuleParams) XXX_Size() int {
+	return xxx_messageInfo_SetRevenueShareRuleParams.Size(m)
+}
+func (m *SetRevenueShareRuleParams) XXX_DiscardUnknown() {
+	xxx_messageInfo_SetRevenueShareRuleParams.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SetRevenueShareRuleParams proto.InternalMessageInfo
+
+func (m *SetRevenueShareRuleParams) GetIdpPercent() int64 {
+	if m != nil {
+		return m.IdpPercent
+	}
+	return 0
+}
+
+func (m *SetRevenueShareRuleParams) GetAsPercent() int64 {
+	if m != nil {
+		return m.AsPercent
+	}
+	return 0
+}
+
+type SetNodeTokenLimitParams struct {
+	NodeId               string       `protobuf:"bytes,1,opt,name=node_id,json=nodeId,proto3" json:"node_id,omitempty"`
+	CreditLimit          *TokenAmount `protobuf:"bytes,2,opt,name=credit_limit,json=creditLimit,proto3" json:"credit_limit,omitempty"`
+	LowBalanceThreshold  *TokenAmount `protobuf:"bytes,3,opt,name=low_balance_threshold,json=lowBalanceThreshold,proto3" json:"low_balance_threshold,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}     `json:"-"`
+	XXX_unrecognized     []byte       `json:"-"`
+	XXX_sizecache        int32        `json:"-"`
+}
+
+func (m *SetNodeTokenLimitParams) Reset()         { *m = SetNodeTokenLimitParams{} }
+func (m *SetNodeTokenLimitParams) String() string { return proto.CompactTextString(m) }
+func (*SetNodeTokenLimitParams) ProtoMessage()    {}
+func (*SetNodeTokenLimitParams) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cebd89e7a20b4de6, []int{52}
+}
+
+func (m *SetNodeTokenLimitParams) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SetNodeTokenLimitParams.Unmarshal(m, b)
+}
+func (m *SetNodeTokenLimitParams) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SetNodeTokenLimitParams.Marshal(b, m, deterministic)
+}
+func (m *SetNodeTokenLimitParams) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SetNodeTokenLimitParams.Merge(m, src)
+}
+func (m *SetNodeTokenLimitParams) XXX_Size() int {
+	return xxx_messageInfo_SetNodeTokenLimitParams.Size(m)
+}
+func (m *SetNodeTokenLimitParams) XXX_DiscardUnknown() {
+	xxx_messageInfo_SetNodeTokenLimitParams.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SetNodeTokenLimitParams proto.InternalMessageInfo
+
+func (m *SetNodeTokenLimitParams) GetNodeId() string {
+	if m != nil {
+		return m.NodeId
+	}
+	return ""
+}
+
+func (m *SetNodeTokenLimitParams) GetCreditLimit() *TokenAmount {
+	if m != nil {
+		return m.CreditLimit
+	}
+	return nil
+}
+
+func (m *SetNodeTokenLimitParams) GetLowBalanceThreshold() *TokenAmount {
+	if m != nil {
+		return m.LowBalanceThreshold
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterType((*OptionalBool)(nil), "OptionalBool")
+	proto.RegisterType((*TokenAmount)(nil), "TokenAmount")
+	proto.RegisterType((*InitNDIDParams)(nil), "InitNDIDParams")
+	proto.RegisterType((*RegisterNodeParams)(nil), "RegisterNodeParams")
+	proto.RegisterType((*NodeTokenParams)(nil), "NodeTokenParams")
+	proto.RegisterType((*SetPriceFuncParams)(nil), "SetPriceFuncParams")
+	proto.RegisterType((*AddNamespaceParams)(nil), "AddNamespaceParams")
+	proto.RegisterType((*SetValidatorParams)(nil), "SetValidatorParams")
+	proto.RegisterType((*ServiceParams)(nil), "ServiceParams")
+	proto.RegisterType((*UpdateNodeByNDIDParams)(nil), "UpdateNodeByNDIDParams")
+	proto.RegisterType((*ServiceDestinationByNDIDParams)(nil), "ServiceDestinationByNDIDParams")
+	proto.RegisterType((*NodeIDParams)(nil), "NodeIDParams")
+	proto.RegisterType((*NamespaceParams)(nil), "NamespaceParams")
+	proto.RegisterType((*ServiceIDParams)(nil), "ServiceIDParams")
+	proto.RegisterType((*UserParam)(nil), "UserParam")
+	proto.RegisterType((*RegisterIdentityParams)(nil), "RegisterIdentityParams")
+	proto.RegisterType((*AccessorMethodParams)(nil), "AccessorMethodParams")
+	proto.RegisterType((*CreateIdpResponseParams)(nil), "CreateIdpResponseParams")
+	proto.RegisterType((*RegisterAccessorParams)(nil), "RegisterAccessorParams")
+	proto.RegisterType((*UpdateIdentityParams)(nil), "UpdateIdentityParams")
+	proto.RegisterType((*DeclareIdentityProofParams)(nil), "DeclareIdentityProofParams")
+	proto.RegisterType((*SignDataParams)(nil), "SignDataParams")
+	proto.RegisterType((*ServiceDestinationParams)(nil), "ServiceDestinationParams")
+	proto.RegisterType((*DataRequestParam)(nil), "DataRequestParam")
+	proto.RegisterType((*CreateRequestParams)(nil), "CreateRequestParams")
+	proto.RegisterType((*MsqAddressParam)(nil), "MsqAddressParam")
+	proto.RegisterType((*SetMqAddressesParams)(nil), "SetMqAddressesParams")
+	proto.RegisterType((*UpdateNodeParams)(nil), "UpdateNodeParams")
+	proto.RegisterType((*ResponseValidParam)(nil), "ResponseValidParam")
+	proto.RegisterType((*CloseRequestParams)(nil), "CloseRequestParams")
+	proto.RegisterType((*SetDataReceivedParams)(nil), "SetDataReceivedParams")
+	proto.RegisterType((*HashIDParams)(nil), "HashIDParams")
+	proto.RegisterType((*SetTimeOutBlockRegisterIdentityParams)(nil), "SetTimeOutBlockRegisterIdentityParams")
+	proto.RegisterType((*ProxyNodeParams)(nil), "ProxyNodeParams")
+	proto.RegisterType((*RevokeAccessorMethodParams)(nil), "RevokeAccessorMethodParams")
+	proto.RegisterType((*KeyValueParam)(nil), "KeyValueParam")
+	proto.RegisterType((*SetInitDataParams)(nil), "SetInitDataParams")
+	proto.RegisterType((*EmptyParams)(nil), "EmptyParams")
+	proto.RegisterType((*SetLastBlockParams)(nil), "SetLastBlockParams")
+	proto.RegisterType((*GovernanceKeyParam)(nil), "GovernanceKeyParam")
+	proto.RegisterType((*SetGovernanceParams)(nil), "SetGovernanceParams")
+	proto.RegisterType((*CreateProposalParams)(nil), "CreateProposalParams")
+	proto.RegisterType((*ProposalIDParams)(nil), "ProposalIDParams")
+	proto.RegisterType((*InitiateMasterKeyRecoveryParams)(nil), "InitiateMasterKeyRecoveryParams")
+	proto.RegisterType((*BatchTxParam)(nil), "BatchTxParam")
+	proto.RegisterType((*BatchParams)(nil), "BatchParams")
+	proto.RegisterType((*ScheduleUpgradeParams)(nil), "ScheduleUpgradeParams")
+	proto.RegisterType((*TransferTokenParams)(nil), "TransferTokenParams")
+	proto.RegisterType((*SetTokenTransferWhitelistParams)(nil), "SetTokenTransferWhitelistParams")
+	proto.RegisterType((*ServicePriceParams)(nil), "ServicePriceParams")
+	proto.RegisterType((*SetPriceRuleParams)(nil), "SetPriceRuleParams")
+	proto.RegisterType((*SetRevenueShareRuleParams)(nil), "SetRevenueShareRuleParams")
+	proto.RegisterType((*SetNodeTokenLimitParams)(nil), "SetNodeTokenLimitParams")
+}
+
+func init() { proto.RegisterFile("protos/param/param.proto", fileDescriptor_cebd89e7a20b4de6) }
+
+var fileDescriptor_cebd89e7a20b4de6 = []byte{
+	// 1996 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xd4, 0x58, 0x4f, 0x6f, 0x23, 0x49,
+	0x15, 0x57, 0xdb, 0x89, 0x13, 0x3f, 0x3b, 0xb1, 0xd3, 0x49, 0x66, 0xcc, 0x32, 0xbb, 0xc9, 0x16,
+	0xb3, 0x9a, 0x61, 0x85, 0x3c, 0xb3, 0x59, 0x60, 0x25, 0x04, 0x68, 0x93, 0x09, 0xbb, 0x6b, 0x26,
+	0x99, 0x8d, 0xda, 0x99, 0x59, 0x10, 0x48, 0xad, 0x4a, 0xf7, 0x8b, 0x5d, 0xb8, 0xdd, 0xdd, 0xdb,
+	0x55, 0x76, 0xe2, 0x1b, 0x47, 0xce, 0x1c, 0xb8, 0x70, 0x43, 0xe2, 0x4b, 0x70, 0xe1, 0x13, 0x70,
+	0xe6, 0xc0, 0x07, 0xe0, 0x6b, 0xa0, 0xfa, 0xe7, 0x6e, 0x3b, 0xce, 0xd8, 0x73, 0x41, 0xda, 0x8b,
+	0xe5, 0x7a, 0xef, 0x55, 0xd5, 0xab, 0xf7, 0x7e, 0xef, 0x57, 0xaf, 0x0b, 0x5a, 0x69, 0x96, 0x88,
+	0x84, 0x3f, 0x4b, 0x69, 0x46, 0x87, 0xfa, 0xb7, 0xad, 0x44, 0xe4, 0x31, 0xd4, 0xbf, 0x4e, 0x05,
+	0x4b, 0x62, 0x1a, 0x9d, 0x24, 0x49, 0xe4, 0xee, 0xc1, 0xfa, 0x98, 0x46, 0x23, 0x6c, 0x39, 0x87,
+	0xce, 0xd3, 0x4d, 0x4f, 0x0f, 0x48, 0x1b, 0x6a, 0x97, 0xc9, 0x00, 0xe3, 0xe3, 0x61, 0x32, 0x8a,
+	0x85, 0x7b, 0x00, 0xb5, 0x21, 0x8b, 0x93, 0xcc, 0x1f, 0xc5, 0x4c, 0x70, 0x65, 0x5a, 0xf6, 0x40,
+	0x89, 0x5e, 0x4b, 0x09, 0x11, 0xb0, 0xdd, 0x89, 0x99, 0x78, 0x75, 0xda, 0x39, 0xbd, 0x90, 0x9b,
+	0x71, 0xf7, 0x21, 0x6c, 0xc4, 0x49, 0x88, 0x3e, 0x0b, 0x95, 0x79, 0xd5, 0xab, 0xc8, 0x61, 0x27,
+	0x74, 0xdf, 0x07, 0x48, 0x47, 0x57, 0x11, 0x0b, 0xfc, 0x01, 0x4e, 0x5a, 0x25, 0xa5, 0xab, 0x6a,
+	0xc9, 0x4b, 0x9c, 0xb8, 0x1f, 0xc3, 0xce, 0x90, 0x72, 0x81, 0x99, 0x5f, 0xb0, 0x2a, 0x2b, 0xab,
+	0x86, 0x56, 0x5c, 0x58, 0x5b, 0xf2, 0x1f, 0x07, 0x5c, 0x0f, 0x7b, 0x4c, 0x4a, 0x5f, 0x25, 0x21,
+	0xfe, 0xff, 0xb6, 0x76, 0xbf, 0x0f, 0x55, 0xb5, 0x47, 0x4c, 0x87, 0xd8, 0x5a, 0x53, 0x36, 0x9b,
+	0x52, 0xf0, 0x8a, 0x0e, 0xd1, 0x75, 0x61, 0x2d, 0x4b, 0x22, 0x6c, 0xad, 0x2b, 0xb9, 0xfa, 0x2f,
+	0x9d, 0x1a, 0xd2, 0x5b, 0x9f, 0xd1, 0xa8, 0x55, 0x39, 0x74, 0x9e, 0x3a, 0x5e, 0x65, 0x48, 0x6f,
+	0x3b, 0x34, 0xb2, 0x0a, 0x4a, 0xa3, 0xd6, 0xc6, 0x54, 0x71, 0x4c, 0x23, 0x72, 0x01, 0x0d, 0x79,
+	0x28, 0x95, 0x87, 0x65, 0x27, 0x7b, 0x0c, 0x15, 0xaa, 0x52, 0xa5, 0x4e, 0x55, 0x3b, 0xaa, 0xb7,
+	0x0b, 0xe9, 0xf3, 0x8c, 0x8e, 0x9c, 0x81, 0xdb, 0x45, 0x71, 0x91, 0xb1, 0x00, 0xbf, 0x18, 0xc5,
+	0x81, 0x59, 0xd4, 0x85, 0xb5, 0xeb, 0x51, 0x1c, 0x98, 0x15, 0xd5, 0x7f, 0x97, 0xc0, 0x7a, 0x2a,
+	0xcd, 0x16, 0x2e, 0xa7, 0x55, 0xe4, 0x12, 0xdc, 0xe3, 0x30, 0x94, 0x07, 0xe6, 0x29, 0x0d, 0x6c,
+	0xf0, 0x1f, 0x41, 0x35, 0xb6, 0x22, 0xb3, 0x64, 0x2e, 0x70, 0x0f, 0xa1, 0x16, 0x22, 0x0f, 0x32,
+	0xa6, 0x20, 0x68, 0x52, 0x50, 0x14, 0x91, 0x8e, 0xf2, 0xf1, 0x0d, 0x8d, 0x58, 0x48, 0x45, 0x92,
+	0x99, 0x55, 0x67, 0x33, 0xe7, 0xcc, 0x67, 0x6e, 0x0f, 0xd6, 0xd3, 0xe4, 0x06, 0x33, 0xb5, 0x60,
+	0xd9, 0xd3, 0x03, 0xf2, 0x37, 0x07, 0xb6, 0xba, 0x98, 0x8d, 0xd9, 0xd4, 0xb9, 0xf7, 0x01, 0xb8,
+	0x16, 0xe4, 0x21, 0xac, 0x1a, 0x49, 0x27, 0x74, 0x3f, 0x84, 0xba, 0x55, 0xab, 0xbc, 0x1a, 0xf7,
+	0x8c, 0x4c, 0xa5, 0xf6, 0x00, 0x6a, 0x21, 0x15, 0xd4, 0xe7, 0x41, 0x1f, 0x87, 0xd4, 0xa0, 0x03,
+	0xa4, 0xa8, 0xab, 0x24, 0x6e, 0x1b, 0x76, 0x0b, 0x06, 0xfe, 0x18, 0x33, 0x2e, 0x4f, 0xaa, 0x21,
+	0xb2, 0x93, 0x1b, 0xbe, 0xd1, 0x0a, 0xf2, 0x47, 0x07, 0x1e, 0xbc, 0x4e, 0x43, 0x2a, 0x50, 0x26,
+	0xfb, 0x64, 0xb2, 0x4a, 0x09, 0x15, 0xb0, 0x54, 0xba, 0x0f, 0x4b, 0xe5, 0x22, 0x96, 0xde, 0x0a,
+	0x57, 0xf2, 0x1b, 0xf8, 0xc0, 0x84, 0xe9, 0x14, 0xb9, 0x60, 0x31, 0x95, 0x89, 0x98, 0xf1, 0x64,
+	0x49, 0xdc, 0x0a, 0x8e, 0x96, 0x8a, 0x8e, 0x92, 0x27, 0x50, 0x97, 0xa7, 0x5a, 0x7a, 0x22, 0xf2,
+	0x0c, 0x1a, 0xef, 0x04, 0x24, 0xf2, 0x1c, 0x1a, 0xc6, 0xe7, 0x15, 0x9d, 0x24, 0x67, 0x50, 0x7d,
+	0xcd, 0x51, 0x03, 0x4a, 0x3a, 0xd2, 0xa7, 0xbc, 0x5f, 0x70, 0x44, 0x0e, 0x3b, 0xa1, 0xdb, 0x84,
+	0x72, 0x1e, 0x56, 0xf9, 0x57, 0x62, 0xeb, 0x9a, 0x65, 0x5c, 0xa8, 0x88, 0x6e, 0x7a, 0x7a, 0x40,
+	0x7e, 0x06, 0x0f, 0x2c, 0xf3, 0x74, 0x42, 0x8c, 0x05, 0x13, 0x13, 0xe3, 0xc6, 0x21, 0xac, 0x8f,
+	0x38, 0x66, 0x92, 0x25, 0xcb, 0x4f, 0x6b, 0x47, 0xd0, 0x9e, 0xee, 0xea, 0x69, 0x05, 0xf9, 0xb7,
+	0x03, 0x7b, 0xc7, 0x41, 0x80, 0x9c, 0x27, 0xd9, 0x39, 0x8a, 0x7e, 0x12, 0x9a, 0xa9, 0x07, 0x50,
+	0xa3, 0x46, 0x9e, 0x7b, 0x06, 0x56, 0xd4, 0x09, 0xdd, 0x1f, 0xc0, 0xd6, 0xd4, 0x40, 0x4c, 0x52,
+	0x8b, 0xd0, 0xba, 0x15, 0x5e, 0x4e, 0x52, 0x94, 0x08, 0x9c, 0x1a, 0xdd, 0x21, 0xb2, 0x1d, 0xab,
+	0xba, 0x28, 0xd2, 0xde, 0xd4, 0xbe, 0x97, 0x25, 0xa3, 0x54, 0xee, 0xad, 0x31, 0xd2, 0xb0, 0x8a,
+	0x2f, 0xa5, 0x5c, 0x33, 0x68, 0x86, 0xdf, 0x8e, 0x90, 0x0b, 0x69, 0xa4, 0xf9, 0xad, 0x6a, 0x24,
+	0x9d, 0x90, 0xfc, 0xd7, 0x81, 0x87, 0x2f, 0x32, 0xa4, 0x02, 0x3b, 0x61, 0xea, 0x21, 0x4f, 0x93,
+	0x98, 0x17, 0x6a, 0xaf, 0x30, 0xd5, 0x99, 0x9b, 0xba, 0x20, 0xf0, 0x4d, 0x28, 0xe7, 0x40, 0x96,
+	0x7f, 0xdd, 0x07, 0x50, 0xe1, 0x82, 0x8a, 0x11, 0x37, 0xee, 0x99, 0x91, 0x84, 0x0a, 0x67, 0xbd,
+	0x98, 0x8a, 0x51, 0x66, 0x49, 0x37, 0x17, 0xb8, 0x1f, 0xc1, 0x36, 0x33, 0x29, 0xf2, 0xd3, 0x2c,
+	0x49, 0xae, 0x15, 0x01, 0x57, 0xbd, 0x2d, 0x2b, 0xbd, 0x90, 0x42, 0xf7, 0x47, 0xe0, 0xa6, 0x19,
+	0x1b, 0x53, 0x81, 0xda, 0xca, 0x97, 0x88, 0x50, 0x94, 0x5c, 0xf5, 0x9a, 0x46, 0xa3, 0x2c, 0xbf,
+	0xa2, 0xbc, 0x4f, 0xfe, 0xe1, 0xe4, 0x00, 0xb0, 0xb9, 0xfc, 0xae, 0x64, 0x91, 0x1c, 0xc3, 0x9e,
+	0xa6, 0x9c, 0x39, 0xe8, 0xae, 0x5e, 0x15, 0xe4, 0x0a, 0xde, 0x3b, 0xc5, 0x20, 0xa2, 0x59, 0xbe,
+	0x86, 0x8c, 0x8d, 0x59, 0xe8, 0x6e, 0xc8, 0x9d, 0x45, 0x21, 0x9f, 0x85, 0x44, 0x69, 0x1e, 0x4d,
+	0x11, 0x6c, 0x77, 0x59, 0x2f, 0x3e, 0xa5, 0x82, 0xae, 0xc6, 0x43, 0x6f, 0x5f, 0x6f, 0x16, 0x26,
+	0xe5, 0x39, 0x98, 0x90, 0x01, 0xb4, 0xee, 0xb2, 0xe0, 0xca, 0xfc, 0x37, 0x64, 0xf1, 0x0c, 0x1f,
+	0xb3, 0xd8, 0xf2, 0x31, 0x8b, 0x67, 0xf8, 0x98, 0xc5, 0xf2, 0x6e, 0xff, 0x8b, 0x03, 0x4d, 0x79,
+	0x2e, 0x4f, 0x3b, 0xa7, 0x49, 0x69, 0xc9, 0x2e, 0x8f, 0x00, 0x28, 0xf7, 0x59, 0xe8, 0x47, 0x8c,
+	0xcb, 0x7b, 0xbe, 0x2c, 0x49, 0x9c, 0xf2, 0x4e, 0x78, 0xc6, 0xb8, 0x70, 0xf7, 0xa1, 0xa2, 0xb6,
+	0xe2, 0x6a, 0xa7, 0xb2, 0xb7, 0x2e, 0x77, 0xe2, 0x12, 0x46, 0x36, 0x24, 0xaa, 0x0b, 0xe4, 0x1a,
+	0xd6, 0xe6, 0x3a, 0xca, 0x0a, 0xdb, 0x73, 0x85, 0xeb, 0xbf, 0x96, 0x61, 0x57, 0x57, 0x70, 0xd1,
+	0xb5, 0xa5, 0xd5, 0x6b, 0x23, 0x10, 0xa6, 0xe6, 0x0a, 0x56, 0x11, 0x08, 0xd3, 0x7b, 0x23, 0x50,
+	0x8c, 0xd9, 0xda, 0x4c, 0xcc, 0x9e, 0x40, 0xc3, 0xee, 0x24, 0xd8, 0x10, 0x93, 0x91, 0x50, 0x25,
+	0x5d, 0xf6, 0xb6, 0x8d, 0xf8, 0x52, 0x4b, 0xdd, 0x0f, 0xa0, 0xc6, 0xc2, 0x74, 0x1a, 0x90, 0x8a,
+	0x0a, 0x48, 0x95, 0x85, 0xa9, 0x89, 0xc8, 0x2f, 0x40, 0x5d, 0xb7, 0xbe, 0x5d, 0x4d, 0x59, 0x6d,
+	0x28, 0x52, 0xde, 0x69, 0xcf, 0x07, 0xdf, 0x6b, 0x84, 0xb9, 0x44, 0x4d, 0x7f, 0x0e, 0x7b, 0x76,
+	0xe6, 0x10, 0x39, 0xa7, 0x3d, 0xd4, 0xa1, 0xdb, 0x54, 0x67, 0x77, 0x8d, 0xee, 0x5c, 0xab, 0x64,
+	0xec, 0xdc, 0x16, 0x6c, 0xa4, 0xa3, 0x2c, 0x4d, 0x38, 0xb6, 0xaa, 0xca, 0xc8, 0x0e, 0x65, 0x8b,
+	0x35, 0x4c, 0x42, 0x6c, 0x81, 0x3a, 0x88, 0xfa, 0xef, 0x1e, 0xc1, 0xfe, 0xdc, 0x39, 0xfd, 0xab,
+	0x28, 0x09, 0x06, 0xad, 0x9a, 0x32, 0xda, 0x9d, 0x3d, 0xed, 0x89, 0x54, 0x91, 0x9f, 0x40, 0xe3,
+	0x9c, 0x7f, 0x7b, 0x1c, 0x86, 0x19, 0x72, 0xae, 0x41, 0xb3, 0x0d, 0x25, 0x96, 0x9a, 0x84, 0x94,
+	0x58, 0x2a, 0xb7, 0x4a, 0x93, 0x4c, 0x98, 0x34, 0xa8, 0xff, 0xe4, 0x0b, 0xd8, 0xeb, 0xa2, 0x38,
+	0xb7, 0x13, 0x91, 0x9b, 0xa4, 0xb6, 0xa1, 0x4a, 0xad, 0xc8, 0x5c, 0x57, 0xcd, 0xf6, 0xdc, 0x06,
+	0x5e, 0x6e, 0x42, 0xfe, 0xe4, 0x40, 0x33, 0xef, 0x55, 0x56, 0x6b, 0xcd, 0x16, 0x36, 0xd5, 0xa5,
+	0xc5, 0x4d, 0xf5, 0x0f, 0xa1, 0x39, 0xad, 0x47, 0xdd, 0x40, 0xd9, 0x3a, 0x6d, 0x4c, 0xe5, 0xaa,
+	0x7b, 0x42, 0xf2, 0x4f, 0xd5, 0xfa, 0xeb, 0x0b, 0x46, 0x35, 0x8b, 0x3a, 0x1a, 0xfb, 0x50, 0xd1,
+	0x98, 0x30, 0x8e, 0xac, 0x2b, 0x38, 0xb8, 0x6d, 0xa8, 0x8d, 0xa5, 0x91, 0x21, 0x23, 0xdd, 0xd4,
+	0x6e, 0xb5, 0x8b, 0x1f, 0x42, 0x1e, 0x28, 0x0b, 0x4d, 0x4c, 0x1f, 0x43, 0x55, 0xdb, 0x33, 0x83,
+	0xdb, 0x3b, 0xd6, 0x9b, 0x4a, 0x2f, 0xf1, 0xfa, 0x53, 0x68, 0x68, 0xdb, 0x9c, 0x5b, 0xd6, 0x16,
+	0xcd, 0xd8, 0x56, 0x56, 0xdd, 0x29, 0xdf, 0xdc, 0x82, 0xfb, 0x22, 0x4a, 0xf8, 0xbb, 0xd5, 0xd9,
+	0x0b, 0x59, 0xce, 0xfa, 0xd4, 0xbe, 0xde, 0x75, 0x4a, 0x06, 0xb5, 0xa3, 0xdd, 0xf6, 0xdd, 0x88,
+	0xc8, 0x1a, 0x2f, 0xc8, 0x24, 0xb2, 0xc9, 0x1f, 0x60, 0xbf, 0x8b, 0x42, 0x57, 0x40, 0x80, 0x6c,
+	0x8c, 0xe1, 0x6a, 0x9b, 0xcf, 0xf2, 0x53, 0x69, 0x9e, 0x9f, 0x76, 0x61, 0x5d, 0xf1, 0x93, 0x49,
+	0xd9, 0x1a, 0xe5, 0xba, 0x03, 0x94, 0xb5, 0x51, 0xec, 0x00, 0x17, 0x5e, 0x31, 0xe4, 0x1c, 0x3e,
+	0xea, 0xa2, 0x42, 0xfb, 0xd7, 0x06, 0xed, 0xf7, 0xf4, 0x57, 0x8f, 0x61, 0x5b, 0xd6, 0x8b, 0x9f,
+	0x17, 0x8c, 0xfe, 0x1c, 0xad, 0x8b, 0xc2, 0x5c, 0x72, 0x0d, 0x8d, 0x8b, 0x2c, 0xb9, 0x9d, 0xac,
+	0xf2, 0x59, 0x48, 0x60, 0x2b, 0x95, 0xb6, 0xfe, 0x6c, 0x13, 0x5b, 0x4b, 0xed, 0x02, 0x9d, 0x50,
+	0xb6, 0x1e, 0x41, 0x12, 0x5f, 0xb3, 0x9e, 0x39, 0x9d, 0x19, 0x11, 0x84, 0xf7, 0x3c, 0x1c, 0x27,
+	0x03, 0x5c, 0xd8, 0xd0, 0x3d, 0x85, 0x66, 0xa1, 0x15, 0xd0, 0xb9, 0x72, 0x14, 0x4f, 0x6d, 0xe7,
+	0xfd, 0x80, 0x62, 0x9b, 0x25, 0x57, 0xe1, 0x67, 0xb0, 0xf5, 0x12, 0x27, 0x6f, 0xe4, 0xb7, 0xb9,
+	0x06, 0x7a, 0x13, 0xca, 0xb6, 0xdc, 0xea, 0x9e, 0xfc, 0x9b, 0x7f, 0xc8, 0x97, 0x94, 0x4c, 0x0f,
+	0xc8, 0xcf, 0x61, 0xa7, 0x8b, 0x42, 0x7e, 0x9b, 0x17, 0xae, 0xd1, 0x27, 0xb0, 0x31, 0x18, 0xe7,
+	0xde, 0xd4, 0x8e, 0xb6, 0xdb, 0x33, 0xab, 0x7b, 0x95, 0xc1, 0x58, 0x21, 0x65, 0x0b, 0x6a, 0xbf,
+	0x1a, 0xa6, 0x36, 0xf4, 0xe4, 0x33, 0xf5, 0x6d, 0x76, 0x46, 0xb9, 0x0e, 0xb2, 0x59, 0xed, 0x43,
+	0xa8, 0xab, 0x3c, 0xf8, 0x7d, 0x64, 0xbd, 0xbe, 0x30, 0xe9, 0xa8, 0x29, 0xd9, 0x57, 0x4a, 0x44,
+	0x7e, 0x0d, 0xee, 0x97, 0xc9, 0x18, 0xb3, 0x98, 0xc6, 0x01, 0xbe, 0xc4, 0xc9, 0xb4, 0x58, 0x07,
+	0x38, 0x29, 0x14, 0xeb, 0x00, 0x27, 0x4b, 0xbf, 0xd2, 0xc9, 0x9f, 0x1d, 0xd8, 0xed, 0xa2, 0xc8,
+	0xd7, 0x9b, 0x1e, 0x6a, 0x6d, 0x80, 0x13, 0xcb, 0x63, 0xbb, 0xed, 0xbb, 0x1b, 0x7a, 0xca, 0x40,
+	0xb6, 0x01, 0xa2, 0x9f, 0x21, 0xef, 0x27, 0x51, 0x68, 0x68, 0x32, 0x17, 0xb8, 0x3f, 0x86, 0x07,
+	0x69, 0x96, 0xa4, 0x09, 0xa7, 0xd1, 0x1c, 0x2f, 0xeb, 0x7b, 0x75, 0xcf, 0x6a, 0x67, 0x88, 0xb9,
+	0x07, 0x7b, 0xfa, 0xd6, 0xbc, 0x30, 0xda, 0xbc, 0x17, 0x9c, 0xae, 0x96, 0xf7, 0x82, 0x56, 0xa4,
+	0x71, 0x35, 0x54, 0x88, 0xb1, 0x5f, 0x4e, 0x7a, 0x24, 0xe5, 0xfa, 0xbe, 0xb6, 0x78, 0xd3, 0x23,
+	0xf2, 0x29, 0x34, 0xed, 0x16, 0x9d, 0xd3, 0x15, 0x37, 0x21, 0x43, 0x38, 0x90, 0x08, 0x60, 0x54,
+	0xe0, 0xb9, 0xa2, 0xdc, 0x97, 0x38, 0xf1, 0x30, 0x90, 0x01, 0x9a, 0x2c, 0x2b, 0x8e, 0x4f, 0x60,
+	0x3f, 0xc6, 0x1b, 0xff, 0x3e, 0x0e, 0x77, 0x63, 0xbc, 0x39, 0x9f, 0x7b, 0x96, 0xf9, 0x25, 0xd4,
+	0x4f, 0xa8, 0x08, 0xfa, 0x97, 0xb7, 0x3a, 0xcf, 0xf9, 0x19, 0x9d, 0x7b, 0xce, 0x58, 0x9a, 0x39,
+	0xe3, 0xe7, 0x50, 0x53, 0xf3, 0x8d, 0x6b, 0x9f, 0x40, 0x5d, 0x64, 0x34, 0xe6, 0x34, 0x90, 0x6c,
+	0x6a, 0x13, 0xbc, 0xd5, 0x2e, 0xee, 0xe1, 0xcd, 0x98, 0x90, 0x0e, 0xec, 0xcb, 0x7b, 0x22, 0x1c,
+	0x45, 0xf8, 0x3a, 0xed, 0x65, 0x74, 0xca, 0x01, 0x2d, 0xd8, 0xb0, 0x5f, 0xe4, 0xda, 0x17, 0x3b,
+	0x94, 0xce, 0x18, 0xfc, 0x9a, 0x06, 0x46, 0x8f, 0xc8, 0x6f, 0x61, 0xf7, 0x52, 0x2e, 0x7d, 0x8d,
+	0x59, 0xf1, 0x25, 0xe6, 0x11, 0x80, 0x48, 0xfc, 0xd9, 0x90, 0x6d, 0x8a, 0xe4, 0xd5, 0xbb, 0x3c,
+	0xc7, 0xfc, 0x1e, 0x0e, 0x24, 0xe5, 0x49, 0x8d, 0xdd, 0xe2, 0x9b, 0x3e, 0x13, 0x28, 0x0b, 0x73,
+	0x59, 0x5a, 0x0e, 0xa1, 0x6e, 0x14, 0xc5, 0x76, 0x10, 0xb4, 0x56, 0xd5, 0xee, 0x37, 0xb2, 0x58,
+	0xf5, 0xe3, 0x47, 0xb6, 0xf2, 0x0b, 0xc8, 0x2a, 0xef, 0x3e, 0xff, 0x72, 0xf2, 0x67, 0x24, 0x6f,
+	0x14, 0xe1, 0x5b, 0x9e, 0x91, 0x9e, 0xc3, 0x56, 0x8a, 0x92, 0xfa, 0x52, 0xff, 0xfe, 0x65, 0x6b,
+	0xa9, 0xe4, 0xf8, 0x54, 0xad, 0xe7, 0xb6, 0xa1, 0x2e, 0x67, 0x50, 0x6e, 0x26, 0x94, 0x17, 0x4c,
+	0x80, 0x14, 0xb3, 0x63, 0xae, 0xed, 0x8f, 0xc1, 0xb5, 0xe7, 0x51, 0x13, 0x74, 0x34, 0xd6, 0x0c,
+	0x07, 0xdc, 0x0d, 0x80, 0xd7, 0xe4, 0x05, 0x99, 0x0a, 0xd4, 0xef, 0xe0, 0x7b, 0x5d, 0x14, 0x1e,
+	0x8e, 0x31, 0x1e, 0x61, 0xb7, 0x4f, 0xb3, 0xe2, 0xa9, 0x0e, 0x74, 0x93, 0x99, 0x62, 0x16, 0x60,
+	0x6c, 0xb9, 0x0d, 0x58, 0x98, 0x5e, 0x68, 0x89, 0x0c, 0xa8, 0x74, 0xd6, 0xe8, 0x0d, 0x9d, 0x50,
+	0x6e, 0xd4, 0xe4, 0xef, 0x0e, 0x3c, 0xec, 0xa2, 0x98, 0x3e, 0xe4, 0x9d, 0xb1, 0x21, 0x5b, 0x9a,
+	0xdc, 0x67, 0x50, 0x0f, 0x32, 0x0c, 0x99, 0xec, 0x59, 0x87, 0x6c, 0x31, 0x88, 0x6a, 0xda, 0x42,
+	0xad, 0xe7, 0x7e, 0x0e, 0xfb, 0x51, 0x72, 0xe3, 0x5f, 0xd1, 0x48, 0xf2, 0x9d, 0x9f, 0xd3, 0xdb,
+	0xa2, 0xf0, 0xed, 0x46, 0xc9, 0xcd, 0x89, 0xb6, 0xbc, 0xb4, 0x86, 0x57, 0x15, 0xf5, 0x3a, 0xfc,
+	0xe9, 0xff, 0x06, 0x00, 0x74, 0xb3, 0x00, 0x1e, 0x39, 0x16, 0x00, 0x00,
+}
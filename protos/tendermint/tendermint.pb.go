@@ -20,12 +20,39 @@ var _ = math.Inf
 // proto package needs to be updated.
 const _ = proto.ProtoPackageIsVersion2 // please upgrade the proto package
 
+type Encoding int32
+
+const (
+	Encoding_JSON     Encoding = 0
+	Encoding_PROTOBUF Encoding = 1
+)
+
+var Encoding_name = map[int32]string{
+	0: "JSON",
+	1: "PROTOBUF",
+}
+
+var Encoding_value = map[string]int32{
+	"JSON":     0,
+	"PROTOBUF": 1,
+}
+
+func (x Encoding) String() string {
+	return proto.EnumName(Encoding_name, int32(x))
+}
+
+func (Encoding) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_a91b4db4311f0d35, []int{0}
+}
+
 type Tx struct {
 	Method               string   `protobuf:"bytes,1,opt,name=method,proto3" json:"method,omitempty"`
 	Params               string   `protobuf:"bytes,2,opt,name=params,proto3" json:"params,omitempty"`
 	Nonce                []byte   `protobuf:"bytes,3,opt,name=nonce,proto3" json:"nonce,omitempty"`
 	Signature            []byte   `protobuf:"bytes,4,opt,name=signature,proto3" json:"signature,omitempty"`
 	NodeId               string   `protobuf:"bytes,5,opt,name=node_id,json=nodeId,proto3" json:"node_id,omitempty"`
+	Encoding             Encoding `protobuf:"varint,6,opt,name=encoding,proto3,enum=Encoding" json:"encoding,omitempty"`
+	EncodedParams        []byte   `protobuf:"bytes,7,opt,name=encoded_params,json=encodedParams,proto3" json:"encoded_params,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -91,6 +118,20 @@ func (m *Tx) GetNodeId() string {
 	return ""
 }
 
+func (m *Tx) GetEncoding() Encoding {
+	if m != nil {
+		return m.Encoding
+	}
+	return Encoding_JSON
+}
+
+func (m *Tx) GetEncodedParams() []byte {
+	if m != nil {
+		return m.EncodedParams
+	}
+	return nil
+}
+
 type Query struct {
 	Method               string   `protobuf:"bytes,1,opt,name=method,proto3" json:"method,omitempty"`
 	Params               string   `protobuf:"bytes,2,opt,name=params,proto3" json:"params,omitempty"`
@@ -139,6 +180,7 @@ func (m *Query) GetParams() string {
 }
 
 func init() {
+	proto.RegisterEnum("Encoding", Encoding_name, Encoding_value)
 	proto.RegisterType((*Tx)(nil), "Tx")
 	proto.RegisterType((*Query)(nil), "Query")
 }
@@ -146,15 +188,20 @@ func init() {
 func init() { proto.RegisterFile("protos/tendermint/tendermint.proto", fileDescriptor_a91b4db4311f0d35) }
 
 var fileDescriptor_a91b4db4311f0d35 = []byte{
-	// 159 bytes of a gzipped FileDescriptorProto
+	// 233 bytes of a gzipped FileDescriptorProto
 	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x52, 0x2a, 0x28, 0xca, 0x2f,
 	0xc9, 0x2f, 0xd6, 0x2f, 0x49, 0xcd, 0x4b, 0x49, 0x2d, 0xca, 0xcd, 0xcc, 0x2b, 0x41, 0x62, 0xea,
-	0x81, 0x25, 0x95, 0x1a, 0x19, 0xb9, 0x98, 0x42, 0x2a, 0x84, 0xc4, 0xb8, 0xd8, 0x72, 0x53, 0x4b,
-	0x32, 0xf2, 0x53, 0x24, 0x18, 0x15, 0x18, 0x35, 0x38, 0x83, 0xa0, 0x3c, 0x90, 0x78, 0x41, 0x62,
-	0x51, 0x62, 0x6e, 0xb1, 0x04, 0x13, 0x44, 0x1c, 0xc2, 0x13, 0x12, 0xe1, 0x62, 0xcd, 0xcb, 0xcf,
-	0x4b, 0x4e, 0x95, 0x60, 0x56, 0x60, 0xd4, 0xe0, 0x09, 0x82, 0x70, 0x84, 0x64, 0xb8, 0x38, 0x8b,
-	0x33, 0xd3, 0xf3, 0x12, 0x4b, 0x4a, 0x8b, 0x52, 0x25, 0x58, 0xc0, 0x32, 0x08, 0x01, 0x21, 0x71,
-	0x2e, 0xf6, 0xbc, 0xfc, 0x94, 0xd4, 0xf8, 0xcc, 0x14, 0x09, 0x56, 0x88, 0x61, 0x20, 0xae, 0x67,
-	0x8a, 0x92, 0x39, 0x17, 0x6b, 0x60, 0x69, 0x6a, 0x51, 0x25, 0xa9, 0xae, 0x48, 0x62, 0x03, 0xfb,
-	0xc1, 0x18, 0x10, 0x00, 0x00, 0xff, 0xff, 0x48, 0x6c, 0xd7, 0xd7, 0xe9, 0x00, 0x00, 0x00,
+	0x81, 0x25, 0x95, 0xce, 0x33, 0x72, 0x31, 0x85, 0x54, 0x08, 0x89, 0x71, 0xb1, 0xe5, 0xa6, 0x96,
+	0x64, 0xe4, 0xa7, 0x48, 0x30, 0x2a, 0x30, 0x6a, 0x70, 0x06, 0x41, 0x79, 0x20, 0xf1, 0x82, 0xc4,
+	0xa2, 0xc4, 0xdc, 0x62, 0x09, 0x26, 0x88, 0x38, 0x84, 0x27, 0x24, 0xc2, 0xc5, 0x9a, 0x97, 0x9f,
+	0x97, 0x9c, 0x2a, 0xc1, 0xac, 0xc0, 0xa8, 0xc1, 0x13, 0x04, 0xe1, 0x08, 0xc9, 0x70, 0x71, 0x16,
+	0x67, 0xa6, 0xe7, 0x25, 0x96, 0x94, 0x16, 0xa5, 0x4a, 0xb0, 0x80, 0x65, 0x10, 0x02, 0x42, 0xe2,
+	0x5c, 0xec, 0x79, 0xf9, 0x29, 0xa9, 0xf1, 0x99, 0x29, 0x12, 0xac, 0x10, 0xc3, 0x40, 0x5c, 0xcf,
+	0x14, 0x21, 0x55, 0x2e, 0x8e, 0xd4, 0xbc, 0xe4, 0xfc, 0x94, 0xcc, 0xbc, 0x74, 0x09, 0x36, 0x05,
+	0x46, 0x0d, 0x3e, 0x23, 0x4e, 0x3d, 0x57, 0xa8, 0x40, 0x10, 0x5c, 0x4a, 0x48, 0x95, 0x8b, 0x0f,
+	0xcc, 0x4e, 0x4d, 0x89, 0x87, 0xba, 0x89, 0x1d, 0x6c, 0x05, 0x2f, 0x54, 0x34, 0x00, 0x2c, 0xa8,
+	0x64, 0xce, 0xc5, 0x1a, 0x58, 0x9a, 0x5a, 0x54, 0x49, 0xaa, 0x9f, 0xb4, 0x94, 0xb8, 0x38, 0x60,
+	0xb6, 0x0a, 0x71, 0x70, 0xb1, 0x78, 0x05, 0xfb, 0xfb, 0x09, 0x30, 0x08, 0xf1, 0x70, 0x71, 0x04,
+	0x04, 0xf9, 0x87, 0xf8, 0x3b, 0x85, 0xba, 0x09, 0x30, 0x26, 0xb1, 0x81, 0x43, 0xcd, 0x18, 0x30,
+	0x00, 0xc1, 0x86, 0xb6, 0x26, 0x5b, 0x01, 0x00, 0x00,
 }
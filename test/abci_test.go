@@ -23,17 +23,25 @@
 package test
 
 import (
+	"crypto/ecdsa"
+	"crypto/ed25519"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/rsa"
 	"crypto/sha256"
 	"encoding/hex"
 	"encoding/json"
 	"fmt"
 	"log"
+	"math"
+	"strconv"
 	"strings"
 	"testing"
-	"time"
 
 	did "github.com/ndidplatform/smart-contract/abci/did/v1"
+	protoParam "github.com/ndidplatform/smart-contract/protos/param"
 	uuid "github.com/satori/go.uuid"
+	"github.com/tendermint/tendermint/libs/common"
 )
 
 var RP1 = RandStringRunes(20)
@@ -58,6 +66,9 @@ var requestID2 = uuid.NewV4()
 var requestID3 = uuid.NewV4()
 var requestID4 = uuid.NewV4()
 var requestID5 = uuid.NewV4()
+var requestID6 = uuid.NewV4()
+var requestID7 = uuid.NewV4()
+var requestID8 = uuid.NewV4()
 var namespaceID1 = RandStringRunes(20)
 var namespaceID2 = RandStringRunes(20)
 var accessorID1 = uuid.NewV4()
@@ -101,11 +112,15 @@ func createRequestInInitState(t *testing.T) {
 	CreateRequestExpectLog(t, param, rpPrivK, RP1, "Chain is not initialized")
 }
 
+func TestQueryABCIInfoAppVersion(t *testing.T) {
+	GetABCIInfoAppVersion(t, "1")
+}
+
 func TestSetLastBlock1(t *testing.T) {
 	var param did.SetLastBlockParam
 	param.BlockHeight = 0
 	SetLastBlock(t, param)
-	time.Sleep(2 * time.Second)
+	waitForNextBlock()
 }
 
 func TestCreateRequestAferSetLastBlock(t *testing.T) {
@@ -136,7 +151,7 @@ func TestSetLastBlock2(t *testing.T) {
 func TestInitData(t *testing.T) {
 	var param = did.SetPriceFuncParam{
 		"CreateRequest",
-		1,
+		1 * did.TokenScale,
 	}
 	SetPriceFunc(t, param)
 }
@@ -292,7 +307,7 @@ func TestQueryGetNodePublicKeyAS(t *testing.T) {
 func TestAddNodeTokenRP(t *testing.T) {
 	var param = did.AddNodeTokenParam{
 		RP1,
-		111.11,
+		111.11 * did.TokenScale,
 	}
 	AddNodeToken(t, param)
 }
@@ -300,7 +315,7 @@ func TestAddNodeTokenRP(t *testing.T) {
 func TestAddNodeTokenIdP(t *testing.T) {
 	var param = did.AddNodeTokenParam{
 		IdP1,
-		222.22,
+		222.22 * did.TokenScale,
 	}
 	AddNodeToken(t, param)
 }
@@ -308,7 +323,7 @@ func TestAddNodeTokenIdP(t *testing.T) {
 func TestAddNodeTokenIdP10(t *testing.T) {
 	var param = did.AddNodeTokenParam{
 		IdP10,
-		222.22,
+		222.22 * did.TokenScale,
 	}
 	AddNodeToken(t, param)
 }
@@ -316,7 +331,7 @@ func TestAddNodeTokenIdP10(t *testing.T) {
 func TestAddNodeTokenAS(t *testing.T) {
 	var param = did.AddNodeTokenParam{
 		AS1,
-		333.33,
+		333.33 * did.TokenScale,
 	}
 	AddNodeToken(t, param)
 }
@@ -326,7 +341,7 @@ func TestQueryGetNodeTokenRP(t *testing.T) {
 		RP1,
 	}
 	var expected = did.GetNodeTokenResult{
-		111.11,
+		111.11 * did.TokenScale,
 	}
 	GetNodeToken(t, param, expected)
 }
@@ -334,7 +349,7 @@ func TestQueryGetNodeTokenRP(t *testing.T) {
 func TestReduceNodeTokenRP(t *testing.T) {
 	var param = did.ReduceNodeTokenParam{
 		RP1,
-		61.11,
+		61.11 * did.TokenScale,
 	}
 	ReduceNodeToken(t, param)
 }
@@ -344,7 +359,7 @@ func TestQueryGetNodeTokenRPAfterReduce(t *testing.T) {
 		RP1,
 	}
 	var expected = did.GetNodeTokenResult{
-		50.0,
+		50.0 * did.TokenScale,
 	}
 	GetNodeToken(t, param, expected)
 }
@@ -352,7 +367,7 @@ func TestQueryGetNodeTokenRPAfterReduce(t *testing.T) {
 func TestSetNodeTokenRP(t *testing.T) {
 	var param = did.SetNodeTokenParam{
 		RP1,
-		100.0,
+		100.0 * did.TokenScale,
 	}
 	SetNodeToken(t, param)
 }
@@ -362,7 +377,32 @@ func TestQueryGetNodeTokenRPAfterSetToken(t *testing.T) {
 		RP1,
 	}
 	var expected = did.GetNodeTokenResult{
-		100.0,
+		100.0 * did.TokenScale,
+	}
+	GetNodeToken(t, param, expected)
+}
+
+func TestReduceNodeTokenRPInSteps(t *testing.T) {
+	var param = did.ReduceNodeTokenParam{
+		RP1,
+		0.1 * did.TokenScale,
+	}
+	for i := 0; i < 10; i++ {
+		ReduceNodeToken(t, param)
+	}
+	var addParam = did.AddNodeTokenParam{
+		RP1,
+		1 * did.TokenScale,
+	}
+	AddNodeToken(t, addParam)
+}
+
+func TestQueryGetNodeTokenRPAfterReduceInSteps(t *testing.T) {
+	var param = did.GetNodeTokenParam{
+		RP1,
+	}
+	var expected = did.GetNodeTokenResult{
+		100 * did.TokenScale,
 	}
 	GetNodeToken(t, param, expected)
 }
@@ -625,7 +665,7 @@ func TestQueryGetNodeTokenRPAfterCreatRequest(t *testing.T) {
 		RP1,
 	}
 	var expected = did.GetNodeTokenResult{
-		99.0,
+		99.0 * did.TokenScale,
 	}
 	GetNodeToken(t, param, expected)
 }
@@ -735,7 +775,7 @@ func TestIdPCreateIdpResponseForSpecialRequest(t *testing.T) {
 func TestNDIDSetPrice(t *testing.T) {
 	var param = did.SetPriceFuncParam{
 		"CreateRequest",
-		9.99,
+		9.99 * did.TokenScale,
 	}
 	SetPriceFunc(t, param)
 }
@@ -745,7 +785,7 @@ func TestNDIDGetPrice(t *testing.T) {
 		"CreateRequest",
 	}
 	var expected = did.GetPriceFuncResult{
-		9.99,
+		9.99 * did.TokenScale,
 	}
 	GetPriceFunc(t, param, expected)
 }
@@ -778,7 +818,7 @@ func TestQueryGetRequestDetail1(t *testing.T) {
 	var param = did.GetRequestParam{
 		requestID1.String(),
 	}
-	var expected = `{"request_id":"` + requestID1.String() + `","min_idp":1,"min_aal":3,"min_ial":3,"request_timeout":259200,"idp_id_list":["` + IdP1 + `"],"data_request_list":[{"service_id":"` + serviceID1 + `","as_id_list":["` + AS1 + `"],"min_as":1,"request_params_hash":"hash","answered_as_id_list":["` + AS1 + `"],"received_data_from_list":["` + AS1 + `"]}],"request_message_hash":"hash('Please allow...')","response_list":[{"ial":3,"aal":3,"status":"accept","signature":"signature","identity_proof":"Magic","private_proof_hash":"Magic","idp_id":"` + IdP1 + `","valid_proof":null,"valid_ial":null,"valid_signature":null}],"closed":false,"timed_out":false,"purpose":"","mode":3,"requester_node_id":"` + RP1 + `","creation_block_height":26,"creation_chain_id":"test-chain-NDID"}`
+	var expected = `{"request_id":"` + requestID1.String() + `","min_idp":1,"min_aal":3,"min_ial":3,"request_timeout":259200,"idp_id_list":["` + IdP1 + `"],"data_request_list":[{"service_id":"` + serviceID1 + `","as_id_list":["` + AS1 + `"],"min_as":1,"request_params_hash":"hash","answered_as_id_list":["` + AS1 + `"],"received_data_from_list":["` + AS1 + `"]}],"request_message_hash":"hash('Please allow...')","response_list":[{"ial":3,"aal":3,"status":"accept","signature":"signature","identity_proof":"Magic","private_proof_hash":"Magic","idp_id":"` + IdP1 + `","valid_proof":null,"valid_ial":null,"valid_signature":null}],"closed":false,"timed_out":false,"purpose":"","mode":3,"requester_node_id":"` + RP1 + `","creation_block_height":26,"creation_chain_id":"test-chain-NDID","expire_block_height":17306}`
 	GetRequestDetail(t, param, expected)
 }
 
@@ -798,6 +838,38 @@ func TestRPCloseRequest(t *testing.T) {
 	CloseRequest(t, param, RP1)
 }
 
+func TestQueryGetNodeTokenRPAfterCloseRequest(t *testing.T) {
+	var param = did.GetNodeTokenParam{
+		RP1,
+	}
+	var expected = did.GetNodeTokenResult{
+		97.0 * did.TokenScale,
+	}
+	GetNodeToken(t, param, expected)
+}
+
+func TestQueryGetNodeTokenIdPAfterCloseRequest(t *testing.T) {
+	var param = did.GetNodeTokenParam{
+		IdP1,
+	}
+	// Half of the held price of the request
+	var expected = did.GetNodeTokenResult{
+		216.72 * did.TokenScale,
+	}
+	GetNodeToken(t, param, expected)
+}
+
+func TestQueryGetNodeTokenASAfterCloseRequest(t *testing.T) {
+	var param = did.GetNodeTokenParam{
+		AS1,
+	}
+	// Half of the held price of the request
+	var expected = did.GetNodeTokenResult{
+		329.83 * did.TokenScale,
+	}
+	GetNodeToken(t, param, expected)
+}
+
 func TestQueryGetRequestClosed(t *testing.T) {
 	var param = did.GetRequestParam{
 		requestID1.String(),
@@ -815,7 +887,7 @@ func TestQueryGetRequestDetail2(t *testing.T) {
 	var param = did.GetRequestParam{
 		requestID1.String(),
 	}
-	var expected = `{"request_id":"` + requestID1.String() + `","min_idp":1,"min_aal":3,"min_ial":3,"request_timeout":259200,"idp_id_list":["` + IdP1 + `"],"data_request_list":[{"service_id":"` + serviceID1 + `","as_id_list":["` + AS1 + `"],"min_as":1,"request_params_hash":"hash","answered_as_id_list":["` + AS1 + `"],"received_data_from_list":["` + AS1 + `"]}],"request_message_hash":"hash('Please allow...')","response_list":[{"ial":3,"aal":3,"status":"accept","signature":"signature","identity_proof":"Magic","private_proof_hash":"Magic","idp_id":"` + IdP1 + `","valid_proof":true,"valid_ial":true,"valid_signature":true}],"closed":true,"timed_out":false,"purpose":"","mode":3,"requester_node_id":"` + RP1 + `","creation_block_height":26,"creation_chain_id":"test-chain-NDID"}`
+	var expected = `{"request_id":"` + requestID1.String() + `","min_idp":1,"min_aal":3,"min_ial":3,"request_timeout":259200,"idp_id_list":["` + IdP1 + `"],"data_request_list":[{"service_id":"` + serviceID1 + `","as_id_list":["` + AS1 + `"],"min_as":1,"request_params_hash":"hash","answered_as_id_list":["` + AS1 + `"],"received_data_from_list":["` + AS1 + `"]}],"request_message_hash":"hash('Please allow...')","response_list":[{"ial":3,"aal":3,"status":"accept","signature":"signature","identity_proof":"Magic","private_proof_hash":"Magic","idp_id":"` + IdP1 + `","valid_proof":true,"valid_ial":true,"valid_signature":true}],"closed":true,"timed_out":false,"purpose":"","mode":3,"requester_node_id":"` + RP1 + `","creation_block_height":26,"creation_chain_id":"test-chain-NDID","expire_block_height":17306}`
 	GetRequestDetail(t, param, expected)
 }
 
@@ -888,11 +960,22 @@ func TestRPTimeOutRequest(t *testing.T) {
 	TimeOutRequest(t, param, RP1)
 }
 
+func TestQueryGetNodeTokenRPAfterTimeOutRequest(t *testing.T) {
+	var param = did.GetNodeTokenParam{
+		RP1,
+	}
+	// Only the price of TimeOutRequest, the held price of the request is refunded
+	var expected = did.GetNodeTokenResult{
+		96.0 * did.TokenScale,
+	}
+	GetNodeToken(t, param, expected)
+}
+
 func TestQueryGetRequestDetail3(t *testing.T) {
 	var param = did.GetRequestParam{
 		requestID3.String(),
 	}
-	var expected = `{"request_id":"` + requestID3.String() + `","min_idp":1,"min_aal":3,"min_ial":3,"request_timeout":259200,"idp_id_list":["` + IdP1 + `"],"data_request_list":[{"service_id":"` + serviceID1 + `","as_id_list":["` + AS1 + `"],"min_as":2,"request_params_hash":"hash","answered_as_id_list":[],"received_data_from_list":[]},{"service_id":"credit","as_id_list":["` + AS1 + `"],"min_as":2,"request_params_hash":"hash","answered_as_id_list":[],"received_data_from_list":[]}],"request_message_hash":"hash('Please allow...')","response_list":[{"ial":3,"aal":3,"status":"accept","signature":"signature","identity_proof":"Magic","private_proof_hash":"Magic","idp_id":"` + IdP1 + `","valid_proof":false,"valid_ial":false,"valid_signature":false}],"closed":false,"timed_out":true,"purpose":"","mode":3,"requester_node_id":"` + RP1 + `","creation_block_height":38,"creation_chain_id":"test-chain-NDID"}`
+	var expected = `{"request_id":"` + requestID3.String() + `","min_idp":1,"min_aal":3,"min_ial":3,"request_timeout":259200,"idp_id_list":["` + IdP1 + `"],"data_request_list":[{"service_id":"` + serviceID1 + `","as_id_list":["` + AS1 + `"],"min_as":2,"request_params_hash":"hash","answered_as_id_list":[],"received_data_from_list":[]},{"service_id":"credit","as_id_list":["` + AS1 + `"],"min_as":2,"request_params_hash":"hash","answered_as_id_list":[],"received_data_from_list":[]}],"request_message_hash":"hash('Please allow...')","response_list":[{"ial":3,"aal":3,"status":"accept","signature":"signature","identity_proof":"Magic","private_proof_hash":"Magic","idp_id":"` + IdP1 + `","valid_proof":false,"valid_ial":false,"valid_signature":false}],"closed":false,"timed_out":true,"purpose":"","mode":3,"requester_node_id":"` + RP1 + `","creation_block_height":38,"creation_chain_id":"test-chain-NDID","expire_block_height":17318}`
 	GetRequestDetail(t, param, expected)
 }
 
@@ -909,6 +992,115 @@ func TestQueryGetRequestTimedOut(t *testing.T) {
 	GetRequest(t, param, expected)
 }
 
+func TestCreateRequestWithNegativeTimeoutBlock(t *testing.T) {
+	var param did.Request
+	param.RequestID = requestID6.String()
+	param.MinIdp = 1
+	param.MinAal = 3
+	param.MinIal = 3
+	param.Timeout = 259200
+	param.TimeoutBlock = -1
+	param.IdPIDList = append(param.IdPIDList, IdP1)
+	param.MessageHash = "hash('Please allow...')"
+	param.Mode = 1
+	CreateRequestExpectLogDeliverTx(t, param, rpPrivK, RP1, "Request timeout block must not be negative")
+}
+
+func TestCreateRequestWithTooLargeTimeoutBlock(t *testing.T) {
+	var param did.Request
+	param.RequestID = requestID6.String()
+	param.MinIdp = 1
+	param.MinAal = 3
+	param.MinIal = 3
+	param.Timeout = 259200
+	param.TimeoutBlock = math.MaxInt64
+	param.IdPIDList = append(param.IdPIDList, IdP1)
+	param.MessageHash = "hash('Please allow...')"
+	param.Mode = 1
+	CreateRequestExpectLogDeliverTx(t, param, rpPrivK, RP1, "Request timeout block must not be greater than 518400")
+}
+
+func TestCreateRequestExpireInOneBlock(t *testing.T) {
+	var param did.Request
+	param.RequestID = requestID6.String()
+	param.MinIdp = 1
+	param.MinAal = 3
+	param.MinIal = 3
+	param.Timeout = 259200
+	param.TimeoutBlock = 1
+	param.IdPIDList = append(param.IdPIDList, IdP1)
+	param.MessageHash = "hash('Please allow...')"
+	param.Mode = 1
+	CreateRequest(t, param, rpPrivK, RP1)
+}
+
+func TestCreateRequestAfterExpiringRequest(t *testing.T) {
+	var param did.Request
+	param.RequestID = requestID7.String()
+	param.MinIdp = 1
+	param.MinAal = 3
+	param.MinIal = 3
+	param.Timeout = 259200
+	param.IdPIDList = append(param.IdPIDList, IdP1)
+	param.MessageHash = "hash('Please allow...')"
+	param.Mode = 1
+	CreateRequest(t, param, rpPrivK, RP1)
+}
+
+func TestIdPCreateIdpResponseAfterExpiry(t *testing.T) {
+	var param = did.CreateIdpResponseParam{
+		requestID6.String(),
+		3,
+		3,
+		"accept",
+		"signature",
+		"",
+		"",
+	}
+	CreateIdpResponseExpectLog(t, param, idpPrivK, IdP1, "Can't response a request that's timed out")
+}
+
+func TestQueryGetRequestExpired(t *testing.T) {
+	var param = did.GetRequestParam{
+		requestID6.String(),
+	}
+	var expected = did.GetRequestResult{
+		false,
+		true,
+		"hash('Please allow...')",
+		1,
+	}
+	GetRequest(t, param, expected)
+}
+
+func TestCreateRequestTags(t *testing.T) {
+	var data1 did.DataRequest
+	data1.ServiceID = serviceID1
+	data1.As = []string{
+		AS1,
+	}
+	data1.Count = 1
+	data1.RequestParamsHash = "hash"
+	var param did.Request
+	param.RequestID = requestID8.String()
+	param.MinIdp = 1
+	param.MinAal = 3
+	param.MinIal = 3
+	param.Timeout = 259200
+	param.IdPIDList = append(param.IdPIDList, IdP1)
+	param.DataRequestList = append(param.DataRequestList, data1)
+	param.MessageHash = "hash('Please allow...')"
+	param.Mode = 1
+	expected := []common.KVPair{
+		{Key: []byte("success"), Value: []byte("true")},
+		{Key: []byte("method"), Value: []byte("CreateRequest")},
+		{Key: []byte("node_id"), Value: []byte(RP1)},
+		{Key: []byte("request_id"), Value: []byte(requestID8.String())},
+		{Key: []byte("service_id"), Value: []byte(serviceID1)},
+	}
+	CreateRequestExpectTags(t, param, rpPrivK, RP1, expected)
+}
+
 func TestDisableOldNamespace(t *testing.T) {
 	namespaces := GetNamespaceListForDisable(t)
 	for _, namespace := range namespaces {
@@ -1157,6 +1349,36 @@ func TestQueryGetAccessorKey(t *testing.T) {
 	GetAccessorKey(t, param, expected)
 }
 
+var accessorIDEd25519 = uuid.NewV4()
+var accessorGroupIDEd25519 = uuid.NewV4()
+var accessorPublicKeyEd25519, _, _ = ed25519.GenerateKey(rand.Reader)
+
+func TestIdPRegisterAccessorWithEd25519Key(t *testing.T) {
+	accessorPublicKeyBytes, err := generatePublicKey(accessorPublicKeyEd25519)
+	if err != nil {
+		log.Fatal(err.Error())
+	}
+	var param = did.RegisterAccessorParam{
+		accessorIDEd25519.String(),
+		"accessor_type",
+		string(accessorPublicKeyBytes),
+		accessorGroupIDEd25519.String(),
+	}
+	RegisterAccessor(t, param, IdP1)
+}
+
+func TestQueryGetAccessorKeyEd25519(t *testing.T) {
+	accessorPublicKeyBytes, err := generatePublicKey(accessorPublicKeyEd25519)
+	if err != nil {
+		log.Fatal(err.Error())
+	}
+	var param = did.GetAccessorGroupIDParam{
+		accessorIDEd25519.String(),
+	}
+	var expected = `{"accessor_public_key":"` + strings.Replace(string(accessorPublicKeyBytes), "\n", "\\n", -1) + `","active":true}`
+	GetAccessorKey(t, param, expected)
+}
+
 func TestDisableOldIdPNode2(t *testing.T) {
 	var param did.GetIdpNodesParam
 	param.MinIal = 3
@@ -1216,10 +1438,31 @@ func TestIdPUpdateNode(t *testing.T) {
 	var param = did.UpdateNodeParam{
 		string(idpPublicKeyBytes2),
 		"",
+		"",
 	}
 	UpdateNode(t, param, allMasterKey, IdP1)
 }
 
+func TestQueryGetNodePublicKeyHistoryIdP1(t *testing.T) {
+	idpKey := getPrivateKeyFromString(idpPrivK)
+	idpPublicKeyBytes, err := generatePublicKey(&idpKey.PublicKey)
+	if err != nil {
+		log.Fatal(err.Error())
+	}
+	idpKey2 := getPrivateKeyFromString(idpPrivK2)
+	idpPublicKeyBytes2, err := generatePublicKey(&idpKey2.PublicKey)
+	if err != nil {
+		log.Fatal(err.Error())
+	}
+	var param did.GetNodePublicKeyHistoryParam
+	param.NodeID = IdP1
+	expected := []string{
+		string(idpPublicKeyBytes),
+		string(idpPublicKeyBytes2),
+	}
+	GetNodePublicKeyHistory(t, param, expected)
+}
+
 func TestSetValidator(t *testing.T) {
 	var param did.SetValidatorParam
 	param.PublicKey = getValidatorPubkey()
@@ -1240,7 +1483,7 @@ func TestDisableOldService(t *testing.T) {
 }
 
 func TestQueryGetServiceList(t *testing.T) {
-	var expected = `[{"service_id":"` + serviceID1 + `","service_name":"Bank statement (ย้อนหลัง 3 เดือน)","active":true}]`
+	var expected = `{"service_list":[{"service_id":"` + serviceID1 + `","service_name":"Bank statement (ย้อนหลัง 3 เดือน)","active":true}]}`
 	GetServiceList(t, expected)
 }
 
@@ -1372,7 +1615,7 @@ func TestRegisterNodeIDP5(t *testing.T) {
 func TestSetNodeTokenIDP4(t *testing.T) {
 	var param = did.SetNodeTokenParam{
 		IdP4,
-		100.0,
+		100.0 * did.TokenScale,
 	}
 	SetNodeToken(t, param)
 }
@@ -1380,7 +1623,7 @@ func TestSetNodeTokenIDP4(t *testing.T) {
 func TestSetNodeTokenIDP5(t *testing.T) {
 	var param = did.SetNodeTokenParam{
 		IdP5,
-		100.0,
+		100.0 * did.TokenScale,
 	}
 	SetNodeToken(t, param)
 }
@@ -1394,6 +1637,7 @@ func TestIdPUpdateNode4(t *testing.T) {
 	var param = did.UpdateNodeParam{
 		string(idpPublicKeyBytes2),
 		"",
+		"",
 	}
 	UpdateNode(t, param, allMasterKey, IdP4)
 }
@@ -1407,6 +1651,7 @@ func TestIdPUpdateNode5(t *testing.T) {
 	var param = did.UpdateNodeParam{
 		string(idpPublicKeyBytes2),
 		string(idpPublicKeyBytes2),
+		"",
 	}
 	UpdateNode(t, param, allMasterKey, IdP5)
 }
@@ -1558,7 +1803,7 @@ func TestRegisterNodeAS2(t *testing.T) {
 func TestSetNodeTokenAS2(t *testing.T) {
 	var param = did.SetNodeTokenParam{
 		AS2,
-		100.0,
+		100.0 * did.TokenScale,
 	}
 	SetNodeToken(t, param)
 }
@@ -1844,7 +2089,7 @@ func TestEnableNamespace(t *testing.T) {
 }
 
 func TestQueryGetNamespaceList2(t *testing.T) {
-	expected := `[{"namespace":"` + namespaceID1 + `","description":"Citizen ID","active":true},{"namespace":"` + namespaceID2 + `","description":"Tel number","active":true}]`
+	expected := `{"namespace_list":[{"namespace":"` + namespaceID1 + `","description":"Citizen ID","active":true},{"namespace":"` + namespaceID2 + `","description":"Tel number","active":true}]}`
 	GetNamespaceListExpectString(t, expected)
 }
 
@@ -2240,6 +2485,7 @@ func TestUpdateNodeNDID(t *testing.T) {
 	var param = did.UpdateNodeParam{
 		string(ndidpublicKeyBytes),
 		"",
+		"",
 	}
 	UpdateNode(t, param, ndidPrivK, "NDID")
 }
@@ -2292,7 +2538,7 @@ func TestRegisterProxyNode(t *testing.T) {
 func TestSetNodeTokenProxy1(t *testing.T) {
 	var param = did.SetNodeTokenParam{
 		Proxy1,
-		100.0,
+		100.0 * did.TokenScale,
 	}
 	SetNodeToken(t, param)
 }
@@ -2324,7 +2570,7 @@ func TestRegisterIdP6BehindProxy1(t *testing.T) {
 func TestSetNodeTokenIdP6BehindProxy1(t *testing.T) {
 	var param = did.SetNodeTokenParam{
 		IdP6BehindProxy1,
-		100.0,
+		100.0 * did.TokenScale,
 	}
 	SetNodeToken(t, param)
 }
@@ -2466,7 +2712,7 @@ func TestRegisterAS3BehindProxy1(t *testing.T) {
 func TestSetNodeTokenAS3BehindProxy1(t *testing.T) {
 	var param = did.SetNodeTokenParam{
 		AS3BehindProxy1,
-		100.0,
+		100.0 * did.TokenScale,
 	}
 	SetNodeToken(t, param)
 }
@@ -2544,7 +2790,7 @@ func TestRegisterProxyNodeProxy2(t *testing.T) {
 func TestSetNodeTokenProxy2(t *testing.T) {
 	var param = did.SetNodeTokenParam{
 		Proxy2,
-		100.0,
+		100.0 * did.TokenScale,
 	}
 	SetNodeToken(t, param)
 }
@@ -2687,6 +2933,37 @@ func TestQueryGetNodeIDListAS(t *testing.T) {
 	GetNodeIDList(t, param, expected)
 }
 
+func TestQueryGetNodeIDListIdPFirstPage(t *testing.T) {
+	var param did.GetNodeIDListParam
+	param.Role = "IdP"
+	param.Limit = 2
+	expected := string(`{"node_id_list":["` + IdP1 + `","` + IdP4 + `"],"next_cursor":"` + IdP4 + `"}`)
+	GetNodeIDList(t, param, expected)
+}
+
+func TestQueryGetNodeIDListIdPNextPage(t *testing.T) {
+	var param did.GetNodeIDListParam
+	param.Role = "IdP"
+	param.Limit = 2
+	param.Cursor = IdP4
+	expected := string(`{"node_id_list":["` + IdP5 + `","` + IdP6BehindProxy1 + `"]}`)
+	GetNodeIDList(t, param, expected)
+}
+
+func TestQueryGetNodeIDListInvalidCursor(t *testing.T) {
+	var param did.GetNodeIDListParam
+	param.Cursor = "not-a-node"
+	expected := "Invalid cursor"
+	GetNodeIDList(t, param, expected)
+}
+
+func TestQueryGetNodeIDListNamePrefix(t *testing.T) {
+	var param did.GetNodeIDListParam
+	param.NamePrefix = "IdP6"
+	expected := string(`{"node_id_list":["` + IdP6BehindProxy1 + `"]}`)
+	GetNodeIDList(t, param, expected)
+}
+
 func TestDisableAllNode(t *testing.T) {
 	var param did.GetNodeIDListParam
 	allNode := GetNodeIDListForDisable(t, param)
@@ -2696,3 +2973,1155 @@ func TestDisableAllNode(t *testing.T) {
 		DisableNode(t, param)
 	}
 }
+
+var RP2 = RandStringRunes(20)
+var rp2Key, _ = ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+var rp2MasterPublicKey, _, _ = ed25519.GenerateKey(rand.Reader)
+
+func TestRegisterNodeRP2WithECDSAKey(t *testing.T) {
+	rpPublicKeyBytes, err := generatePublicKey(&rp2Key.PublicKey)
+	if err != nil {
+		log.Fatal(err.Error())
+	}
+	rpMasterPublicKeyBytes, err := generatePublicKey(rp2MasterPublicKey)
+	if err != nil {
+		log.Fatal(err.Error())
+	}
+	var param did.RegisterNode
+	param.NodeID = RP2
+	param.PublicKey = string(rpPublicKeyBytes)
+	param.MasterPublicKey = string(rpMasterPublicKeyBytes)
+	param.Role = "RP"
+	param.NodeName = "Node RP 2"
+	RegisterNode(t, param)
+}
+
+func TestSetNodeTokenRP2(t *testing.T) {
+	var param = did.SetNodeTokenParam{
+		RP2,
+		100.0 * did.TokenScale,
+	}
+	SetNodeToken(t, param)
+}
+
+func TestSetMqAddressesRP2WithECDSAKey(t *testing.T) {
+	var mq did.MsqAddress
+	mq.IP = "192.168.3.99"
+	mq.Port = 8000
+	var param did.SetMqAddressesParam
+	param.Addresses = make([]did.MsqAddress, 0)
+	param.Addresses = append(param.Addresses, mq)
+	SetMqAddressesWithECDSAKey(t, param, rp2Key, RP2)
+}
+
+var RP3 = RandStringRunes(20)
+
+func TestRegisterNodeRP3(t *testing.T) {
+	rpKey := getPrivateKeyFromString(rpPrivK)
+	rpPublicKeyBytes, err := generatePublicKey(&rpKey.PublicKey)
+	if err != nil {
+		log.Fatal(err.Error())
+	}
+	rpKey2 := getPrivateKeyFromString(allMasterKey)
+	rpPublicKeyBytes2, err := generatePublicKey(&rpKey2.PublicKey)
+	if err != nil {
+		log.Fatal(err.Error())
+	}
+	var param did.RegisterNode
+	param.NodeID = RP3
+	param.PublicKey = string(rpPublicKeyBytes)
+	param.MasterPublicKey = string(rpPublicKeyBytes2)
+	param.Role = "RP"
+	param.NodeName = "Node RP 3"
+	RegisterNode(t, param)
+}
+
+func TestSetNodeTokenRP3(t *testing.T) {
+	var param = did.SetNodeTokenParam{
+		RP3,
+		100.0 * did.TokenScale,
+	}
+	SetNodeToken(t, param)
+}
+
+func TestRP3UpdateNodeSignatureSchemePSS(t *testing.T) {
+	var param did.UpdateNodeParam
+	param.SignatureScheme = "PSS"
+	UpdateNode(t, param, allMasterKey, RP3)
+}
+
+func TestSetMqAddressesRP3WithPSS(t *testing.T) {
+	var mq did.MsqAddress
+	mq.IP = "192.168.3.99"
+	mq.Port = 8000
+	var param did.SetMqAddressesParam
+	param.Addresses = make([]did.MsqAddress, 0)
+	param.Addresses = append(param.Addresses, mq)
+	SetMqAddressesWithPSS(t, param, rpPrivK, RP3)
+}
+
+var governanceKey1, _ = rsa.GenerateKey(rand.Reader, 2048)
+var governanceKey2, _ = rsa.GenerateKey(rand.Reader, 2048)
+var proposalID1 = RandStringRunes(20)
+var proposalID2 = RandStringRunes(20)
+var proposalID3 = RandStringRunes(20)
+
+func TestSetGovernance(t *testing.T) {
+	publicKeyBytes1, err := generatePublicKey(&governanceKey1.PublicKey)
+	if err != nil {
+		log.Fatal(err.Error())
+	}
+	publicKeyBytes2, err := generatePublicKey(&governanceKey2.PublicKey)
+	if err != nil {
+		log.Fatal(err.Error())
+	}
+	var param did.SetGovernanceParam
+	param.Keys = append(param.Keys, did.GovernanceKey{"governance1", string(publicKeyBytes1)})
+	param.Keys = append(param.Keys, did.GovernanceKey{"governance2", string(publicKeyBytes2)})
+	param.Threshold = 2
+	param.ProposalTimeoutBlock = 100
+	SetGovernance(t, param)
+}
+
+func TestNDIDMethodRequiresProposal(t *testing.T) {
+	var param did.TimeOutBlockRegisterIdentity
+	param.TimeOutBlock = 50
+	SetTimeOutBlockRegisterIdentityExpectedCheckTxString(t, param, "NDID method must be proposed through governance")
+}
+
+func TestCreateProposalSetTimeOutBlockRegisterIdentity(t *testing.T) {
+	var timeOut did.TimeOutBlockRegisterIdentity
+	timeOut.TimeOutBlock = 50
+	timeOutJSON, err := json.Marshal(timeOut)
+	if err != nil {
+		log.Fatal(err.Error())
+	}
+	var param did.CreateProposalParam
+	param.ProposalID = proposalID1
+	param.Method = "SetTimeOutBlockRegisterIdentity"
+	param.Params = string(timeOutJSON)
+	CreateProposal(t, param, governanceKey1, "governance1", "pending")
+}
+
+func TestApproveProposalSetTimeOutBlockRegisterIdentity(t *testing.T) {
+	var param did.ApproveProposalParam
+	param.ProposalID = proposalID1
+	ApproveProposal(t, param, governanceKey2, "governance2", "executed")
+}
+
+func TestQueryGetProposal(t *testing.T) {
+	var param did.GetProposalParam
+	param.ProposalID = proposalID1
+	GetProposal(t, param, "executed")
+}
+
+func TestCreateProposalDisableUnknownNode(t *testing.T) {
+	var disableNode did.DisableNodeParam
+	disableNode.NodeID = RandStringRunes(20)
+	disableNodeJSON, err := json.Marshal(disableNode)
+	if err != nil {
+		log.Fatal(err.Error())
+	}
+	var param did.CreateProposalParam
+	param.ProposalID = proposalID3
+	param.Method = "DisableNode"
+	param.Params = string(disableNodeJSON)
+	CreateProposal(t, param, governanceKey1, "governance1", "pending")
+}
+
+func TestApproveProposalDisableUnknownNode(t *testing.T) {
+	var param did.ApproveProposalParam
+	param.ProposalID = proposalID3
+	ApproveProposal(t, param, governanceKey2, "governance2", "failed")
+}
+
+func TestQueryGetProposalDisableUnknownNode(t *testing.T) {
+	var param did.GetProposalParam
+	param.ProposalID = proposalID3
+	GetProposal(t, param, "failed")
+}
+
+func TestDisableGovernance(t *testing.T) {
+	var governance did.SetGovernanceParam
+	governanceJSON, err := json.Marshal(governance)
+	if err != nil {
+		log.Fatal(err.Error())
+	}
+	var param did.CreateProposalParam
+	param.ProposalID = proposalID2
+	param.Method = "SetGovernance"
+	param.Params = string(governanceJSON)
+	CreateProposal(t, param, governanceKey1, "governance1", "pending")
+	var approveParam did.ApproveProposalParam
+	approveParam.ProposalID = proposalID2
+	ApproveProposal(t, approveParam, governanceKey2, "governance2", "executed")
+}
+
+var RP4 = RandStringRunes(20)
+var rp4NewMasterKey, _ = rsa.GenerateKey(rand.Reader, 2048)
+
+func TestRegisterNodeRP4(t *testing.T) {
+	rpKey := getPrivateKeyFromString(rpPrivK)
+	rpPublicKeyBytes, err := generatePublicKey(&rpKey.PublicKey)
+	if err != nil {
+		log.Fatal(err.Error())
+	}
+	rpKey2 := getPrivateKeyFromString(allMasterKey)
+	rpPublicKeyBytes2, err := generatePublicKey(&rpKey2.PublicKey)
+	if err != nil {
+		log.Fatal(err.Error())
+	}
+	var param did.RegisterNode
+	param.NodeID = RP4
+	param.PublicKey = string(rpPublicKeyBytes)
+	param.MasterPublicKey = string(rpPublicKeyBytes2)
+	param.Role = "RP"
+	param.NodeName = "Node RP 4"
+	RegisterNode(t, param)
+}
+
+func TestSetNodeTokenRP4(t *testing.T) {
+	var param = did.SetNodeTokenParam{
+		RP4,
+		100.0 * did.TokenScale,
+	}
+	SetNodeToken(t, param)
+}
+
+func TestInitiateMasterKeyRecoveryRP4(t *testing.T) {
+	newMasterPublicKeyBytes, err := generatePublicKey(&rp4NewMasterKey.PublicKey)
+	if err != nil {
+		log.Fatal(err.Error())
+	}
+	var param did.InitiateMasterKeyRecoveryParam
+	param.NodeID = RP4
+	param.NewMasterPublicKey = string(newMasterPublicKeyBytes)
+	InitiateMasterKeyRecovery(t, param)
+}
+
+func TestQueryGetMasterKeyRecoveryRP4Pending(t *testing.T) {
+	var param did.GetMasterKeyRecoveryParam
+	param.NodeID = RP4
+	GetMasterKeyRecovery(t, param, "pending")
+}
+
+func TestCancelMasterKeyRecoveryRP4(t *testing.T) {
+	CancelMasterKeyRecovery(t, allMasterKey, RP4)
+}
+
+func TestQueryGetMasterKeyRecoveryRP4Cancelled(t *testing.T) {
+	var param did.GetMasterKeyRecoveryParam
+	param.NodeID = RP4
+	GetMasterKeyRecovery(t, param, "cancelled")
+}
+
+func TestBatchSetMqAddressesRP4(t *testing.T) {
+	var param did.BatchParam
+	param.Transactions = append(param.Transactions, did.BatchTx{"SetMqAddresses", `{"addresses":[{"ip":"192.168.3.99","port":8000}]}`})
+	param.Transactions = append(param.Transactions, did.BatchTx{"SetMqAddresses", `{"addresses":[{"ip":"192.168.3.99","port":8001}]}`})
+	Batch(t, param, rpPrivK, RP4, "success")
+}
+
+func TestBatchRollbackRP4(t *testing.T) {
+	var param did.BatchParam
+	param.Transactions = append(param.Transactions, did.BatchTx{"SetMqAddresses", `{"addresses":[{"ip":"192.168.3.99","port":8002}]}`})
+	param.Transactions = append(param.Transactions, did.BatchTx{"TransferToken", `{"to_node_id":"` + RP4 + `","amount":0}`})
+	Batch(t, param, rpPrivK, RP4, "Transaction 1 (TransferToken): Can not transfer token to self")
+}
+
+func TestBatchCheckTxRequestNotFoundRP4(t *testing.T) {
+	var param did.BatchParam
+	param.Transactions = append(param.Transactions, did.BatchTx{"SetMqAddresses", `{"addresses":[{"ip":"192.168.3.99","port":8003}]}`})
+	param.Transactions = append(param.Transactions, did.BatchTx{"CloseRequest", `{"request_id":"` + RandStringRunes(20) + `"}`})
+	BatchExpectLog(t, param, rpPrivK, RP4, "Transaction 1: Request ID not found")
+}
+
+func TestBatchCheckTxNoPermissionRP4(t *testing.T) {
+	var param did.BatchParam
+	param.Transactions = append(param.Transactions, did.BatchTx{"SetMqAddresses", `{"addresses":[{"ip":"192.168.3.99","port":8003}]}`})
+	param.Transactions = append(param.Transactions, did.BatchTx{"UpdateIdentity", `{"hash_id":"hash","ial":3}`})
+	BatchExpectLog(t, param, rpPrivK, RP4, "Transaction 1: This node does not have permission to call IdP method")
+}
+
+func TestQueryGetMqAddressesRP4AfterBatch(t *testing.T) {
+	var param = did.GetMqAddressesParam{
+		RP4,
+	}
+	var expected = []did.MsqAddress{
+		did.MsqAddress{
+			"192.168.3.99",
+			8001,
+		},
+	}
+	GetMqAddresses(t, param, expected)
+}
+
+func TestQueryGetNodeTokenRP4AfterBatch(t *testing.T) {
+	var param = did.GetNodeTokenParam{
+		RP4,
+	}
+	var expected = did.GetNodeTokenResult{
+		97.0 * did.TokenScale,
+	}
+	GetNodeToken(t, param, expected)
+}
+
+func TestSetMqAddressesProtobufRP4(t *testing.T) {
+	var param protoParam.SetMqAddressesParams
+	param.Addresses = append(param.Addresses, &protoParam.MsqAddressParam{Ip: "192.168.3.100", Port: 8000})
+	SetMqAddressesProtobuf(t, param, rpPrivK, RP4)
+}
+
+func TestQueryGetMqAddressesRP4AfterProtobuf(t *testing.T) {
+	var param = did.GetMqAddressesParam{
+		RP4,
+	}
+	var expected = []did.MsqAddress{
+		did.MsqAddress{
+			"192.168.3.100",
+			8000,
+		},
+	}
+	GetMqAddresses(t, param, expected)
+}
+
+func TestCreateRequestWithoutRequestID(t *testing.T) {
+	var param did.Request
+	param.MinIdp = 1
+	param.MinIal = 3
+	param.MinAal = 3
+	param.Timeout = 259200
+	param.MessageHash = "hash('Please allow...')"
+	param.Mode = 3
+	CreateRequestExpectLog(t, param, rpPrivK, RP4, "Invalid parameter: request_id is required")
+}
+
+func TestCreateRequestWithoutServiceID(t *testing.T) {
+	var datas []did.DataRequest
+	var data1 did.DataRequest
+	data1.Count = 1
+	data1.RequestParamsHash = "hash"
+	datas = append(datas, data1)
+	var param did.Request
+	param.RequestID = RandStringRunes(20)
+	param.MinIdp = 1
+	param.MinIal = 3
+	param.MinAal = 3
+	param.Timeout = 259200
+	param.DataRequestList = datas
+	param.MessageHash = "hash('Please allow...')"
+	param.Mode = 3
+	CreateRequestExpectLog(t, param, rpPrivK, RP4, "Invalid parameter: data_request_list[0].service_id is required")
+}
+
+func TestQueryGetNodePublicKeyRP4WithProof(t *testing.T) {
+	var param = did.GetNodePublicKeyParam{
+		RP4,
+	}
+	rpKey := getPrivateKeyFromString(rpPrivK)
+	rpPublicKeyBytes, err := generatePublicKey(&rpKey.PublicKey)
+	if err != nil {
+		log.Fatal(err.Error())
+	}
+	GetNodePublicKeyWithProof(t, param, string(rpPublicKeyBytes))
+}
+
+func TestQueryGetNodeIDListRPWithProof(t *testing.T) {
+	var param did.GetNodeIDListParam
+	param.Role = "RP"
+	GetNodeIDListWithProof(t, param, "rpList")
+}
+
+var rp4TokenHeight int64
+
+func TestQueryGetNodeTokenRP4AtHeight(t *testing.T) {
+	rp4TokenHeight, _ = strconv.ParseInt(getABCIInfo().Result.Response.LastBlockHeight, 10, 64)
+	var param did.SetMqAddressesParam
+	param.Addresses = append(param.Addresses, did.MsqAddress{"192.168.3.101", 8000})
+	SetMqAddresses(t, param, rpPrivK, RP4)
+	var tokenParam = did.GetNodeTokenParam{
+		RP4,
+	}
+	var expected = did.GetNodeTokenResult{
+		96.0 * did.TokenScale,
+	}
+	GetNodeTokenAtHeight(t, tokenParam, rp4TokenHeight, expected)
+}
+
+func TestQueryGetNodeTokenRP4AtUncommittedHeight(t *testing.T) {
+	var param = did.GetNodeTokenParam{
+		RP4,
+	}
+	height := rp4TokenHeight + 1000
+	GetNodeTokenAtHeightExpectLog(t, param, height, fmt.Sprintf("Height %d is not committed", height))
+}
+
+var upgradeHeight int64
+
+func TestScheduleUpgradeAtCommittedHeight(t *testing.T) {
+	var param did.ScheduleUpgradeParam
+	param.Version = "2"
+	param.Height = 1
+	ScheduleUpgrade(t, param, "Upgrade height must be above current block height")
+}
+
+func TestScheduleUpgrade(t *testing.T) {
+	lastBlockHeight, _ := strconv.ParseInt(getABCIInfo().Result.Response.LastBlockHeight, 10, 64)
+	upgradeHeight = lastBlockHeight + 1000000
+	var param did.ScheduleUpgradeParam
+	param.Version = "2"
+	param.Height = upgradeHeight
+	ScheduleUpgrade(t, param, "success")
+}
+
+func TestQueryGetUpgradeSchedule(t *testing.T) {
+	var expected = []did.ScheduleUpgradeParam{
+		{"2", upgradeHeight},
+	}
+	GetUpgradeSchedule(t, expected)
+}
+
+func TestQueryABCIInfoAppVersionBeforeUpgrade(t *testing.T) {
+	GetABCIInfoAppVersion(t, "1")
+}
+
+var rp4Nonce string
+
+func TestSetMqAddressesRP4Nonce(t *testing.T) {
+	var param did.SetMqAddressesParam
+	param.Addresses = append(param.Addresses, did.MsqAddress{"192.168.3.101", 8000})
+	rp4Nonce = newNonce()
+	SetMqAddressesWithNonce(t, param, rpPrivK, RP4, rp4Nonce, "success")
+}
+
+func TestSetMqAddressesRP4DuplicateNonce(t *testing.T) {
+	var param did.SetMqAddressesParam
+	param.Addresses = append(param.Addresses, did.MsqAddress{"192.168.3.101", 8000})
+	SetMqAddressesWithNonce(t, param, rpPrivK, RP4, rp4Nonce, "Duplicate nonce")
+}
+
+func TestSetMqAddressesRP4DuplicateNonceInBlock(t *testing.T) {
+	var param did.SetMqAddressesParam
+	param.Addresses = append(param.Addresses, did.MsqAddress{"192.168.3.101", 8000})
+	SetMqAddressesTwiceInBlock(t, param, rpPrivK, RP4, []string{"success", "Duplicate nonce"})
+}
+
+func TestSetMqAddressesRP4NonceWithoutHeight(t *testing.T) {
+	var param did.SetMqAddressesParam
+	param.Addresses = append(param.Addresses, did.MsqAddress{"192.168.3.101", 8000})
+	SetMqAddressesWithNonce(t, param, rpPrivK, RP4, RandStringRunes(20), "Invalid nonce")
+}
+
+func TestSetMqAddressesRP4NonceInFuture(t *testing.T) {
+	var param did.SetMqAddressesParam
+	param.Addresses = append(param.Addresses, did.MsqAddress{"192.168.3.101", 8000})
+	lastBlockHeight, _ := strconv.ParseInt(getABCIInfo().Result.Response.LastBlockHeight, 10, 64)
+	nonce := strconv.FormatInt(lastBlockHeight+100, 10) + ":" + RandStringRunes(20)
+	SetMqAddressesWithNonce(t, param, rpPrivK, RP4, nonce, "Nonce height is in the future")
+}
+
+func TestSetNodeTokenRP4BeforeTransfer(t *testing.T) {
+	var param = did.SetNodeTokenParam{
+		RP4,
+		10 * did.TokenScale,
+	}
+	SetNodeToken(t, param)
+}
+
+func TestSetNodeTokenRP2BeforeTransfer(t *testing.T) {
+	var param = did.SetNodeTokenParam{
+		RP2,
+		0,
+	}
+	SetNodeToken(t, param)
+}
+
+func TestTransferTokenRP4ToRP2(t *testing.T) {
+	var param = did.TransferTokenParam{
+		RP2,
+		2.5 * did.TokenScale,
+	}
+	TransferToken(t, param, rpPrivK, RP4, "success")
+}
+
+func TestQueryGetNodeTokenRP4AfterTransfer(t *testing.T) {
+	var param = did.GetNodeTokenParam{
+		RP4,
+	}
+	var expected = did.GetNodeTokenResult{
+		6.5 * did.TokenScale,
+	}
+	GetNodeToken(t, param, expected)
+}
+
+func TestQueryGetNodeTokenRP2AfterTransfer(t *testing.T) {
+	var param = did.GetNodeTokenParam{
+		RP2,
+	}
+	var expected = did.GetNodeTokenResult{
+		2.5 * did.TokenScale,
+	}
+	GetNodeToken(t, param, expected)
+}
+
+func TestTransferTokenProtobufRP4NotEnoughByOneMinorUnit(t *testing.T) {
+	var param protoParam.TransferTokenParams
+	param.ToNodeId = RP2
+	param.Amount = &protoParam.TokenAmount{MinorUnits: 6.5*did.TokenScale + 1}
+	TransferTokenProtobuf(t, param, rpPrivK, RP4, "token not enough")
+}
+
+func TestTransferTokenProtobufWithoutToNodeID(t *testing.T) {
+	var param protoParam.TransferTokenParams
+	param.Amount = &protoParam.TokenAmount{MinorUnits: 1}
+	TransferTokenProtobufExpectLog(t, param, rpPrivK, RP4, "Invalid parameter: to_node_id is required")
+}
+
+func TestTransferTokenProtobufUnknownField(t *testing.T) {
+	var param protoParam.TransferTokenParams
+	param.ToNodeId = RP2
+	param.Amount = &protoParam.TokenAmount{MinorUnits: 1}
+	// Field 9 as a varint, which TransferTokenParams does not have
+	param.XXX_unrecognized = []byte{0x48, 0x01}
+	TransferTokenProtobufExpectLog(t, param, rpPrivK, RP4, "Invalid parameter: unknown field number 9")
+}
+
+func TestTransferTokenRP4NotEnough(t *testing.T) {
+	var param = did.TransferTokenParam{
+		RP2,
+		6 * did.TokenScale,
+	}
+	TransferToken(t, param, rpPrivK, RP4, "token not enough")
+}
+
+func TestTransferTokenRP4ToSelf(t *testing.T) {
+	var param = did.TransferTokenParam{
+		RP4,
+		1 * did.TokenScale,
+	}
+	TransferToken(t, param, rpPrivK, RP4, "Can not transfer token to self")
+}
+
+var rp4TransferNonce string
+
+func TestTransferTokenRP4ToSelfWithNonce(t *testing.T) {
+	var param = did.TransferTokenParam{
+		RP4,
+		1 * did.TokenScale,
+	}
+	rp4TransferNonce = newNonce()
+	TransferTokenWithNonce(t, param, rpPrivK, RP4, rp4TransferNonce, "Can not transfer token to self")
+}
+
+func TestTransferTokenRP4NonceOfFailedTx(t *testing.T) {
+	var param = did.TransferTokenParam{
+		RP2,
+		1 * did.TokenScale,
+	}
+	TransferTokenWithNonce(t, param, rpPrivK, RP4, rp4TransferNonce, "Duplicate nonce")
+}
+
+func TestSetTokenTransferWhitelistRP4(t *testing.T) {
+	var param = did.SetTokenTransferWhitelistParam{
+		RP4,
+		[]string{RP3},
+	}
+	SetTokenTransferWhitelist(t, param)
+}
+
+func TestQueryGetTokenTransferWhitelistRP4(t *testing.T) {
+	var param = did.GetTokenTransferWhitelistParam{
+		RP4,
+	}
+	var expected = did.GetTokenTransferWhitelistResult{
+		[]string{RP3},
+	}
+	GetTokenTransferWhitelist(t, param, expected)
+}
+
+func TestTransferTokenRP4NotInWhitelist(t *testing.T) {
+	var param = did.TransferTokenParam{
+		RP2,
+		1 * did.TokenScale,
+	}
+	TransferToken(t, param, rpPrivK, RP4, "Recipient is not in token transfer whitelist")
+}
+
+func TestTransferTokenRP4ToRP3InWhitelist(t *testing.T) {
+	var param = did.TransferTokenParam{
+		RP3,
+		1 * did.TokenScale,
+	}
+	TransferToken(t, param, rpPrivK, RP4, "success")
+}
+
+func TestQueryGetNodeTokenRP4AfterTransferToRP3(t *testing.T) {
+	var param = did.GetNodeTokenParam{
+		RP4,
+	}
+	var expected = did.GetNodeTokenResult{
+		4.5 * did.TokenScale,
+	}
+	GetNodeToken(t, param, expected)
+}
+
+func TestRemoveTokenTransferWhitelistRP4(t *testing.T) {
+	var param = did.SetTokenTransferWhitelistParam{
+		RP4,
+		[]string{},
+	}
+	SetTokenTransferWhitelist(t, param)
+}
+
+func TestQueryGetTokenTransferWhitelistRP4AfterRemove(t *testing.T) {
+	var param = did.GetTokenTransferWhitelistParam{
+		RP4,
+	}
+	var expected = did.GetTokenTransferWhitelistResult{
+		[]string{},
+	}
+	GetTokenTransferWhitelist(t, param, expected)
+}
+
+func TestBatchTransferTokenRP4FeeNotPaid(t *testing.T) {
+	var param did.BatchParam
+	param.Transactions = append(param.Transactions, did.BatchTx{"SetMqAddresses", `{"addresses":[{"ip":"192.168.3.102","port":8000}]}`})
+	param.Transactions = append(param.Transactions, did.BatchTx{"TransferToken", `{"to_node_id":"` + RP2 + `","amount":3.5}`})
+	Batch(t, param, rpPrivK, RP4, "Transaction 1 (TransferToken): token not enough")
+}
+
+func TestQueryGetMqAddressesRP4AfterFeeNotPaid(t *testing.T) {
+	var param = did.GetMqAddressesParam{
+		RP4,
+	}
+	var expected = []did.MsqAddress{
+		did.MsqAddress{
+			"192.168.3.101",
+			8000,
+		},
+	}
+	GetMqAddresses(t, param, expected)
+}
+
+func TestQueryGetNodeTokenRP4AfterFeeNotPaid(t *testing.T) {
+	var param = did.GetNodeTokenParam{
+		RP4,
+	}
+	var expected = did.GetNodeTokenResult{
+		4.5 * did.TokenScale,
+	}
+	GetNodeToken(t, param, expected)
+}
+
+func TestQueryGetNodeTokenRP2AfterFeeNotPaid(t *testing.T) {
+	var param = did.GetNodeTokenParam{
+		RP2,
+	}
+	var expected = did.GetNodeTokenResult{
+		2.5 * did.TokenScale,
+	}
+	GetNodeToken(t, param, expected)
+}
+
+func TestQueryGetTokenLedgerRP2(t *testing.T) {
+	var param did.GetTokenLedgerParam
+	param.NodeID = RP2
+	param.Limit = 2
+	var expected = []did.TokenLedgerEntry{
+		{Method: "SetNodeToken", Amount: 100 * did.TokenScale},
+		{Method: "SetMqAddresses", Amount: -1 * did.TokenScale},
+		{Method: "SetNodeToken", Amount: -99 * did.TokenScale},
+		{Method: "TransferToken", Amount: 2.5 * did.TokenScale},
+	}
+	GetTokenLedger(t, param, expected)
+}
+
+func TestNDIDSetPriceRuleCreateRequest(t *testing.T) {
+	var param = did.SetPriceRuleParam{
+		"CreateRequest",
+		1 * did.TokenScale,
+		0.5 * did.TokenScale,
+		[]did.ServicePrice{
+			{"statement", 2 * did.TokenScale},
+		},
+	}
+	SetPriceRule(t, param)
+}
+
+func TestQueryGetPriceRuleCreateRequest(t *testing.T) {
+	var param = did.GetPriceFuncParam{
+		"CreateRequest",
+	}
+	var expected = did.GetPriceRuleResult{
+		9.99 * did.TokenScale,
+		1 * did.TokenScale,
+		0.5 * did.TokenScale,
+		[]did.ServicePrice{
+			{"statement", 2 * did.TokenScale},
+		},
+	}
+	GetPriceRule(t, param, expected)
+}
+
+func TestQueryGetTxCostCreateRequest(t *testing.T) {
+	var datas []did.DataRequest
+	var data1 did.DataRequest
+	data1.ServiceID = "statement"
+	data1.As = []string{AS1}
+	data1.Count = 1
+	data1.RequestParamsHash = "hash"
+	datas = append(datas, data1)
+	var request did.Request
+	request.RequestID = "cost-preview"
+	request.MinIdp = 2
+	request.MinAal = 3
+	request.MinIal = 3
+	request.Timeout = 259200
+	request.IdPIDList = []string{IdP1}
+	request.DataRequestList = datas
+	request.MessageHash = "hash('Please allow...')"
+	request.Mode = 3
+	requestJSON, err := json.Marshal(request)
+	if err != nil {
+		fmt.Println("error:", err)
+	}
+	var param = did.GetTxCostParam{
+		"CreateRequest",
+		string(requestJSON),
+		RP2,
+	}
+	// 9.99 price + 2 IdPs * 1 + 1 AS * 0.5 + 2 for statement service
+	var expected = did.GetTxCostResult{
+		14.49 * did.TokenScale,
+	}
+	GetTxCost(t, param, expected)
+	param.NodeID = "NDID"
+	expected.Cost = 0
+	GetTxCost(t, param, expected)
+}
+
+func TestNDIDRemovePriceRuleCreateRequest(t *testing.T) {
+	var param = did.SetPriceRuleParam{
+		"CreateRequest",
+		0,
+		0,
+		[]did.ServicePrice{},
+	}
+	SetPriceRule(t, param)
+}
+
+func TestQueryGetPriceRuleCreateRequestAfterRemove(t *testing.T) {
+	var param = did.GetPriceFuncParam{
+		"CreateRequest",
+	}
+	var expected = did.GetPriceRuleResult{
+		9.99 * did.TokenScale,
+		0,
+		0,
+		[]did.ServicePrice{},
+	}
+	GetPriceRule(t, param, expected)
+}
+
+func TestQueryGetEarningsIdP1(t *testing.T) {
+	var param did.GetEarningsParam
+	param.NodeID = IdP1
+	var expected = did.GetEarningsResult{
+		1.5 * did.TokenScale,
+		2,
+	}
+	GetEarnings(t, param, expected)
+}
+
+func TestQueryGetEarningsRP1(t *testing.T) {
+	var param did.GetEarningsParam
+	param.NodeID = RP1
+	var expected = did.GetEarningsResult{
+		0,
+		0,
+	}
+	GetEarnings(t, param, expected)
+}
+
+func TestNDIDSetRevenueShareRule(t *testing.T) {
+	var param = did.SetRevenueShareRuleParam{
+		70,
+		20,
+	}
+	SetRevenueShareRule(t, param)
+}
+
+func TestQueryGetRevenueShareRule(t *testing.T) {
+	var expected = did.GetRevenueShareRuleResult{
+		70,
+		20,
+	}
+	GetRevenueShareRule(t, expected)
+}
+
+var requestIDRevenueShareTimeout = uuid.NewV4()
+
+func TestEnableNodeRP1ForRevenueShareTimeout(t *testing.T) {
+	var param did.DisableNodeParam
+	param.NodeID = RP1
+	EnableNode(t, param)
+}
+
+func TestSetNodeTokenRP1ForRevenueShareTimeout(t *testing.T) {
+	var param = did.SetNodeTokenParam{
+		RP1,
+		100 * did.TokenScale,
+	}
+	SetNodeToken(t, param)
+}
+
+func TestCreateRequestWithRevenueShareRule(t *testing.T) {
+	var param did.Request
+	param.RequestID = requestIDRevenueShareTimeout.String()
+	param.MinIdp = 1
+	param.MinIal = 3
+	param.MinAal = 3
+	param.Timeout = 259200
+	param.MessageHash = "hash('Please allow...')"
+	param.Mode = 1
+	CreateRequest(t, param, rpPrivK, RP1)
+}
+
+func TestRPTimeOutRequestWithRevenueShareRule(t *testing.T) {
+	var param = did.TimeOutRequestParam{
+		requestIDRevenueShareTimeout.String(),
+		[]did.ResponseValid{},
+	}
+	TimeOutRequest(t, param, RP1)
+}
+
+func TestQueryGetNodeTokenRPAfterTimeOutRequestWithRevenueShareRule(t *testing.T) {
+	var param = did.GetNodeTokenParam{
+		RP1,
+	}
+	// Nothing of a timed out request is burned, only the price of TimeOutRequest is spent
+	var expected = did.GetNodeTokenResult{
+		99.0 * did.TokenScale,
+	}
+	GetNodeToken(t, param, expected)
+}
+
+func TestDisableNodeRP1AfterRevenueShareTimeout(t *testing.T) {
+	var param did.DisableNodeParam
+	param.NodeID = RP1
+	DisableNode(t, param)
+}
+
+func TestNDIDRemoveRevenueShareRule(t *testing.T) {
+	var param = did.SetRevenueShareRuleParam{
+		0,
+		0,
+	}
+	SetRevenueShareRule(t, param)
+}
+
+func TestQueryGetRevenueShareRuleAfterRemove(t *testing.T) {
+	var expected = did.GetRevenueShareRuleResult{
+		0,
+		0,
+	}
+	GetRevenueShareRule(t, expected)
+}
+
+func TestSetNodeTokenRP4BeforeCredit(t *testing.T) {
+	var param = did.SetNodeTokenParam{
+		RP4,
+		1 * did.TokenScale,
+	}
+	SetNodeToken(t, param)
+}
+
+func TestNDIDSetNodeTokenLimitRP4(t *testing.T) {
+	threshold := did.TokenAmount(0)
+	var param = did.SetNodeTokenLimitParam{
+		RP4,
+		2 * did.TokenScale,
+		&threshold,
+	}
+	SetNodeTokenLimit(t, param)
+}
+
+func TestQueryGetNodeTokenLimitRP4(t *testing.T) {
+	var param = did.GetNodeTokenParam{
+		RP4,
+	}
+	threshold := did.TokenAmount(0)
+	var expected = did.GetNodeTokenLimitResult{
+		2 * did.TokenScale,
+		&threshold,
+	}
+	GetNodeTokenLimit(t, param, expected)
+}
+
+func TestSetMqAddressesRP4TokenLow(t *testing.T) {
+	var mq did.MsqAddress
+	mq.IP = "192.168.3.104"
+	mq.Port = 8000
+	var param did.SetMqAddressesParam
+	param.Addresses = make([]did.MsqAddress, 0)
+	param.Addresses = append(param.Addresses, mq)
+	expected := []common.KVPair{
+		{Key: []byte("token_low"), Value: []byte(RP4)},
+	}
+	SetMqAddressesExpectTags(t, param, rpPrivK, RP4, expected)
+}
+
+func TestSetMqAddressesRP4OnCredit(t *testing.T) {
+	var mq did.MsqAddress
+	mq.IP = "192.168.3.104"
+	mq.Port = 8001
+	var param did.SetMqAddressesParam
+	param.Addresses = make([]did.MsqAddress, 0)
+	param.Addresses = append(param.Addresses, mq)
+	SetMqAddresses(t, param, rpPrivK, RP4)
+}
+
+func TestQueryGetNodeTokenRP4OnCredit(t *testing.T) {
+	var param = did.GetNodeTokenParam{
+		RP4,
+	}
+	var expected = did.GetNodeTokenResult{
+		-1 * did.TokenScale,
+	}
+	GetNodeToken(t, param, expected)
+}
+
+func TestAddNodeTokenRP4OnCredit(t *testing.T) {
+	var param = did.AddNodeTokenParam{
+		RP4,
+		3 * did.TokenScale,
+	}
+	AddNodeToken(t, param)
+}
+
+func TestQueryGetNodeTokenRP4AfterAddOnCredit(t *testing.T) {
+	var param = did.GetNodeTokenParam{
+		RP4,
+	}
+	var expected = did.GetNodeTokenResult{
+		2 * did.TokenScale,
+	}
+	GetNodeToken(t, param, expected)
+}
+
+func TestNDIDRemoveNodeTokenLimitRP4(t *testing.T) {
+	var param = did.SetNodeTokenLimitParam{
+		RP4,
+		0,
+		nil,
+	}
+	SetNodeTokenLimit(t, param)
+}
+
+func TestQueryGetNodeTokenLimitRP4AfterRemove(t *testing.T) {
+	var param = did.GetNodeTokenParam{
+		RP4,
+	}
+	var expected = did.GetNodeTokenLimitResult{
+		0,
+		nil,
+	}
+	GetNodeTokenLimit(t, param, expected)
+}
+
+var RP5 = RandStringRunes(20)
+var rp5PublicKey, rp5Key, _ = ed25519.GenerateKey(rand.Reader)
+
+func TestRegisterNodeRP5WithEd25519Key(t *testing.T) {
+	rpPublicKeyBytes, err := generatePublicKey(rp5PublicKey)
+	if err != nil {
+		log.Fatal(err.Error())
+	}
+	rpKey2 := getPrivateKeyFromString(allMasterKey)
+	rpMasterPublicKeyBytes, err := generatePublicKey(&rpKey2.PublicKey)
+	if err != nil {
+		log.Fatal(err.Error())
+	}
+	var param did.RegisterNode
+	param.NodeID = RP5
+	param.PublicKey = string(rpPublicKeyBytes)
+	param.MasterPublicKey = string(rpMasterPublicKeyBytes)
+	param.Role = "RP"
+	param.NodeName = "Node RP 5"
+	RegisterNode(t, param)
+}
+
+func TestSetNodeTokenRP5(t *testing.T) {
+	var param = did.SetNodeTokenParam{
+		RP5,
+		100.0 * did.TokenScale,
+	}
+	SetNodeToken(t, param)
+}
+
+func TestSetMqAddressesRP5WithEd25519Key(t *testing.T) {
+	var mq did.MsqAddress
+	mq.IP = "192.168.3.105"
+	mq.Port = 8000
+	var param did.SetMqAddressesParam
+	param.Addresses = make([]did.MsqAddress, 0)
+	param.Addresses = append(param.Addresses, mq)
+	SetMqAddressesWithEd25519Key(t, param, rp5Key, RP5)
+}
+
+func TestQueryGetMqAddressesRP5(t *testing.T) {
+	var param = did.GetMqAddressesParam{
+		RP5,
+	}
+	var expected = []did.MsqAddress{
+		did.MsqAddress{
+			"192.168.3.105",
+			8000,
+		},
+	}
+	GetMqAddresses(t, param, expected)
+}
+
+func TestRP5UpdateNodeSignatureSchemePSSWithEd25519Key(t *testing.T) {
+	var param did.UpdateNodeParam
+	param.SignatureScheme = "PSS"
+	UpdateNodeExpectString(t, param, allMasterKey, RP5, "Signature scheme can only be set for a node with an RSA key")
+}
+
+func TestListMigrationAtUpgradeHeight(t *testing.T) {
+	chain := NewChainWithListBlobs(t, 3)
+	chain.Block()
+	chain.Block()
+	QueryChainExpectString(t, chain, "GetNodeIDList", did.GetNodeIDListParam{Role: "IdP"}, `{"node_id_list":["IdP-z","IdP-a"]}`)
+	QueryChainExpectString(t, chain, "GetAccessorsInAccessorGroup", did.GetAccessorsInAccessorGroupParam{AccessorGroupID: "group-a", Limit: 2}, `{"accessor_list":["acc-z","acc-a"],"next_cursor":"acc-a"}`)
+	chain.Block()
+	QueryChainExpectString(t, chain, "GetNodeIDList", did.GetNodeIDListParam{Role: "IdP"}, `{"node_id_list":["IdP-z","IdP-a"]}`)
+	QueryChainExpectString(t, chain, "GetNodeIDList", did.GetNodeIDListParam{}, `{"node_id_list":["IdP-z","IdP-a"]}`)
+	QueryChainExpectString(t, chain, "GetNamespaceList", did.GetListParam{}, `{"namespace_list":[{"namespace":"tel","description":"Tel number","active":true},{"namespace":"cid","description":"Citizen ID","active":true}]}`)
+	QueryChainExpectString(t, chain, "GetServiceList", did.GetListParam{}, `{"service_list":[{"service_id":"statement","service_name":"Bank statement","active":true},{"service_id":"credit","service_name":"Credit score","active":true}]}`)
+	QueryChainExpectString(t, chain, "GetIdpNodes", did.GetIdpNodesParam{HashID: "hash", MinIal: 1, MinAal: 1}, `{"node":[{"node_id":"IdP-z","node_name":"IdP Z","max_ial":3,"max_aal":3},{"node_id":"IdP-a","node_name":"IdP A","max_ial":3,"max_aal":3}]}`)
+	QueryChainExpectString(t, chain, "GetIdentityInfo", did.GetIdentityInfoParam{HashID: "hash", NodeID: "IdP-a"}, `{"ial":3}`)
+	QueryChainExpectString(t, chain, "GetAccessorsInAccessorGroup", did.GetAccessorsInAccessorGroupParam{AccessorGroupID: "group-a", Limit: 2}, `{"accessor_list":["acc-z","acc-a"],"next_cursor":"acc-a"}`)
+	QueryChainExpectString(t, chain, "GetAccessorsInAccessorGroup", did.GetAccessorsInAccessorGroupParam{AccessorGroupID: "group-a", Cursor: "acc-a", Limit: 2}, `{"accessor_list":["acc-m"]}`)
+}
+
+func TestListReplayAcrossUpgradeHeight(t *testing.T) {
+	chain := NewChainWithListBlobs(t, 4)
+	ndidKey := getPrivateKeyFromString(ndidPrivK)
+	ndidPublicKey, err := generatePublicKey(&ndidKey.PublicKey)
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+	idpKey := getPrivateKeyFromString(idpPrivK)
+	idpPublicKey, err := generatePublicKey(&idpKey.PublicKey)
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+	BlockExpectSuccess(t, chain,
+		ChainTx(t, chain, "InitNDID", did.InitNDIDParam{NodeID: "NDID", PublicKey: string(ndidPublicKey), MasterPublicKey: string(ndidPublicKey)}, ndidPrivK, "NDID"),
+		ChainTx(t, chain, "EndInit", did.EndInitParam{}, ndidPrivK, "NDID"),
+	)
+	// Written as list blobs before the upgrade
+	BlockExpectSuccess(t, chain,
+		ChainTx(t, chain, "RegisterNode", did.RegisterNode{NodeID: "IdP-b", PublicKey: string(idpPublicKey), NodeName: "IdP B", Role: "IdP", MaxIal: 3, MaxAal: 3}, ndidPrivK, "NDID"),
+		ChainTx(t, chain, "AddNamespace", did.Namespace{Namespace: "email", Description: "Email"}, ndidPrivK, "NDID"),
+		ChainTx(t, chain, "DisableNamespace", did.DisableNamespaceParam{Namespace: "tel"}, ndidPrivK, "NDID"),
+		ChainTx(t, chain, "SetNodeToken", did.SetNodeTokenParam{NodeID: "IdP-b", Amount: 100 * did.TokenScale}, ndidPrivK, "NDID"),
+	)
+	BlockExpectSuccess(t, chain,
+		ChainTx(t, chain, "RegisterAccessor", did.RegisterAccessorParam{AccessorID: "acc-b", AccessorType: "RSA2048", AccessorPublicKey: string(idpPublicKey), AccessorGroupID: "group-b"}, idpPrivK, "IdP-b"),
+	)
+	preUpgradeHeight := chain.Height()
+	nodeIDList := `{"node_id_list":["IdP-z","IdP-a","IdP-b"]}`
+	namespaceList := `{"namespace_list":[{"namespace":"cid","description":"Citizen ID","active":true},{"namespace":"email","description":"Email","active":true}]}`
+	QueryChainExpectString(t, chain, "GetNodeIDList", did.GetNodeIDListParam{Role: "IdP"}, nodeIDList)
+	QueryChainExpectString(t, chain, "GetNamespaceList", did.GetListParam{}, namespaceList)
+	chain.Block()
+	chain.Block()
+	QueryChainExpectString(t, chain, "GetNodeIDList", did.GetNodeIDListParam{Role: "IdP"}, nodeIDList)
+	QueryChainExpectString(t, chain, "GetNamespaceList", did.GetListParam{}, namespaceList)
+	QueryChainAtHeightExpectString(t, chain, "GetNodeIDList", did.GetNodeIDListParam{Role: "IdP"}, preUpgradeHeight, nodeIDList)
+	QueryChainAtHeightExpectString(t, chain, "GetNamespaceList", did.GetListParam{}, preUpgradeHeight, namespaceList)
+	QueryChainExpectString(t, chain, "GetAccessorsInAccessorGroup", did.GetAccessorsInAccessorGroupParam{AccessorGroupID: "group-b"}, `{"accessor_list":["acc-b"]}`)
+	// Written as per-item keys after the upgrade
+	BlockExpectSuccess(t, chain,
+		ChainTx(t, chain, "EnableNamespace", did.DisableNamespaceParam{Namespace: "tel"}, ndidPrivK, "NDID"),
+		ChainTx(t, chain, "AddNamespace", did.Namespace{Namespace: "passport", Description: "Passport"}, ndidPrivK, "NDID"),
+	)
+	QueryChainExpectString(t, chain, "GetNamespaceList", did.GetListParam{}, `{"namespace_list":[{"namespace":"tel","description":"Tel number","active":true},{"namespace":"cid","description":"Citizen ID","active":true},{"namespace":"email","description":"Email","active":true},{"namespace":"passport","description":"Passport","active":true}]}`)
+	QueryChainAtHeightExpectString(t, chain, "GetNamespaceList", did.GetListParam{}, preUpgradeHeight, namespaceList)
+	BlockExpectSuccess(t, chain,
+		ChainTx(t, chain, "RegisterAccessor", did.RegisterAccessorParam{AccessorID: "acc-c", AccessorType: "RSA2048", AccessorPublicKey: string(idpPublicKey), AccessorGroupID: "group-c"}, idpPrivK, "IdP-b"),
+	)
+	QueryChainExpectString(t, chain, "GetAccessorsInAccessorGroup", did.GetAccessorsInAccessorGroupParam{AccessorGroupID: "group-b"}, `{"accessor_list":["acc-b"]}`)
+	QueryChainExpectString(t, chain, "GetAccessorsInAccessorGroup", did.GetAccessorsInAccessorGroupParam{AccessorGroupID: "group-c", IdpID: "IdP-b"}, `{"accessor_list":["acc-c"]}`)
+	QueryChainAtHeightExpectString(t, chain, "GetAccessorsInAccessorGroup", did.GetAccessorsInAccessorGroupParam{AccessorGroupID: "group-b"}, preUpgradeHeight, `{"accessor_list":["acc-b"]}`)
+}
+
+var userIDRegisterTimeout = RandStringRunes(20)
+var registerIdentityHeight int64
+
+func TestEnableNodeIdP4ForRegisterTimeout(t *testing.T) {
+	var param did.DisableNodeParam
+	param.NodeID = IdP4
+	EnableNode(t, param)
+}
+
+func TestNDIDSetShortTimeOutBlockRegisterIdentity(t *testing.T) {
+	SetTimeOutBlockRegisterIdentityTo(t, 2)
+}
+
+func TestIdP4RegisterIdentityWithShortTimeout(t *testing.T) {
+	h := sha256.New()
+	h.Write([]byte(userNamespace + userIDRegisterTimeout))
+	userHash := h.Sum(nil)
+	var users []did.User
+	var user = did.User{
+		hex.EncodeToString(userHash),
+		3,
+		true,
+	}
+	users = append(users, user)
+	var param = did.RegisterIdentityParam{
+		users,
+	}
+	RegisterIdentity(t, param, idpPrivK5, IdP4, "success")
+	registerIdentityHeight, _ = strconv.ParseInt(getABCIInfo().Result.Response.LastBlockHeight, 10, 64)
+	for i := 0; i < 3; i++ {
+		waitForNextBlock()
+	}
+}
+
+func TestQueryGetIdpNodesAtRegisterIdentityHeight(t *testing.T) {
+	h := sha256.New()
+	h.Write([]byte(userNamespace + userIDRegisterTimeout))
+	userHash := h.Sum(nil)
+	var param did.GetIdpNodesParam
+	param.HashID = hex.EncodeToString(userHash)
+	param.MinIal = 1
+	param.MinAal = 1
+	var expected = `{"node":[{"node_id":"` + IdP4 + `","node_name":"IdP Number 4 from ...","max_ial":3,"max_aal":3}]}`
+	GetIdpNodesAtHeightExpectString(t, param, registerIdentityHeight, expected)
+}
+
+func TestQueryGetIdpNodesAfterRegisterIdentityTimeout(t *testing.T) {
+	h := sha256.New()
+	h.Write([]byte(userNamespace + userIDRegisterTimeout))
+	userHash := h.Sum(nil)
+	var param did.GetIdpNodesParam
+	param.HashID = hex.EncodeToString(userHash)
+	param.MinIal = 1
+	param.MinAal = 1
+	var expected = `{"node":[]}`
+	GetIdpNodesExpectString(t, param, expected)
+}
+
+func TestNDIDResetTimeOutBlockRegisterIdentity(t *testing.T) {
+	SetTimeOutBlockRegisterIdentityTo(t, 50)
+}
+
+func TestDisableNodeIdP4AfterRegisterTimeout(t *testing.T) {
+	var param did.DisableNodeParam
+	param.NodeID = IdP4
+	DisableNode(t, param)
+}
@@ -33,7 +33,6 @@ import (
 	"testing"
 
 	did "github.com/ndidplatform/smart-contract/abci/did/v1"
-	"github.com/tendermint/tendermint/libs/common"
 )
 
 func RegisterServiceDestination(t *testing.T, param did.RegisterServiceDestinationParam, priveKFile string, nodeID string, expected string) {
@@ -43,7 +42,7 @@ func RegisterServiceDestination(t *testing.T, param did.RegisterServiceDestinati
 	if err != nil {
 		log.Fatal(err.Error())
 	}
-	nonce := base64.StdEncoding.EncodeToString([]byte(common.RandStr(12)))
+	nonce := newNonce()
 	fnName := "RegisterServiceDestination"
 	tempPSSmessage := append([]byte(fnName), paramJSON...)
 	tempPSSmessage = append(tempPSSmessage, []byte(nonce)...)
@@ -71,7 +70,7 @@ func UpdateServiceDestination(t *testing.T, param did.UpdateServiceDestinationPa
 		log.Fatal(err.Error())
 	}
 	fnName := "UpdateServiceDestination"
-	nonce := base64.StdEncoding.EncodeToString([]byte(common.RandStr(12)))
+	nonce := newNonce()
 	tempPSSmessage := append([]byte(fnName), paramJSON...)
 	tempPSSmessage = append(tempPSSmessage, []byte(nonce)...)
 	PSSmessage := []byte(base64.StdEncoding.EncodeToString(tempPSSmessage))
@@ -99,7 +98,7 @@ func SignData(t *testing.T, param did.SignDataParam, expected string, nodeID str
 		fmt.Println("error:", err)
 	}
 	fnName := "SignData"
-	nonce := base64.StdEncoding.EncodeToString([]byte(common.RandStr(12)))
+	nonce := newNonce()
 	tempPSSmessage := append([]byte(fnName), paramJSON...)
 	tempPSSmessage = append(tempPSSmessage, []byte(nonce)...)
 	PSSmessage := []byte(base64.StdEncoding.EncodeToString(tempPSSmessage))
@@ -126,7 +125,7 @@ func DisableServiceDestination(t *testing.T, param did.DisableServiceDestination
 		log.Fatal(err.Error())
 	}
 	fnName := "DisableServiceDestination"
-	nonce := base64.StdEncoding.EncodeToString([]byte(common.RandStr(12)))
+	nonce := newNonce()
 	tempPSSmessage := append([]byte(fnName), paramJSON...)
 	tempPSSmessage = append(tempPSSmessage, []byte(nonce)...)
 	PSSmessage := []byte(base64.StdEncoding.EncodeToString(tempPSSmessage))
@@ -154,7 +153,7 @@ func EnableServiceDestination(t *testing.T, param did.DisableServiceDestinationP
 		log.Fatal(err.Error())
 	}
 	fnName := "EnableServiceDestination"
-	nonce := base64.StdEncoding.EncodeToString([]byte(common.RandStr(12)))
+	nonce := newNonce()
 	tempPSSmessage := append([]byte(fnName), paramJSON...)
 	tempPSSmessage = append(tempPSSmessage, []byte(nonce)...)
 	PSSmessage := []byte(base64.StdEncoding.EncodeToString(tempPSSmessage))
@@ -24,6 +24,8 @@ package test
 
 import (
 	"crypto"
+	"crypto/ecdsa"
+	"crypto/ed25519"
 	"crypto/rand"
 	"crypto/rsa"
 	"encoding/base64"
@@ -31,8 +33,16 @@ import (
 	"fmt"
 	"testing"
 
+	"github.com/golang/protobuf/proto"
+	didApp "github.com/ndidplatform/smart-contract/abci/did"
+	"github.com/ndidplatform/smart-contract/abci/did/didtest"
 	did "github.com/ndidplatform/smart-contract/abci/did/v1"
+	"github.com/ndidplatform/smart-contract/protos/data"
+	protoParam "github.com/ndidplatform/smart-contract/protos/param"
+	"github.com/sirupsen/logrus"
+	"github.com/tendermint/iavl"
 	"github.com/tendermint/tendermint/libs/common"
+	dbm "github.com/tendermint/tendermint/libs/db"
 )
 
 func SetMqAddresses(t *testing.T, param did.SetMqAddressesParam, priveKFile string, nodeID string) {
@@ -42,7 +52,7 @@ func SetMqAddresses(t *testing.T, param did.SetMqAddressesParam, priveKFile stri
 	}
 	idpKey := getPrivateKeyFromString(priveKFile)
 	idpNodeID := []byte(nodeID)
-	nonce := base64.StdEncoding.EncodeToString([]byte(common.RandStr(12)))
+	nonce := newNonce()
 	fnName := "SetMqAddresses"
 	tempPSSmessage := append([]byte(fnName), paramJSON...)
 	tempPSSmessage = append(tempPSSmessage, []byte(nonce)...)
@@ -63,6 +73,473 @@ func SetMqAddresses(t *testing.T, param did.SetMqAddressesParam, priveKFile stri
 	t.Logf("PASS: %s", fnName)
 }
 
+func SetMqAddressesWithNonce(t *testing.T, param did.SetMqAddressesParam, priveKFile string, nodeID string, nonce string, expected string) {
+	paramJSON, err := json.Marshal(param)
+	if err != nil {
+		fmt.Println("error:", err)
+	}
+	idpKey := getPrivateKeyFromString(priveKFile)
+	idpNodeID := []byte(nodeID)
+	fnName := "SetMqAddresses"
+	tempPSSmessage := append([]byte(fnName), paramJSON...)
+	tempPSSmessage = append(tempPSSmessage, []byte(nonce)...)
+	PSSmessage := []byte(base64.StdEncoding.EncodeToString(tempPSSmessage))
+	newhash := crypto.SHA256
+	pssh := newhash.New()
+	pssh.Write(PSSmessage)
+	hashed := pssh.Sum(nil)
+
+	signature, err := rsa.SignPKCS1v15(rand.Reader, idpKey, newhash, hashed)
+	result, _ := callTendermint([]byte(fnName), paramJSON, []byte(nonce), signature, idpNodeID)
+	resultObj, _ := result.(ResponseTx)
+	actual := resultObj.Result.DeliverTx.Log
+	if resultObj.Result.CheckTx.Code != 0 {
+		actual = resultObj.Result.CheckTx.Log
+	}
+	if actual != expected {
+		t.Fatalf("FAIL: %s\nExpected: %#v\nActual: %#v", fnName, expected, actual)
+	}
+	t.Logf("PASS: %s", fnName)
+}
+
+// SetMqAddressesTwiceInBlock delivers the same transaction twice in one block
+// without checking it first, which needs the app in process
+func SetMqAddressesTwiceInBlock(t *testing.T, param did.SetMqAddressesParam, priveKFile string, nodeID string, expected []string) {
+	if harness == nil {
+		t.Skip("needs the app in process (ABCI_IN_PROCESS=true)")
+	}
+	paramJSON, err := json.Marshal(param)
+	if err != nil {
+		fmt.Println("error:", err)
+	}
+	fnName := "SetMqAddresses"
+	tx, err := harness.NewTx(fnName, paramJSON, getPrivateKeyFromString(priveKFile), nodeID)
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+	deliverTxs, _ := harness.Block(tx, tx)
+	for index, deliverTx := range deliverTxs {
+		if actual := deliverTx.Log; actual != expected[index] {
+			t.Fatalf("FAIL: %s\nExpected: %#v\nActual: %#v", fnName, expected[index], actual)
+		}
+	}
+	t.Logf("PASS: %s", fnName)
+}
+
+// NewChainWithListBlobs starts a chain in process over a state that holds
+// IdP, node, namespace, service and MsqDestination lists as one value per
+// list, as app version 1 stored them, and that upgrades to app version 2 at
+// upgradeHeight
+func NewChainWithListBlobs(t *testing.T, upgradeHeight int64) *didtest.Harness {
+	if harness == nil {
+		t.Skip("needs the app in process (ABCI_IN_PROCESS=true)")
+	}
+	tree := iavl.NewMutableTree(dbm.NewMemDB(), 0)
+	blobs := map[string]proto.Message{
+		"IdPList": &data.IdPList{NodeId: []string{"IdP-z", "IdP-a"}},
+		"allList": &data.AllList{NodeId: []string{"IdP-z", "IdP-a"}},
+		"AllNamespace": &data.NamespaceList{Namespaces: []*data.Namespace{
+			{Namespace: "tel", Description: "Tel number", Active: true},
+			{Namespace: "cid", Description: "Citizen ID", Active: true},
+		}},
+		"AllService": &data.ServiceDetailList{Services: []*data.ServiceDetail{
+			{ServiceId: "statement", ServiceName: "Bank statement", Active: true},
+			{ServiceId: "credit", ServiceName: "Credit score", Active: true},
+		}},
+		"MsqDestination|hash": &data.MsqDesList{Nodes: []*data.Node{
+			{NodeId: "IdP-z", Ial: 2, Active: true},
+			{NodeId: "IdP-a", Ial: 3, Active: true},
+		}},
+		"AccessorInGroup|group-a": &data.AccessorInGroup{Accessors: []string{"acc-z", "acc-a", "acc-m"}},
+		"NodeID|IdP-z":            &data.NodeDetail{NodeName: "IdP Z", Role: "IdP", MaxIal: 3, MaxAal: 3, Active: true},
+		"NodeID|IdP-a":            &data.NodeDetail{NodeName: "IdP A", Role: "IdP", MaxIal: 3, MaxAal: 3, Active: true},
+	}
+	for key, blob := range blobs {
+		value, err := proto.Marshal(blob)
+		if err != nil {
+			t.Fatal(err.Error())
+		}
+		tree.Set([]byte("kvPairKey:"+key), value)
+	}
+	schedule := didApp.VersionSchedule{
+		{Height: 0, Version: "1"},
+		{Height: upgradeHeight, Version: did.ListIndexVersion},
+	}
+	logger := logrus.WithFields(logrus.Fields{"module": "abci-app"})
+	return didtest.NewWithApp(didApp.NewDIDApplicationInterfaceWithTree(logger, tree, schedule), didtest.DefaultChainID)
+}
+
+// QueryChainExpectString queries method of chain at its latest committed height
+func QueryChainExpectString(t *testing.T, chain *didtest.Harness, fnName string, param interface{}, expected string) {
+	QueryChainAtHeightExpectString(t, chain, fnName, param, 0, expected)
+}
+
+// QueryChainAtHeightExpectString queries method of chain at height
+func QueryChainAtHeightExpectString(t *testing.T, chain *didtest.Harness, fnName string, param interface{}, height int64, expected string) {
+	paramJSON, err := json.Marshal(param)
+	if err != nil {
+		fmt.Println("error:", err)
+	}
+	res := chain.Query(fnName, paramJSON, height, false)
+	if actual := string(res.Value); actual != expected {
+		t.Fatalf("FAIL: %s\nExpected: %s\nActual: %s", fnName, expected, actual)
+	}
+	t.Logf("PASS: %s", fnName)
+}
+
+// ChainTx builds a transaction of fnName to chain signed by privKFile of nodeID
+func ChainTx(t *testing.T, chain *didtest.Harness, fnName string, param interface{}, privKFile string, nodeID string) []byte {
+	paramJSON, err := json.Marshal(param)
+	if err != nil {
+		fmt.Println("error:", err)
+	}
+	key := getPrivateKeyFromString(privKFile)
+	tx, err := chain.NewTx(fnName, paramJSON, key, nodeID)
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+	return tx
+}
+
+// BlockExpectSuccess commits txs to chain in a new block and fails unless every tx succeeds
+func BlockExpectSuccess(t *testing.T, chain *didtest.Harness, txs ...[]byte) {
+	results, _ := chain.Block(txs...)
+	for index, result := range results {
+		if result.Log != "success" {
+			t.Fatalf("FAIL: tx %d of block %d\nExpected: success\nActual: %s", index, chain.Height(), result.Log)
+		}
+	}
+}
+
+func SetMqAddressesExpectTags(t *testing.T, param did.SetMqAddressesParam, priveKFile string, nodeID string, expected []common.KVPair) {
+	paramJSON, err := json.Marshal(param)
+	if err != nil {
+		fmt.Println("error:", err)
+	}
+	idpKey := getPrivateKeyFromString(priveKFile)
+	idpNodeID := []byte(nodeID)
+	nonce := newNonce()
+	fnName := "SetMqAddresses"
+	tempPSSmessage := append([]byte(fnName), paramJSON...)
+	tempPSSmessage = append(tempPSSmessage, []byte(nonce)...)
+	PSSmessage := []byte(base64.StdEncoding.EncodeToString(tempPSSmessage))
+	newhash := crypto.SHA256
+	pssh := newhash.New()
+	pssh.Write(PSSmessage)
+	hashed := pssh.Sum(nil)
+
+	signature, err := rsa.SignPKCS1v15(rand.Reader, idpKey, newhash, hashed)
+	result, _ := callTendermint([]byte(fnName), paramJSON, []byte(nonce), signature, idpNodeID)
+	resultObj, _ := result.(ResponseTx)
+	if actual := resultObj.Result.DeliverTx.Log; actual != "success" {
+		t.Errorf("\n"+`CheckTx log: "%s"`, resultObj.Result.CheckTx.Log)
+		t.Fatalf("FAIL: %s\nExpected: %s\nActual: %s", fnName, "success", actual)
+	}
+	for _, expectedTag := range expected {
+		found := false
+		for _, tag := range resultObj.Result.DeliverTx.Tags {
+			if string(tag.Key) == string(expectedTag.Key) && string(tag.Value) == string(expectedTag.Value) {
+				found = true
+				break
+			}
+		}
+		if !found {
+			t.Fatalf("FAIL: %s\nExpected tag: %s=%s\nActual tags: %v", fnName, expectedTag.Key, expectedTag.Value, resultObj.Result.DeliverTx.Tags)
+		}
+	}
+	t.Logf("PASS: %s", fnName)
+}
+
+func SetMqAddressesProtobuf(t *testing.T, param protoParam.SetMqAddressesParams, priveKFile string, nodeID string) {
+	paramProto, err := proto.Marshal(&param)
+	if err != nil {
+		fmt.Println("error:", err)
+	}
+	idpKey := getPrivateKeyFromString(priveKFile)
+	idpNodeID := []byte(nodeID)
+	nonce := newNonce()
+	fnName := "SetMqAddresses"
+	tempPSSmessage := append([]byte(fnName), paramProto...)
+	tempPSSmessage = append(tempPSSmessage, []byte(nonce)...)
+	PSSmessage := []byte(base64.StdEncoding.EncodeToString(tempPSSmessage))
+	newhash := crypto.SHA256
+	pssh := newhash.New()
+	pssh.Write(PSSmessage)
+	hashed := pssh.Sum(nil)
+
+	signature, err := rsa.SignPKCS1v15(rand.Reader, idpKey, newhash, hashed)
+	result, _ := callTendermintProtobuf([]byte(fnName), paramProto, []byte(nonce), signature, idpNodeID)
+	resultObj, _ := result.(ResponseTx)
+	expected := "success"
+	if actual := resultObj.Result.DeliverTx.Log; actual != expected {
+		t.Errorf("\n"+`CheckTx log: "%s"`, resultObj.Result.CheckTx.Log)
+		t.Fatalf("FAIL: %s\nExpected: %#v\nActual: %#v", fnName, expected, actual)
+	}
+	t.Logf("PASS: %s", fnName)
+}
+
+func TransferTokenProtobuf(t *testing.T, param protoParam.TransferTokenParams, priveKFile string, nodeID string, expected string) {
+	paramProto, err := proto.Marshal(&param)
+	if err != nil {
+		fmt.Println("error:", err)
+	}
+	privKey := getPrivateKeyFromString(priveKFile)
+	nonce := newNonce()
+	fnName := "TransferToken"
+	tempPSSmessage := append([]byte(fnName), paramProto...)
+	tempPSSmessage = append(tempPSSmessage, []byte(nonce)...)
+	PSSmessage := []byte(base64.StdEncoding.EncodeToString(tempPSSmessage))
+	newhash := crypto.SHA256
+	pssh := newhash.New()
+	pssh.Write(PSSmessage)
+	hashed := pssh.Sum(nil)
+
+	signature, err := rsa.SignPKCS1v15(rand.Reader, privKey, newhash, hashed)
+	result, _ := callTendermintProtobuf([]byte(fnName), paramProto, []byte(nonce), signature, []byte(nodeID))
+	resultObj, _ := result.(ResponseTx)
+	if actual := resultObj.Result.DeliverTx.Log; actual != expected {
+		t.Errorf("\n"+`CheckTx log: "%s"`, resultObj.Result.CheckTx.Log)
+		t.Fatalf("FAIL: %s\nExpected: %#v\nActual: %#v", fnName, expected, actual)
+	}
+	t.Logf("PASS: %s", fnName)
+}
+
+func TransferTokenProtobufExpectLog(t *testing.T, param protoParam.TransferTokenParams, priveKFile string, nodeID string, expected string) {
+	paramProto, err := proto.Marshal(&param)
+	if err != nil {
+		fmt.Println("error:", err)
+	}
+	privKey := getPrivateKeyFromString(priveKFile)
+	nonce := newNonce()
+	fnName := "TransferToken"
+	tempPSSmessage := append([]byte(fnName), paramProto...)
+	tempPSSmessage = append(tempPSSmessage, []byte(nonce)...)
+	PSSmessage := []byte(base64.StdEncoding.EncodeToString(tempPSSmessage))
+	newhash := crypto.SHA256
+	pssh := newhash.New()
+	pssh.Write(PSSmessage)
+	hashed := pssh.Sum(nil)
+
+	signature, err := rsa.SignPKCS1v15(rand.Reader, privKey, newhash, hashed)
+	result, _ := callTendermintProtobuf([]byte(fnName), paramProto, []byte(nonce), signature, []byte(nodeID))
+	resultObj, _ := result.(ResponseTx)
+	if actual := resultObj.Result.CheckTx.Log; actual != expected {
+		t.Fatalf("FAIL: %s\nExpected: %s\nActual: %s", fnName, expected, actual)
+	}
+	t.Logf("PASS: %s", fnName)
+}
+
+func SetMqAddressesWithECDSAKey(t *testing.T, param did.SetMqAddressesParam, privKey *ecdsa.PrivateKey, nodeID string) {
+	paramJSON, err := json.Marshal(param)
+	if err != nil {
+		fmt.Println("error:", err)
+	}
+	nonce := newNonce()
+	fnName := "SetMqAddresses"
+	tempPSSmessage := append([]byte(fnName), paramJSON...)
+	tempPSSmessage = append(tempPSSmessage, []byte(nonce)...)
+	PSSmessage := []byte(base64.StdEncoding.EncodeToString(tempPSSmessage))
+	newhash := crypto.SHA256
+	pssh := newhash.New()
+	pssh.Write(PSSmessage)
+	hashed := pssh.Sum(nil)
+
+	signature, err := privKey.Sign(rand.Reader, hashed, newhash)
+	result, _ := callTendermint([]byte(fnName), paramJSON, []byte(nonce), signature, []byte(nodeID))
+	resultObj, _ := result.(ResponseTx)
+	expected := "success"
+	if actual := resultObj.Result.DeliverTx.Log; actual != expected {
+		t.Errorf("\n"+`CheckTx log: "%s"`, resultObj.Result.CheckTx.Log)
+		t.Fatalf("FAIL: %s\nExpected: %#v\nActual: %#v", fnName, expected, actual)
+	}
+	t.Logf("PASS: %s", fnName)
+}
+
+func SetMqAddressesWithEd25519Key(t *testing.T, param did.SetMqAddressesParam, privKey ed25519.PrivateKey, nodeID string) {
+	paramJSON, err := json.Marshal(param)
+	if err != nil {
+		fmt.Println("error:", err)
+	}
+	nonce := newNonce()
+	fnName := "SetMqAddresses"
+	tempPSSmessage := append([]byte(fnName), paramJSON...)
+	tempPSSmessage = append(tempPSSmessage, []byte(nonce)...)
+	PSSmessage := []byte(base64.StdEncoding.EncodeToString(tempPSSmessage))
+
+	signature := ed25519.Sign(privKey, PSSmessage)
+	result, _ := callTendermint([]byte(fnName), paramJSON, []byte(nonce), signature, []byte(nodeID))
+	resultObj, _ := result.(ResponseTx)
+	expected := "success"
+	if actual := resultObj.Result.DeliverTx.Log; actual != expected {
+		t.Errorf("\n"+`CheckTx log: "%s"`, resultObj.Result.CheckTx.Log)
+		t.Fatalf("FAIL: %s\nExpected: %#v\nActual: %#v", fnName, expected, actual)
+	}
+	t.Logf("PASS: %s", fnName)
+}
+
+func SetMqAddressesWithPSS(t *testing.T, param did.SetMqAddressesParam, priveKFile string, nodeID string) {
+	paramJSON, err := json.Marshal(param)
+	if err != nil {
+		fmt.Println("error:", err)
+	}
+	privKey := getPrivateKeyFromString(priveKFile)
+	nonce := newNonce()
+	fnName := "SetMqAddresses"
+	tempPSSmessage := append([]byte(fnName), paramJSON...)
+	tempPSSmessage = append(tempPSSmessage, []byte(nonce)...)
+	PSSmessage := []byte(base64.StdEncoding.EncodeToString(tempPSSmessage))
+	newhash := crypto.SHA256
+	pssh := newhash.New()
+	pssh.Write(PSSmessage)
+	hashed := pssh.Sum(nil)
+
+	signature, err := rsa.SignPSS(rand.Reader, privKey, newhash, hashed, nil)
+	result, _ := callTendermint([]byte(fnName), paramJSON, []byte(nonce), signature, []byte(nodeID))
+	resultObj, _ := result.(ResponseTx)
+	expected := "success"
+	if actual := resultObj.Result.DeliverTx.Log; actual != expected {
+		t.Errorf("\n"+`CheckTx log: "%s"`, resultObj.Result.CheckTx.Log)
+		t.Fatalf("FAIL: %s\nExpected: %#v\nActual: %#v", fnName, expected, actual)
+	}
+	t.Logf("PASS: %s", fnName)
+}
+
+func CancelMasterKeyRecovery(t *testing.T, masterPriveKFile string, nodeID string) {
+	var param did.CancelMasterKeyRecoveryParam
+	masterKey := getPrivateKeyFromString(masterPriveKFile)
+	paramJSON, err := json.Marshal(param)
+	if err != nil {
+		fmt.Println("error:", err)
+	}
+	nonce := newNonce()
+	fnName := "CancelMasterKeyRecovery"
+	tempPSSmessage := append([]byte(fnName), paramJSON...)
+	tempPSSmessage = append(tempPSSmessage, []byte(nonce)...)
+	PSSmessage := []byte(base64.StdEncoding.EncodeToString(tempPSSmessage))
+	newhash := crypto.SHA256
+	pssh := newhash.New()
+	pssh.Write(PSSmessage)
+	hashed := pssh.Sum(nil)
+
+	signature, err := rsa.SignPKCS1v15(rand.Reader, masterKey, newhash, hashed)
+	result, _ := callTendermint([]byte(fnName), paramJSON, []byte(nonce), signature, []byte(nodeID))
+	resultObj, _ := result.(ResponseTx)
+	expected := "success"
+	if actual := resultObj.Result.DeliverTx.Log; actual != expected {
+		t.Errorf("\n"+`CheckTx log: "%s"`, resultObj.Result.CheckTx.Log)
+		t.Fatalf("FAIL: %s\nExpected: %#v\nActual: %#v", fnName, expected, actual)
+	}
+	if actual := getTagValue(resultObj, "master_key_recovery.status"); actual != "cancelled" {
+		t.Fatalf("FAIL: %s\nExpected: %#v\nActual: %#v", fnName, "cancelled", actual)
+	}
+	t.Logf("PASS: %s", fnName)
+}
+
+func Batch(t *testing.T, param did.BatchParam, priveKFile string, nodeID string, expected string) {
+	paramJSON, err := json.Marshal(param)
+	if err != nil {
+		fmt.Println("error:", err)
+	}
+	privKey := getPrivateKeyFromString(priveKFile)
+	nonce := newNonce()
+	fnName := "Batch"
+	tempPSSmessage := append([]byte(fnName), paramJSON...)
+	tempPSSmessage = append(tempPSSmessage, []byte(nonce)...)
+	PSSmessage := []byte(base64.StdEncoding.EncodeToString(tempPSSmessage))
+	newhash := crypto.SHA256
+	pssh := newhash.New()
+	pssh.Write(PSSmessage)
+	hashed := pssh.Sum(nil)
+
+	signature, err := rsa.SignPKCS1v15(rand.Reader, privKey, newhash, hashed)
+	result, _ := callTendermint([]byte(fnName), paramJSON, []byte(nonce), signature, []byte(nodeID))
+	resultObj, _ := result.(ResponseTx)
+	if actual := resultObj.Result.DeliverTx.Log; actual != expected {
+		t.Errorf("\n"+`CheckTx log: "%s"`, resultObj.Result.CheckTx.Log)
+		t.Fatalf("FAIL: %s\nExpected: %#v\nActual: %#v", fnName, expected, actual)
+	}
+	t.Logf("PASS: %s", fnName)
+}
+
+func TransferTokenWithNonce(t *testing.T, param did.TransferTokenParam, priveKFile string, nodeID string, nonce string, expected string) {
+	paramJSON, err := json.Marshal(param)
+	if err != nil {
+		fmt.Println("error:", err)
+	}
+	privKey := getPrivateKeyFromString(priveKFile)
+	fnName := "TransferToken"
+	tempPSSmessage := append([]byte(fnName), paramJSON...)
+	tempPSSmessage = append(tempPSSmessage, []byte(nonce)...)
+	PSSmessage := []byte(base64.StdEncoding.EncodeToString(tempPSSmessage))
+	newhash := crypto.SHA256
+	pssh := newhash.New()
+	pssh.Write(PSSmessage)
+	hashed := pssh.Sum(nil)
+
+	signature, err := rsa.SignPKCS1v15(rand.Reader, privKey, newhash, hashed)
+	result, _ := callTendermint([]byte(fnName), paramJSON, []byte(nonce), signature, []byte(nodeID))
+	resultObj, _ := result.(ResponseTx)
+	actual := resultObj.Result.DeliverTx.Log
+	if resultObj.Result.CheckTx.Code != 0 {
+		actual = resultObj.Result.CheckTx.Log
+	}
+	if actual != expected {
+		t.Fatalf("FAIL: %s\nExpected: %#v\nActual: %#v", fnName, expected, actual)
+	}
+	t.Logf("PASS: %s", fnName)
+}
+
+func BatchExpectLog(t *testing.T, param did.BatchParam, priveKFile string, nodeID string, expected string) {
+	paramJSON, err := json.Marshal(param)
+	if err != nil {
+		fmt.Println("error:", err)
+	}
+	privKey := getPrivateKeyFromString(priveKFile)
+	nonce := newNonce()
+	fnName := "Batch"
+	tempPSSmessage := append([]byte(fnName), paramJSON...)
+	tempPSSmessage = append(tempPSSmessage, []byte(nonce)...)
+	PSSmessage := []byte(base64.StdEncoding.EncodeToString(tempPSSmessage))
+	newhash := crypto.SHA256
+	pssh := newhash.New()
+	pssh.Write(PSSmessage)
+	hashed := pssh.Sum(nil)
+
+	signature, err := rsa.SignPKCS1v15(rand.Reader, privKey, newhash, hashed)
+	result, _ := callTendermint([]byte(fnName), paramJSON, []byte(nonce), signature, []byte(nodeID))
+	resultObj, _ := result.(ResponseTx)
+	if actual := resultObj.Result.CheckTx.Log; actual != expected {
+		t.Fatalf("FAIL: %s\nExpected: %s\nActual: %s", fnName, expected, actual)
+	}
+	t.Logf("PASS: %s", fnName)
+}
+
+func TransferToken(t *testing.T, param did.TransferTokenParam, priveKFile string, nodeID string, expected string) {
+	paramJSON, err := json.Marshal(param)
+	if err != nil {
+		fmt.Println("error:", err)
+	}
+	privKey := getPrivateKeyFromString(priveKFile)
+	nonce := newNonce()
+	fnName := "TransferToken"
+	tempPSSmessage := append([]byte(fnName), paramJSON...)
+	tempPSSmessage = append(tempPSSmessage, []byte(nonce)...)
+	PSSmessage := []byte(base64.StdEncoding.EncodeToString(tempPSSmessage))
+	newhash := crypto.SHA256
+	pssh := newhash.New()
+	pssh.Write(PSSmessage)
+	hashed := pssh.Sum(nil)
+
+	signature, err := rsa.SignPKCS1v15(rand.Reader, privKey, newhash, hashed)
+	result, _ := callTendermint([]byte(fnName), paramJSON, []byte(nonce), signature, []byte(nodeID))
+	resultObj, _ := result.(ResponseTx)
+	if actual := resultObj.Result.DeliverTx.Log; actual != expected {
+		t.Errorf("\n"+`CheckTx log: "%s"`, resultObj.Result.CheckTx.Log)
+		t.Fatalf("FAIL: %s\nExpected: %#v\nActual: %#v", fnName, expected, actual)
+	}
+	t.Logf("PASS: %s", fnName)
+}
+
 func CreateRequest(t *testing.T, param did.Request, priveKFile string, nodeID string) {
 	privKey := getPrivateKeyFromString(priveKFile)
 	byteNodeID := []byte(nodeID)
@@ -70,7 +547,7 @@ func CreateRequest(t *testing.T, param did.Request, priveKFile string, nodeID st
 	if err != nil {
 		fmt.Println("error:", err)
 	}
-	nonce := base64.StdEncoding.EncodeToString([]byte(common.RandStr(12)))
+	nonce := newNonce()
 	fnName := "CreateRequest"
 	tempPSSmessage := append([]byte(fnName), paramJSON...)
 	tempPSSmessage = append(tempPSSmessage, []byte(nonce)...)
@@ -98,7 +575,7 @@ func CreateRequestExpectLog(t *testing.T, param did.Request, priveKFile string,
 	if err != nil {
 		fmt.Println("error:", err)
 	}
-	nonce := base64.StdEncoding.EncodeToString([]byte(common.RandStr(12)))
+	nonce := newNonce()
 	fnName := "CreateRequest"
 	tempPSSmessage := append([]byte(fnName), paramJSON...)
 	tempPSSmessage = append(tempPSSmessage, []byte(nonce)...)
@@ -125,7 +602,7 @@ func CreateRequestExpectLogDeliverTx(t *testing.T, param did.Request, priveKFile
 	if err != nil {
 		fmt.Println("error:", err)
 	}
-	nonce := base64.StdEncoding.EncodeToString([]byte(common.RandStr(12)))
+	nonce := newNonce()
 	fnName := "CreateRequest"
 	tempPSSmessage := append([]byte(fnName), paramJSON...)
 	tempPSSmessage = append(tempPSSmessage, []byte(nonce)...)
@@ -145,14 +622,57 @@ func CreateRequestExpectLogDeliverTx(t *testing.T, param did.Request, priveKFile
 	t.Logf("PASS: %s", fnName)
 }
 
+func CreateRequestExpectTags(t *testing.T, param did.Request, priveKFile string, nodeID string, expected []common.KVPair) {
+	privKey := getPrivateKeyFromString(priveKFile)
+	byteNodeID := []byte(nodeID)
+	paramJSON, err := json.Marshal(param)
+	if err != nil {
+		fmt.Println("error:", err)
+	}
+	nonce := newNonce()
+	fnName := "CreateRequest"
+	tempPSSmessage := append([]byte(fnName), paramJSON...)
+	tempPSSmessage = append(tempPSSmessage, []byte(nonce)...)
+	PSSmessage := []byte(base64.StdEncoding.EncodeToString(tempPSSmessage))
+	newhash := crypto.SHA256
+	pssh := newhash.New()
+	pssh.Write(PSSmessage)
+	hashed := pssh.Sum(nil)
+
+	signature, err := rsa.SignPKCS1v15(rand.Reader, privKey, newhash, hashed)
+	result, _ := callTendermint([]byte(fnName), paramJSON, []byte(nonce), signature, byteNodeID)
+	resultObj, _ := result.(ResponseTx)
+	if actual := resultObj.Result.DeliverTx.Log; actual != "success" {
+		t.Errorf("\n"+`CheckTx log: "%s"`, resultObj.Result.CheckTx.Log)
+		t.Fatalf("FAIL: %s\nExpected: %s\nActual: %s", fnName, "success", actual)
+	}
+	for _, expectedTag := range expected {
+		found := false
+		for _, tag := range resultObj.Result.DeliverTx.Tags {
+			if string(tag.Key) == string(expectedTag.Key) && string(tag.Value) == string(expectedTag.Value) {
+				found = true
+				break
+			}
+		}
+		if !found {
+			t.Fatalf("FAIL: %s\nExpected tag: %s=%s\nActual tags: %v", fnName, expectedTag.Key, expectedTag.Value, resultObj.Result.DeliverTx.Tags)
+		}
+	}
+	t.Logf("PASS: %s", fnName)
+}
+
 func UpdateNode(t *testing.T, param did.UpdateNodeParam, masterPriveKFile string, nodeID string) {
+	UpdateNodeExpectString(t, param, masterPriveKFile, nodeID, "success")
+}
+
+func UpdateNodeExpectString(t *testing.T, param did.UpdateNodeParam, masterPriveKFile string, nodeID string, expected string) {
 	masterKey := getPrivateKeyFromString(masterPriveKFile)
 	paramJSON, err := json.Marshal(param)
 	if err != nil {
 		fmt.Println("error:", err)
 	}
 	byteNodeID := []byte(nodeID)
-	nonce := base64.StdEncoding.EncodeToString([]byte(common.RandStr(12)))
+	nonce := newNonce()
 	fnName := "UpdateNode"
 	tempPSSmessage := append([]byte(fnName), paramJSON...)
 	tempPSSmessage = append(tempPSSmessage, []byte(nonce)...)
@@ -165,7 +685,6 @@ func UpdateNode(t *testing.T, param did.UpdateNodeParam, masterPriveKFile string
 	signature, err := rsa.SignPKCS1v15(rand.Reader, masterKey, newhash, hashed)
 	result, _ := callTendermint([]byte(fnName), paramJSON, []byte(nonce), signature, byteNodeID)
 	resultObj, _ := result.(ResponseTx)
-	expected := "success"
 	if actual := resultObj.Result.DeliverTx.Log; actual != expected {
 		t.Errorf("\n"+`CheckTx log: "%s"`, resultObj.Result.CheckTx.Log)
 		t.Fatalf("FAIL: %s\nExpected: %#v\nActual: %#v", fnName, expected, actual)
@@ -54,11 +54,25 @@ type ResponseQuery struct {
 		Response struct {
 			Log    string `json:"log"`
 			Value  string `json:"value"`
+			Proof  string `json:"proof"`
 			Height string `json:"height"`
 		} `json:"response"`
 	} `json:"result"`
 }
 
+type ResponseABCIInfo struct {
+	Jsonrpc string `json:"jsonrpc"`
+	ID      string `json:"id"`
+	Result  struct {
+		Response struct {
+			Data             string `json:"data"`
+			Version          string `json:"version"`
+			LastBlockHeight  string `json:"last_block_height"`
+			LastBlockAppHash string `json:"last_block_app_hash"`
+		} `json:"response"`
+	} `json:"result"`
+}
+
 type ResponseStatus struct {
 	Jsonrpc string `json:"jsonrpc"`
 	ID      string `json:"id"`
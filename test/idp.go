@@ -32,7 +32,6 @@ import (
 	"testing"
 
 	did "github.com/ndidplatform/smart-contract/abci/did/v1"
-	"github.com/tendermint/tendermint/libs/common"
 )
 
 func RegisterIdentity(t *testing.T, param did.RegisterIdentityParam, privKeyFile string, nodeID string, expected string) {
@@ -42,7 +41,7 @@ func RegisterIdentity(t *testing.T, param did.RegisterIdentityParam, privKeyFile
 	if err != nil {
 		fmt.Println("error:", err)
 	}
-	nonce := base64.StdEncoding.EncodeToString([]byte(common.RandStr(12)))
+	nonce := newNonce()
 	fnName := "RegisterIdentity"
 	tempPSSmessage := append([]byte(fnName), paramJSON...)
 	tempPSSmessage = append(tempPSSmessage, []byte(nonce)...)
@@ -69,7 +68,7 @@ func DeclareIdentityProof(t *testing.T, param did.DeclareIdentityProofParam, pri
 	if err != nil {
 		fmt.Println("error:", err)
 	}
-	nonce := base64.StdEncoding.EncodeToString([]byte(common.RandStr(12)))
+	nonce := newNonce()
 	fnName := "DeclareIdentityProof"
 	tempPSSmessage := append([]byte(fnName), paramJSON...)
 	tempPSSmessage = append(tempPSSmessage, []byte(nonce)...)
@@ -97,7 +96,7 @@ func CreateIdpResponse(t *testing.T, param did.CreateIdpResponseParam, privKeyFi
 	if err != nil {
 		fmt.Println("error:", err)
 	}
-	nonce := base64.StdEncoding.EncodeToString([]byte(common.RandStr(12)))
+	nonce := newNonce()
 	fnName := "CreateIdpResponse"
 	tempPSSmessage := append([]byte(fnName), paramJSON...)
 	tempPSSmessage = append(tempPSSmessage, []byte(nonce)...)
@@ -118,6 +117,33 @@ func CreateIdpResponse(t *testing.T, param did.CreateIdpResponseParam, privKeyFi
 	t.Logf("PASS: %s", fnName)
 }
 
+func CreateIdpResponseExpectLog(t *testing.T, param did.CreateIdpResponseParam, privKeyFile string, nodeID string, expected string) {
+	idpKey := getPrivateKeyFromString(privKeyFile)
+	idpNodeID := []byte(nodeID)
+	paramJSON, err := json.Marshal(param)
+	if err != nil {
+		fmt.Println("error:", err)
+	}
+	nonce := newNonce()
+	fnName := "CreateIdpResponse"
+	tempPSSmessage := append([]byte(fnName), paramJSON...)
+	tempPSSmessage = append(tempPSSmessage, []byte(nonce)...)
+	PSSmessage := []byte(base64.StdEncoding.EncodeToString(tempPSSmessage))
+	newhash := crypto.SHA256
+	pssh := newhash.New()
+	pssh.Write(PSSmessage)
+	hashed := pssh.Sum(nil)
+
+	signature, err := rsa.SignPKCS1v15(rand.Reader, idpKey, newhash, hashed)
+	result, _ := callTendermint([]byte(fnName), paramJSON, []byte(nonce), signature, idpNodeID)
+	resultObj, _ := result.(ResponseTx)
+	if actual := resultObj.Result.DeliverTx.Log; actual != expected {
+		t.Errorf("\n"+`CheckTx log: "%s"`, resultObj.Result.CheckTx.Log)
+		t.Fatalf("FAIL: %s\nExpected: %s\nActual: %s", fnName, expected, actual)
+	}
+	t.Logf("PASS: %s", fnName)
+}
+
 func RegisterAccessor(t *testing.T, param did.RegisterAccessorParam, nodeID string) {
 	idpKey := getPrivateKeyFromString(idpPrivK)
 	idpNodeID := []byte(nodeID)
@@ -125,7 +151,7 @@ func RegisterAccessor(t *testing.T, param did.RegisterAccessorParam, nodeID stri
 	if err != nil {
 		fmt.Println("error:", err)
 	}
-	nonce := base64.StdEncoding.EncodeToString([]byte(common.RandStr(12)))
+	nonce := newNonce()
 	fnName := "RegisterAccessor"
 	tempPSSmessage := append([]byte(fnName), paramJSON...)
 	tempPSSmessage = append(tempPSSmessage, []byte(nonce)...)
@@ -153,7 +179,7 @@ func AddAccessorMethod(t *testing.T, param did.AccessorMethod, nodeID string, va
 	if err != nil {
 		fmt.Println("error:", err)
 	}
-	nonce := base64.StdEncoding.EncodeToString([]byte(common.RandStr(12)))
+	nonce := newNonce()
 	fnName := "AddAccessorMethod"
 	tempPSSmessage := append([]byte(fnName), paramJSON...)
 	tempPSSmessage = append(tempPSSmessage, []byte(nonce)...)
@@ -184,7 +210,7 @@ func ClearRegisterIdentityTimeout(t *testing.T, param did.ClearRegisterIdentityT
 	if err != nil {
 		fmt.Println("error:", err)
 	}
-	nonce := base64.StdEncoding.EncodeToString([]byte(common.RandStr(12)))
+	nonce := newNonce()
 	fnName := "ClearRegisterIdentityTimeout"
 	tempPSSmessage := append([]byte(fnName), paramJSON...)
 	tempPSSmessage = append(tempPSSmessage, []byte(nonce)...)
@@ -212,7 +238,7 @@ func UpdateIdentity(t *testing.T, param did.UpdateIdentityParam, nodeID string)
 	if err != nil {
 		fmt.Println("error:", err)
 	}
-	nonce := base64.StdEncoding.EncodeToString([]byte(common.RandStr(12)))
+	nonce := newNonce()
 	fnName := "UpdateIdentity"
 	tempPSSmessage := append([]byte(fnName), paramJSON...)
 	tempPSSmessage = append(tempPSSmessage, []byte(nonce)...)
@@ -240,7 +266,7 @@ func RevokeAccessorMethod(t *testing.T, param did.RevokeAccessorMethodParam, nod
 	if err != nil {
 		fmt.Println("error:", err)
 	}
-	nonce := base64.StdEncoding.EncodeToString([]byte(common.RandStr(12)))
+	nonce := newNonce()
 	fnName := "RevokeAccessorMethod"
 	tempPSSmessage := append([]byte(fnName), paramJSON...)
 	tempPSSmessage = append(tempPSSmessage, []byte(nonce)...)
@@ -34,7 +34,6 @@ import (
 	"time"
 
 	did "github.com/ndidplatform/smart-contract/abci/did/v1"
-	"github.com/tendermint/tendermint/libs/common"
 )
 
 func InitNDID(t *testing.T) {
@@ -53,7 +52,7 @@ func InitNDID(t *testing.T) {
 		fmt.Println("error:", err)
 	}
 	fnName := "InitNDID"
-	nonce := base64.StdEncoding.EncodeToString([]byte(common.RandStr(12)))
+	nonce := newNonce()
 	tempPSSmessage := append([]byte(fnName), paramJSON...)
 	tempPSSmessage = append(tempPSSmessage, []byte(nonce)...)
 	PSSmessage := []byte(base64.StdEncoding.EncodeToString(tempPSSmessage))
@@ -87,7 +86,7 @@ func RegisterNode(t *testing.T, param did.RegisterNode) {
 	ndidKey := getPrivateKeyFromString(ndidPrivK)
 	ndidNodeID := []byte("NDID")
 	fnName := "RegisterNode"
-	nonce := base64.StdEncoding.EncodeToString([]byte(common.RandStr(12)))
+	nonce := newNonce()
 	tempPSSmessage := append([]byte(fnName), paramJSON...)
 	tempPSSmessage = append(tempPSSmessage, []byte(nonce)...)
 	PSSmessage := []byte(base64.StdEncoding.EncodeToString(tempPSSmessage))
@@ -115,7 +114,35 @@ func SetTimeOutBlockRegisterIdentity(t *testing.T) {
 		fmt.Println("error:", err)
 	}
 	fnName := "SetTimeOutBlockRegisterIdentity"
-	nonce := base64.StdEncoding.EncodeToString([]byte(common.RandStr(12)))
+	nonce := newNonce()
+	tempPSSmessage := append([]byte(fnName), paramJSON...)
+	tempPSSmessage = append(tempPSSmessage, []byte(nonce)...)
+	PSSmessage := []byte(base64.StdEncoding.EncodeToString(tempPSSmessage))
+	newhash := crypto.SHA256
+	pssh := newhash.New()
+	pssh.Write(PSSmessage)
+	hashed := pssh.Sum(nil)
+	signature, err := rsa.SignPKCS1v15(rand.Reader, ndidKey, newhash, hashed)
+	result, _ := callTendermint([]byte(fnName), paramJSON, []byte(nonce), signature, []byte("NDID"))
+	resultObj, _ := result.(ResponseTx)
+	expected := "success"
+	if actual := resultObj.Result.DeliverTx.Log; actual != expected {
+		t.Errorf("\n"+`CheckTx log: "%s"`, resultObj.Result.CheckTx.Log)
+		t.Fatalf("FAIL: %s\nExpected: %#v\nActual: %#v", fnName, expected, actual)
+	}
+	t.Logf("PASS: %s", fnName)
+}
+
+func SetTimeOutBlockRegisterIdentityTo(t *testing.T, timeOutBlock int64) {
+	ndidKey := getPrivateKeyFromString(ndidPrivK)
+	var param did.TimeOutBlockRegisterIdentity
+	param.TimeOutBlock = timeOutBlock
+	paramJSON, err := json.Marshal(param)
+	if err != nil {
+		fmt.Println("error:", err)
+	}
+	fnName := "SetTimeOutBlockRegisterIdentity"
+	nonce := newNonce()
 	tempPSSmessage := append([]byte(fnName), paramJSON...)
 	tempPSSmessage = append(tempPSSmessage, []byte(nonce)...)
 	PSSmessage := []byte(base64.StdEncoding.EncodeToString(tempPSSmessage))
@@ -142,7 +169,7 @@ func AddNodeToken(t *testing.T, param did.AddNodeTokenParam) {
 		fmt.Println("error:", err)
 	}
 	fnName := "AddNodeToken"
-	nonce := base64.StdEncoding.EncodeToString([]byte(common.RandStr(12)))
+	nonce := newNonce()
 	tempPSSmessage := append([]byte(fnName), paramJSON...)
 	tempPSSmessage = append(tempPSSmessage, []byte(nonce)...)
 	PSSmessage := []byte(base64.StdEncoding.EncodeToString(tempPSSmessage))
@@ -170,7 +197,7 @@ func ReduceNodeToken(t *testing.T, param did.ReduceNodeTokenParam) {
 		fmt.Println("error:", err)
 	}
 	fnName := "ReduceNodeToken"
-	nonce := base64.StdEncoding.EncodeToString([]byte(common.RandStr(12)))
+	nonce := newNonce()
 	tempPSSmessage := append([]byte(fnName), paramJSON...)
 	tempPSSmessage = append(tempPSSmessage, []byte(nonce)...)
 	PSSmessage := []byte(base64.StdEncoding.EncodeToString(tempPSSmessage))
@@ -199,7 +226,7 @@ func SetNodeToken(t *testing.T, param did.SetNodeTokenParam) {
 	}
 
 	fnName := "SetNodeToken"
-	nonce := base64.StdEncoding.EncodeToString([]byte(common.RandStr(12)))
+	nonce := newNonce()
 	tempPSSmessage := append([]byte(fnName), paramJSON...)
 	tempPSSmessage = append(tempPSSmessage, []byte(nonce)...)
 	PSSmessage := []byte(base64.StdEncoding.EncodeToString(tempPSSmessage))
@@ -228,7 +255,7 @@ func AddService(t *testing.T, param did.AddServiceParam) {
 	}
 
 	fnName := "AddService"
-	nonce := base64.StdEncoding.EncodeToString([]byte(common.RandStr(12)))
+	nonce := newNonce()
 	tempPSSmessage := append([]byte(fnName), paramJSON...)
 	tempPSSmessage = append(tempPSSmessage, []byte(nonce)...)
 	PSSmessage := []byte(base64.StdEncoding.EncodeToString(tempPSSmessage))
@@ -256,7 +283,7 @@ func DisableService(t *testing.T, param did.DisableServiceParam) {
 		fmt.Println("error:", err)
 	}
 	fnName := "DisableService"
-	nonce := base64.StdEncoding.EncodeToString([]byte(common.RandStr(12)))
+	nonce := newNonce()
 	tempPSSmessage := append([]byte(fnName), paramJSON...)
 	tempPSSmessage = append(tempPSSmessage, []byte(nonce)...)
 	PSSmessage := []byte(base64.StdEncoding.EncodeToString(tempPSSmessage))
@@ -284,7 +311,7 @@ func EnableService(t *testing.T, param did.DisableServiceParam) {
 		fmt.Println("error:", err)
 	}
 	fnName := "EnableService"
-	nonce := base64.StdEncoding.EncodeToString([]byte(common.RandStr(12)))
+	nonce := newNonce()
 	tempPSSmessage := append([]byte(fnName), paramJSON...)
 	tempPSSmessage = append(tempPSSmessage, []byte(nonce)...)
 	PSSmessage := []byte(base64.StdEncoding.EncodeToString(tempPSSmessage))
@@ -312,7 +339,7 @@ func RegisterServiceDestinationByNDID(t *testing.T, param did.RegisterServiceDes
 		log.Fatal(err.Error())
 	}
 	fnName := "RegisterServiceDestinationByNDID"
-	nonce := base64.StdEncoding.EncodeToString([]byte(common.RandStr(12)))
+	nonce := newNonce()
 	tempPSSmessage := append([]byte(fnName), paramJSON...)
 	tempPSSmessage = append(tempPSSmessage, []byte(nonce)...)
 	PSSmessage := []byte(base64.StdEncoding.EncodeToString(tempPSSmessage))
@@ -340,7 +367,7 @@ func UpdateService(t *testing.T, param did.UpdateServiceParam) {
 		fmt.Println("error:", err)
 	}
 	fnName := "UpdateService"
-	nonce := base64.StdEncoding.EncodeToString([]byte(common.RandStr(12)))
+	nonce := newNonce()
 	tempPSSmessage := append([]byte(fnName), paramJSON...)
 	tempPSSmessage = append(tempPSSmessage, []byte(nonce)...)
 	PSSmessage := []byte(base64.StdEncoding.EncodeToString(tempPSSmessage))
@@ -369,7 +396,7 @@ func SetPriceFunc(t *testing.T, param did.SetPriceFuncParam) {
 	ndidKey := getPrivateKeyFromString(ndidPrivK)
 	ndidNodeID := []byte("NDID")
 	fnName := "SetPriceFunc"
-	nonce := base64.StdEncoding.EncodeToString([]byte(common.RandStr(12)))
+	nonce := newNonce()
 	tempPSSmessage := append([]byte(fnName), paramJSON...)
 	tempPSSmessage = append(tempPSSmessage, []byte(nonce)...)
 	PSSmessage := []byte(base64.StdEncoding.EncodeToString(tempPSSmessage))
@@ -397,7 +424,7 @@ func AddNamespace(t *testing.T, param did.Namespace) {
 		fmt.Println("error:", err)
 	}
 	fnName := "AddNamespace"
-	nonce := base64.StdEncoding.EncodeToString([]byte(common.RandStr(12)))
+	nonce := newNonce()
 	tempPSSmessage := append([]byte(fnName), paramJSON...)
 	tempPSSmessage = append(tempPSSmessage, []byte(nonce)...)
 	PSSmessage := []byte(base64.StdEncoding.EncodeToString(tempPSSmessage))
@@ -425,7 +452,7 @@ func DisableNamespace(t *testing.T, param did.DisableNamespaceParam) {
 		fmt.Println("error:", err)
 	}
 	fnName := "DisableNamespace"
-	nonce := base64.StdEncoding.EncodeToString([]byte(common.RandStr(12)))
+	nonce := newNonce()
 	tempPSSmessage := append([]byte(fnName), paramJSON...)
 	tempPSSmessage = append(tempPSSmessage, []byte(nonce)...)
 	PSSmessage := []byte(base64.StdEncoding.EncodeToString(tempPSSmessage))
@@ -453,7 +480,7 @@ func EnableNamespace(t *testing.T, param did.DisableNamespaceParam) {
 		fmt.Println("error:", err)
 	}
 	fnName := "EnableNamespace"
-	nonce := base64.StdEncoding.EncodeToString([]byte(common.RandStr(12)))
+	nonce := newNonce()
 	tempPSSmessage := append([]byte(fnName), paramJSON...)
 	tempPSSmessage = append(tempPSSmessage, []byte(nonce)...)
 	PSSmessage := []byte(base64.StdEncoding.EncodeToString(tempPSSmessage))
@@ -480,7 +507,7 @@ func SetValidator(t *testing.T, param did.SetValidatorParam) {
 		fmt.Println("error:", err)
 	}
 	fnName := "SetValidator"
-	nonce := base64.StdEncoding.EncodeToString([]byte(common.RandStr(12)))
+	nonce := newNonce()
 	tempPSSmessage := append([]byte(fnName), paramJSON...)
 	tempPSSmessage = append(tempPSSmessage, []byte(nonce)...)
 	PSSmessage := []byte(base64.StdEncoding.EncodeToString(tempPSSmessage))
@@ -508,7 +535,7 @@ func UpdateNodeByNDID(t *testing.T, param did.UpdateNodeByNDIDParam) {
 	fnName := "UpdateNodeByNDID"
 	ndidKey := getPrivateKeyFromString(ndidPrivK)
 	ndidNodeID := []byte("NDID")
-	nonce := base64.StdEncoding.EncodeToString([]byte(common.RandStr(12)))
+	nonce := newNonce()
 	tempPSSmessage := append([]byte(fnName), paramJSON...)
 	tempPSSmessage = append(tempPSSmessage, []byte(nonce)...)
 	PSSmessage := []byte(base64.StdEncoding.EncodeToString(tempPSSmessage))
@@ -536,7 +563,7 @@ func DisableNode(t *testing.T, param did.DisableNodeParam) {
 	ndidKey := getPrivateKeyFromString(ndidPrivK)
 	ndidNodeID := []byte("NDID")
 	fnName := "DisableNode"
-	nonce := base64.StdEncoding.EncodeToString([]byte(common.RandStr(12)))
+	nonce := newNonce()
 	tempPSSmessage := append([]byte(fnName), paramJSON...)
 	tempPSSmessage = append(tempPSSmessage, []byte(nonce)...)
 	PSSmessage := []byte(base64.StdEncoding.EncodeToString(tempPSSmessage))
@@ -564,7 +591,7 @@ func EnableNode(t *testing.T, param did.DisableNodeParam) {
 	ndidKey := getPrivateKeyFromString(ndidPrivK)
 	ndidNodeID := []byte("NDID")
 	fnName := "EnableNode"
-	nonce := base64.StdEncoding.EncodeToString([]byte(common.RandStr(12)))
+	nonce := newNonce()
 	tempPSSmessage := append([]byte(fnName), paramJSON...)
 	tempPSSmessage = append(tempPSSmessage, []byte(nonce)...)
 	PSSmessage := []byte(base64.StdEncoding.EncodeToString(tempPSSmessage))
@@ -592,7 +619,7 @@ func DisableServiceDestinationByNDID(t *testing.T, param did.DisableServiceDesti
 		fmt.Println("error:", err)
 	}
 	fnName := "DisableServiceDestinationByNDID"
-	nonce := base64.StdEncoding.EncodeToString([]byte(common.RandStr(12)))
+	nonce := newNonce()
 	tempPSSmessage := append([]byte(fnName), paramJSON...)
 	tempPSSmessage = append(tempPSSmessage, []byte(nonce)...)
 	PSSmessage := []byte(base64.StdEncoding.EncodeToString(tempPSSmessage))
@@ -620,7 +647,7 @@ func EnableServiceDestinationByNDID(t *testing.T, param did.DisableServiceDestin
 		fmt.Println("error:", err)
 	}
 	fnName := "EnableServiceDestinationByNDID"
-	nonce := base64.StdEncoding.EncodeToString([]byte(common.RandStr(12)))
+	nonce := newNonce()
 	tempPSSmessage := append([]byte(fnName), paramJSON...)
 	tempPSSmessage = append(tempPSSmessage, []byte(nonce)...)
 	PSSmessage := []byte(base64.StdEncoding.EncodeToString(tempPSSmessage))
@@ -649,7 +676,7 @@ func AddNodeToProxyNode(t *testing.T, param did.AddNodeToProxyNodeParam, expecte
 	ndidNodeID := []byte("NDID")
 
 	fnName := "AddNodeToProxyNode"
-	nonce := base64.StdEncoding.EncodeToString([]byte(common.RandStr(12)))
+	nonce := newNonce()
 	tempPSSmessage := append([]byte(fnName), paramJSON...)
 	tempPSSmessage = append(tempPSSmessage, []byte(nonce)...)
 	PSSmessage := []byte(base64.StdEncoding.EncodeToString(tempPSSmessage))
@@ -677,7 +704,7 @@ func UpdateNodeProxyNode(t *testing.T, param did.UpdateNodeProxyNodeParam, expec
 	ndidKey := getPrivateKeyFromString(ndidPrivK)
 	ndidNodeID := []byte("NDID")
 	fnName := "UpdateNodeProxyNode"
-	nonce := base64.StdEncoding.EncodeToString([]byte(common.RandStr(12)))
+	nonce := newNonce()
 	tempPSSmessage := append([]byte(fnName), paramJSON...)
 	tempPSSmessage = append(tempPSSmessage, []byte(nonce)...)
 	PSSmessage := []byte(base64.StdEncoding.EncodeToString(tempPSSmessage))
@@ -705,7 +732,7 @@ func RemoveNodeFromProxyNode(t *testing.T, param did.RemoveNodeFromProxyNode, ex
 	ndidKey := getPrivateKeyFromString(ndidPrivK)
 	ndidNodeID := []byte("NDID")
 	fnName := "RemoveNodeFromProxyNode"
-	nonce := base64.StdEncoding.EncodeToString([]byte(common.RandStr(12)))
+	nonce := newNonce()
 	tempPSSmessage := append([]byte(fnName), paramJSON...)
 	tempPSSmessage = append(tempPSSmessage, []byte(nonce)...)
 	PSSmessage := []byte(base64.StdEncoding.EncodeToString(tempPSSmessage))
@@ -732,7 +759,7 @@ func RegisterServiceDestinationByNDIDExpectedString(t *testing.T, param did.Regi
 		log.Fatal(err.Error())
 	}
 	fnName := "RegisterServiceDestinationByNDID"
-	nonce := base64.StdEncoding.EncodeToString([]byte(common.RandStr(12)))
+	nonce := newNonce()
 	tempPSSmessage := append([]byte(fnName), paramJSON...)
 	tempPSSmessage = append(tempPSSmessage, []byte(nonce)...)
 	PSSmessage := []byte(base64.StdEncoding.EncodeToString(tempPSSmessage))
@@ -758,7 +785,7 @@ func EnableServiceDestinationByNDIDExpectedString(t *testing.T, param did.Disabl
 		fmt.Println("error:", err)
 	}
 	fnName := "EnableServiceDestinationByNDID"
-	nonce := base64.StdEncoding.EncodeToString([]byte(common.RandStr(12)))
+	nonce := newNonce()
 	tempPSSmessage := append([]byte(fnName), paramJSON...)
 	tempPSSmessage = append(tempPSSmessage, []byte(nonce)...)
 	PSSmessage := []byte(base64.StdEncoding.EncodeToString(tempPSSmessage))
@@ -784,7 +811,7 @@ func DisableServiceDestinationByNDIDExpectedString(t *testing.T, param did.Disab
 		fmt.Println("error:", err)
 	}
 	fnName := "DisableServiceDestinationByNDID"
-	nonce := base64.StdEncoding.EncodeToString([]byte(common.RandStr(12)))
+	nonce := newNonce()
 	tempPSSmessage := append([]byte(fnName), paramJSON...)
 	tempPSSmessage = append(tempPSSmessage, []byte(nonce)...)
 	PSSmessage := []byte(base64.StdEncoding.EncodeToString(tempPSSmessage))
@@ -810,7 +837,7 @@ func SetLastBlock(t *testing.T, param did.SetLastBlockParam) {
 	ndidKey := getPrivateKeyFromString(ndidPrivK)
 	ndidNodeID := []byte("NDID")
 	fnName := "SetLastBlock"
-	nonce := base64.StdEncoding.EncodeToString([]byte(common.RandStr(12)))
+	nonce := newNonce()
 	tempPSSmessage := append([]byte(fnName), paramJSON...)
 	tempPSSmessage = append(tempPSSmessage, []byte(nonce)...)
 	PSSmessage := []byte(base64.StdEncoding.EncodeToString(tempPSSmessage))
@@ -838,7 +865,7 @@ func EndInit(t *testing.T) {
 	}
 	fnName := "EndInit"
 	nodeID := "NDID"
-	nonce := base64.StdEncoding.EncodeToString([]byte(common.RandStr(12)))
+	nonce := newNonce()
 	tempPSSmessage := append([]byte(fnName), paramJSON...)
 	tempPSSmessage = append(tempPSSmessage, []byte(nonce)...)
 	PSSmessage := []byte(base64.StdEncoding.EncodeToString(tempPSSmessage))
@@ -859,3 +886,168 @@ func EndInit(t *testing.T) {
 	writeLog(fnName, (stopTime.UnixNano()-startTime.UnixNano())/int64(time.Millisecond))
 	t.Logf("PASS: %s", fnName)
 }
+
+func InitiateMasterKeyRecovery(t *testing.T, param did.InitiateMasterKeyRecoveryParam) {
+	ndidKey := getPrivateKeyFromString(ndidPrivK)
+	ndidNodeID := "NDID"
+	paramJSON, err := json.Marshal(param)
+	if err != nil {
+		fmt.Println("error:", err)
+	}
+	fnName := "InitiateMasterKeyRecovery"
+	nonce := newNonce()
+	tempPSSmessage := append([]byte(fnName), paramJSON...)
+	tempPSSmessage = append(tempPSSmessage, []byte(nonce)...)
+	PSSmessage := []byte(base64.StdEncoding.EncodeToString(tempPSSmessage))
+	newhash := crypto.SHA256
+	pssh := newhash.New()
+	pssh.Write(PSSmessage)
+	hashed := pssh.Sum(nil)
+
+	signature, err := rsa.SignPKCS1v15(rand.Reader, ndidKey, newhash, hashed)
+	result, _ := callTendermint([]byte(fnName), paramJSON, []byte(nonce), signature, []byte(ndidNodeID))
+	resultObj, _ := result.(ResponseTx)
+	expected := "success"
+	if actual := resultObj.Result.DeliverTx.Log; actual != expected {
+		t.Errorf("\n"+`CheckTx log: "%s"`, resultObj.Result.CheckTx.Log)
+		t.Fatalf("FAIL: %s\nExpected: %#v\nActual: %#v", fnName, expected, actual)
+	}
+	if actual := getTagValue(resultObj, "master_key_recovery.status"); actual != "pending" {
+		t.Fatalf("FAIL: %s\nExpected: %#v\nActual: %#v", fnName, "pending", actual)
+	}
+	t.Logf("PASS: %s", fnName)
+}
+
+func ScheduleUpgrade(t *testing.T, param did.ScheduleUpgradeParam, expected string) {
+	paramJSON, err := json.Marshal(param)
+	if err != nil {
+		fmt.Println("error:", err)
+	}
+	ndidKey := getPrivateKeyFromString(ndidPrivK)
+	ndidNodeID := []byte("NDID")
+	fnName := "ScheduleUpgrade"
+	nonce := newNonce()
+	tempPSSmessage := append([]byte(fnName), paramJSON...)
+	tempPSSmessage = append(tempPSSmessage, []byte(nonce)...)
+	PSSmessage := []byte(base64.StdEncoding.EncodeToString(tempPSSmessage))
+	newhash := crypto.SHA256
+	pssh := newhash.New()
+	pssh.Write(PSSmessage)
+	hashed := pssh.Sum(nil)
+	signature, err := rsa.SignPKCS1v15(rand.Reader, ndidKey, newhash, hashed)
+	result, _ := callTendermint([]byte(fnName), paramJSON, []byte(nonce), signature, ndidNodeID)
+	resultObj, _ := result.(ResponseTx)
+	if actual := resultObj.Result.DeliverTx.Log; actual != expected {
+		t.Errorf("\n"+`CheckTx log: "%s"`, resultObj.Result.CheckTx.Log)
+		t.Fatalf("FAIL: %s\nExpected: %#v\nActual: %#v", fnName, expected, actual)
+	}
+	t.Logf("PASS: %s", fnName)
+}
+
+func SetTokenTransferWhitelist(t *testing.T, param did.SetTokenTransferWhitelistParam) {
+	paramJSON, err := json.Marshal(param)
+	if err != nil {
+		fmt.Println("error:", err)
+	}
+	ndidKey := getPrivateKeyFromString(ndidPrivK)
+	ndidNodeID := []byte("NDID")
+	fnName := "SetTokenTransferWhitelist"
+	nonce := newNonce()
+	tempPSSmessage := append([]byte(fnName), paramJSON...)
+	tempPSSmessage = append(tempPSSmessage, []byte(nonce)...)
+	PSSmessage := []byte(base64.StdEncoding.EncodeToString(tempPSSmessage))
+	newhash := crypto.SHA256
+	pssh := newhash.New()
+	pssh.Write(PSSmessage)
+	hashed := pssh.Sum(nil)
+	signature, err := rsa.SignPKCS1v15(rand.Reader, ndidKey, newhash, hashed)
+	result, _ := callTendermint([]byte(fnName), paramJSON, []byte(nonce), signature, ndidNodeID)
+	resultObj, _ := result.(ResponseTx)
+	expected := "success"
+	if actual := resultObj.Result.DeliverTx.Log; actual != expected {
+		t.Errorf("\n"+`CheckTx log: "%s"`, resultObj.Result.CheckTx.Log)
+		t.Fatalf("FAIL: %s\nExpected: %#v\nActual: %#v", fnName, expected, actual)
+	}
+	t.Logf("PASS: %s", fnName)
+}
+
+func SetPriceRule(t *testing.T, param did.SetPriceRuleParam) {
+	paramJSON, err := json.Marshal(param)
+	if err != nil {
+		fmt.Println("error:", err)
+	}
+	ndidKey := getPrivateKeyFromString(ndidPrivK)
+	ndidNodeID := []byte("NDID")
+	fnName := "SetPriceRule"
+	nonce := newNonce()
+	tempPSSmessage := append([]byte(fnName), paramJSON...)
+	tempPSSmessage = append(tempPSSmessage, []byte(nonce)...)
+	PSSmessage := []byte(base64.StdEncoding.EncodeToString(tempPSSmessage))
+	newhash := crypto.SHA256
+	pssh := newhash.New()
+	pssh.Write(PSSmessage)
+	hashed := pssh.Sum(nil)
+	signature, err := rsa.SignPKCS1v15(rand.Reader, ndidKey, newhash, hashed)
+	result, _ := callTendermint([]byte(fnName), paramJSON, []byte(nonce), signature, ndidNodeID)
+	resultObj, _ := result.(ResponseTx)
+	expected := "success"
+	if actual := resultObj.Result.DeliverTx.Log; actual != expected {
+		t.Errorf("\n"+`CheckTx log: "%s"`, resultObj.Result.CheckTx.Log)
+		t.Fatalf("FAIL: %s\nExpected: %#v\nActual: %#v", fnName, expected, actual)
+	}
+	t.Logf("PASS: %s", fnName)
+}
+
+func SetRevenueShareRule(t *testing.T, param did.SetRevenueShareRuleParam) {
+	paramJSON, err := json.Marshal(param)
+	if err != nil {
+		fmt.Println("error:", err)
+	}
+	ndidKey := getPrivateKeyFromString(ndidPrivK)
+	ndidNodeID := []byte("NDID")
+	fnName := "SetRevenueShareRule"
+	nonce := newNonce()
+	tempPSSmessage := append([]byte(fnName), paramJSON...)
+	tempPSSmessage = append(tempPSSmessage, []byte(nonce)...)
+	PSSmessage := []byte(base64.StdEncoding.EncodeToString(tempPSSmessage))
+	newhash := crypto.SHA256
+	pssh := newhash.New()
+	pssh.Write(PSSmessage)
+	hashed := pssh.Sum(nil)
+	signature, err := rsa.SignPKCS1v15(rand.Reader, ndidKey, newhash, hashed)
+	result, _ := callTendermint([]byte(fnName), paramJSON, []byte(nonce), signature, ndidNodeID)
+	resultObj, _ := result.(ResponseTx)
+	expected := "success"
+	if actual := resultObj.Result.DeliverTx.Log; actual != expected {
+		t.Errorf("\n"+`CheckTx log: "%s"`, resultObj.Result.CheckTx.Log)
+		t.Fatalf("FAIL: %s\nExpected: %#v\nActual: %#v", fnName, expected, actual)
+	}
+	t.Logf("PASS: %s", fnName)
+}
+
+func SetNodeTokenLimit(t *testing.T, param did.SetNodeTokenLimitParam) {
+	paramJSON, err := json.Marshal(param)
+	if err != nil {
+		fmt.Println("error:", err)
+	}
+	ndidKey := getPrivateKeyFromString(ndidPrivK)
+	ndidNodeID := []byte("NDID")
+	fnName := "SetNodeTokenLimit"
+	nonce := newNonce()
+	tempPSSmessage := append([]byte(fnName), paramJSON...)
+	tempPSSmessage = append(tempPSSmessage, []byte(nonce)...)
+	PSSmessage := []byte(base64.StdEncoding.EncodeToString(tempPSSmessage))
+	newhash := crypto.SHA256
+	pssh := newhash.New()
+	pssh.Write(PSSmessage)
+	hashed := pssh.Sum(nil)
+	signature, err := rsa.SignPKCS1v15(rand.Reader, ndidKey, newhash, hashed)
+	result, _ := callTendermint([]byte(fnName), paramJSON, []byte(nonce), signature, ndidNodeID)
+	resultObj, _ := result.(ResponseTx)
+	expected := "success"
+	if actual := resultObj.Result.DeliverTx.Log; actual != expected {
+		t.Errorf("\n"+`CheckTx log: "%s"`, resultObj.Result.CheckTx.Log)
+		t.Fatalf("FAIL: %s\nExpected: %#v\nActual: %#v", fnName, expected, actual)
+	}
+	t.Logf("PASS: %s", fnName)
+}
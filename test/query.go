@@ -28,10 +28,14 @@ import (
 	"fmt"
 	"log"
 	"reflect"
+	"strconv"
 	"strings"
 	"testing"
 
+	"github.com/gogo/protobuf/proto"
 	did "github.com/ndidplatform/smart-contract/abci/did/v1"
+	"github.com/ndidplatform/smart-contract/abci/proof"
+	"github.com/ndidplatform/smart-contract/protos/data"
 )
 
 func GetNodePublicKey(t *testing.T, param did.GetNodePublicKeyParam, expected string) {
@@ -58,6 +62,94 @@ func GetNodePublicKey(t *testing.T, param did.GetNodePublicKeyParam, expected st
 	t.Logf("PASS: %s", fnName)
 }
 
+func GetNodePublicKeyWithProof(t *testing.T, param did.GetNodePublicKeyParam, expected string) {
+	fnName := "GetNodePublicKey"
+	paramJSON, err := json.Marshal(param)
+	if err != nil {
+		fmt.Println("error:", err)
+	}
+	result, _ := queryTendermintWithProof([]byte(fnName), paramJSON)
+	resultObj, _ := result.(ResponseQuery)
+	info := getABCIInfo()
+	if resultObj.Result.Response.Height != info.Result.Response.LastBlockHeight {
+		t.Fatalf("FAIL: %s\nQueried height: %s\nLast block height: %s", fnName, resultObj.Result.Response.Height, info.Result.Response.LastBlockHeight)
+	}
+	appHash, _ := base64.StdEncoding.DecodeString(info.Result.Response.LastBlockAppHash)
+	proofBytes, _ := base64.StdEncoding.DecodeString(resultObj.Result.Response.Proof)
+	proofs, err := proof.Verify(proofBytes, appHash)
+	if err != nil {
+		t.Fatalf("FAIL: %s\n%s", fnName, err.Error())
+	}
+	value, found := proof.Lookup(proofs, "NodeID|"+param.NodeID)
+	if !found || value == nil {
+		t.Fatalf("FAIL: %s\nNo existence proof of node ID: %s", fnName, param.NodeID)
+	}
+	var nodeDetail data.NodeDetail
+	err = proto.Unmarshal(value, &nodeDetail)
+	if err != nil {
+		log.Fatal(err.Error())
+	}
+	if actual := nodeDetail.PublicKey; actual != expected {
+		t.Fatalf("FAIL: %s\nExpected: %#v\nActual: %#v", fnName, expected, actual)
+	}
+	t.Logf("PASS: %s", fnName)
+}
+
+// GetNodeIDListWithProof checks that every node ID returned is proven to be
+// in list, the state key of the node list read by the query. The list is
+// proven as one blob before app version 2 and by the range of its order keys
+// from app version 2 on.
+func GetNodeIDListWithProof(t *testing.T, param did.GetNodeIDListParam, list string) {
+	fnName := "GetNodeIDList"
+	paramJSON, err := json.Marshal(param)
+	if err != nil {
+		fmt.Println("error:", err)
+	}
+	result, _ := queryTendermintWithProof([]byte(fnName), paramJSON)
+	resultObj, _ := result.(ResponseQuery)
+	resultString, _ := base64.StdEncoding.DecodeString(resultObj.Result.Response.Value)
+	var res did.GetNodeIDListResult
+	err = json.Unmarshal(resultString, &res)
+	if err != nil {
+		log.Fatal(err.Error())
+	}
+	if len(res.NodeIDList) == 0 {
+		t.Fatalf("FAIL: %s\nNo node ID returned", fnName)
+	}
+	info := getABCIInfo()
+	appHash, _ := base64.StdEncoding.DecodeString(info.Result.Response.LastBlockAppHash)
+	proofBytes, _ := base64.StdEncoding.DecodeString(resultObj.Result.Response.Proof)
+	proofs, err := proof.Verify(proofBytes, appHash)
+	if err != nil {
+		t.Fatalf("FAIL: %s\n%s", fnName, err.Error())
+	}
+	proven := make(map[string]bool)
+	if blob, found := proof.Lookup(proofs, list); found && blob != nil {
+		var nodes data.AllList
+		err = proto.Unmarshal(blob, &nodes)
+		if err != nil {
+			t.Fatalf("FAIL: %s\n%s", fnName, err.Error())
+		}
+		for _, nodeID := range nodes.NodeId {
+			proven[nodeID] = true
+		}
+	} else {
+		_, values, found := proof.LookupRange(proofs, list+"#", list+"$")
+		if !found {
+			t.Fatalf("FAIL: %s\nNo proof of list: %s", fnName, list)
+		}
+		for _, value := range values {
+			proven[string(value)] = true
+		}
+	}
+	for _, nodeID := range res.NodeIDList {
+		if !proven[nodeID] {
+			t.Fatalf("FAIL: %s\nNode ID is not proven: %s", fnName, nodeID)
+		}
+	}
+	t.Logf("PASS: %s", fnName)
+}
+
 func GetNodeMasterPublicKey(t *testing.T, param did.GetNodePublicKeyParam, expected string) {
 	fnName := "GetNodeMasterPublicKey"
 	paramJSON, err := json.Marshal(param)
@@ -102,6 +194,43 @@ func GetNodeToken(t *testing.T, param did.GetNodeTokenParam, expected did.GetNod
 	t.Logf("PASS: %s", fnName)
 }
 
+func GetNodeTokenAtHeight(t *testing.T, param did.GetNodeTokenParam, height int64, expected did.GetNodeTokenResult) {
+	fnName := "GetNodeToken"
+	paramJSON, err := json.Marshal(param)
+	if err != nil {
+		fmt.Println("error:", err)
+	}
+	result, _ := queryTendermintAtHeight([]byte(fnName), paramJSON, height)
+	resultObj, _ := result.(ResponseQuery)
+	if actual := resultObj.Result.Response.Height; actual != strconv.FormatInt(height, 10) {
+		t.Fatalf("FAIL: %s\nExpected height: %d\nActual height: %s", fnName, height, actual)
+	}
+	resultString, _ := base64.StdEncoding.DecodeString(resultObj.Result.Response.Value)
+	var res did.GetNodeTokenResult
+	err = json.Unmarshal(resultString, &res)
+	if err != nil {
+		log.Fatal(err.Error())
+	}
+	if actual := res; !reflect.DeepEqual(actual, expected) {
+		t.Fatalf("FAIL: %s\nExpected: %#v\nActual: %#v", fnName, expected, actual)
+	}
+	t.Logf("PASS: %s", fnName)
+}
+
+func GetNodeTokenAtHeightExpectLog(t *testing.T, param did.GetNodeTokenParam, height int64, expected string) {
+	fnName := "GetNodeToken"
+	paramJSON, err := json.Marshal(param)
+	if err != nil {
+		fmt.Println("error:", err)
+	}
+	result, _ := queryTendermintAtHeight([]byte(fnName), paramJSON, height)
+	resultObj, _ := result.(ResponseQuery)
+	if actual := resultObj.Result.Response.Log; actual != expected {
+		t.Fatalf("FAIL: %s\nExpected: %#v\nActual: %#v", fnName, expected, actual)
+	}
+	t.Logf("PASS: %s", fnName)
+}
+
 func GetNodeTokenExpectString(t *testing.T, param did.GetNodeTokenParam, expected string) {
 	fnName := "GetNodeToken"
 	paramJSON, err := json.Marshal(param)
@@ -182,6 +311,25 @@ func GetIdpNodesExpectString(t *testing.T, param did.GetIdpNodesParam, expected
 	t.Logf("PASS: %s", fnName)
 }
 
+func GetIdpNodesAtHeightExpectString(t *testing.T, param did.GetIdpNodesParam, height int64, expected string) {
+	fnName := "GetIdpNodes"
+	paramJSON, err := json.Marshal(param)
+	if err != nil {
+		fmt.Println("error:", err)
+	}
+	result, _ := queryTendermintAtHeight([]byte(fnName), paramJSON, height)
+	resultObj, _ := result.(ResponseQuery)
+	resultString, _ := base64.StdEncoding.DecodeString(resultObj.Result.Response.Value)
+	if resultObj.Result.Response.Log == expected {
+		t.Logf("PASS: %s", fnName)
+		return
+	}
+	if actual := string(resultString); actual != expected {
+		t.Fatalf("FAIL: %s\nExpected: %s\nActual: %s", fnName, expected, actual)
+	}
+	t.Logf("PASS: %s", fnName)
+}
+
 func GetMqAddresses(t *testing.T, param did.GetMqAddressesParam, expected []did.MsqAddress) {
 	fnName := "GetMqAddresses"
 	paramJSON, err := json.Marshal(param)
@@ -369,6 +517,107 @@ func GetPriceFunc(t *testing.T, param did.GetPriceFuncParam, expected did.GetPri
 	t.Logf("PASS: %s", fnName)
 }
 
+func GetPriceRule(t *testing.T, param did.GetPriceFuncParam, expected did.GetPriceRuleResult) {
+	fnName := "GetPriceRule"
+	paramJSON, err := json.Marshal(param)
+	if err != nil {
+		fmt.Println("error:", err)
+	}
+	result, _ := queryTendermint([]byte(fnName), paramJSON)
+	resultObj, _ := result.(ResponseQuery)
+	resultString, _ := base64.StdEncoding.DecodeString(resultObj.Result.Response.Value)
+
+	var res did.GetPriceRuleResult
+	err = json.Unmarshal(resultString, &res)
+	if err != nil {
+		log.Fatal(err.Error())
+	}
+	if actual := res; !reflect.DeepEqual(actual, expected) {
+		t.Fatalf("FAIL: %s\nExpected: %#v\nActual: %#v", fnName, expected, actual)
+	}
+	t.Logf("PASS: %s", fnName)
+}
+
+func GetTxCost(t *testing.T, param did.GetTxCostParam, expected did.GetTxCostResult) {
+	fnName := "GetTxCost"
+	paramJSON, err := json.Marshal(param)
+	if err != nil {
+		fmt.Println("error:", err)
+	}
+	result, _ := queryTendermint([]byte(fnName), paramJSON)
+	resultObj, _ := result.(ResponseQuery)
+	resultString, _ := base64.StdEncoding.DecodeString(resultObj.Result.Response.Value)
+
+	var res did.GetTxCostResult
+	err = json.Unmarshal(resultString, &res)
+	if err != nil {
+		t.Fatalf("FAIL: %s\nLog: %s", fnName, resultObj.Result.Response.Log)
+	}
+	if actual := res; !reflect.DeepEqual(actual, expected) {
+		t.Fatalf("FAIL: %s\nExpected: %#v\nActual: %#v", fnName, expected, actual)
+	}
+	t.Logf("PASS: %s", fnName)
+}
+
+func GetNodeTokenLimit(t *testing.T, param did.GetNodeTokenParam, expected did.GetNodeTokenLimitResult) {
+	fnName := "GetNodeTokenLimit"
+	paramJSON, err := json.Marshal(param)
+	if err != nil {
+		fmt.Println("error:", err)
+	}
+	result, _ := queryTendermint([]byte(fnName), paramJSON)
+	resultObj, _ := result.(ResponseQuery)
+	resultString, _ := base64.StdEncoding.DecodeString(resultObj.Result.Response.Value)
+
+	var res did.GetNodeTokenLimitResult
+	err = json.Unmarshal(resultString, &res)
+	if err != nil {
+		t.Fatalf("FAIL: %s\nLog: %s", fnName, resultObj.Result.Response.Log)
+	}
+	if actual := res; !reflect.DeepEqual(actual, expected) {
+		t.Fatalf("FAIL: %s\nExpected: %#v\nActual: %#v", fnName, expected, actual)
+	}
+	t.Logf("PASS: %s", fnName)
+}
+
+func GetRevenueShareRule(t *testing.T, expected did.GetRevenueShareRuleResult) {
+	fnName := "GetRevenueShareRule"
+	result, _ := queryTendermint([]byte(fnName), []byte("{}"))
+	resultObj, _ := result.(ResponseQuery)
+	resultString, _ := base64.StdEncoding.DecodeString(resultObj.Result.Response.Value)
+
+	var res did.GetRevenueShareRuleResult
+	err := json.Unmarshal(resultString, &res)
+	if err != nil {
+		log.Fatal(err.Error())
+	}
+	if actual := res; !reflect.DeepEqual(actual, expected) {
+		t.Fatalf("FAIL: %s\nExpected: %#v\nActual: %#v", fnName, expected, actual)
+	}
+	t.Logf("PASS: %s", fnName)
+}
+
+func GetEarnings(t *testing.T, param did.GetEarningsParam, expected did.GetEarningsResult) {
+	fnName := "GetEarnings"
+	paramJSON, err := json.Marshal(param)
+	if err != nil {
+		fmt.Println("error:", err)
+	}
+	result, _ := queryTendermint([]byte(fnName), paramJSON)
+	resultObj, _ := result.(ResponseQuery)
+	resultString, _ := base64.StdEncoding.DecodeString(resultObj.Result.Response.Value)
+
+	var res did.GetEarningsResult
+	err = json.Unmarshal(resultString, &res)
+	if err != nil {
+		log.Fatal(err.Error())
+	}
+	if actual := res; !reflect.DeepEqual(actual, expected) {
+		t.Fatalf("FAIL: %s\nExpected: %#v\nActual: %#v", fnName, expected, actual)
+	}
+	t.Logf("PASS: %s", fnName)
+}
+
 // func GetUsedTokenReport(t *testing.T, param did.GetUsedTokenReportParam, expectedString string) {
 // 	fnName := "GetUsedTokenReport"
 // 	paramJSON, err := json.Marshal(param)
@@ -431,13 +680,18 @@ func GetRequestDetail(t *testing.T, param did.GetRequestParam, expected string)
 		t.Logf("PASS: %s", fnName)
 		return
 	}
-	oldBlockNumber := after(string(expected), `"creation_block_height":`)
-	oldBlockNumber = before(string(oldBlockNumber), `,"creation_chain_id":`)
-	newBlockNumber := after(string(resultString), `"creation_block_height":`)
-	newBlockNumber = before(string(newBlockNumber), `,"creation_chain_id":`)
-	oldBlockNumber = ":" + oldBlockNumber
-	newBlockNumber = ":" + newBlockNumber
-	expected = strings.Replace(expected, oldBlockNumber, newBlockNumber, -1)
+	for _, field := range [][2]string{
+		{`"creation_block_height":`, `,"creation_chain_id":`},
+		{`"expire_block_height":`, `}`},
+	} {
+		oldBlockNumber := after(string(expected), field[0])
+		oldBlockNumber = before(string(oldBlockNumber), field[1])
+		newBlockNumber := after(string(resultString), field[0])
+		newBlockNumber = before(string(newBlockNumber), field[1])
+		oldBlockNumber = ":" + oldBlockNumber
+		newBlockNumber = ":" + newBlockNumber
+		expected = strings.Replace(expected, oldBlockNumber, newBlockNumber, -1)
+	}
 	if actual := string(resultString); actual != expected {
 		t.Fatalf("FAIL: %s\nExpected: %s\nActual: %s", fnName, expected, actual)
 	}
@@ -494,12 +748,14 @@ func GetNamespaceList(t *testing.T, expected []did.Namespace) {
 	result, _ := queryTendermint([]byte(fnName), paramJSON)
 	resultObj, _ := result.(ResponseQuery)
 	resultString, _ := base64.StdEncoding.DecodeString(resultObj.Result.Response.Value)
-	var res []did.Namespace
+	var res struct {
+		NamespaceList []did.Namespace `json:"namespace_list"`
+	}
 	err := json.Unmarshal(resultString, &res)
 	if err != nil {
 		log.Fatal(err.Error())
 	}
-	if actual := res; !reflect.DeepEqual(actual, expected) {
+	if actual := res.NamespaceList; !reflect.DeepEqual(actual, expected) {
 		t.Fatalf("FAIL: %s\nExpected: %#v\nActual: %#v", fnName, expected, actual)
 	}
 	t.Logf("PASS: %s", fnName)
@@ -511,12 +767,14 @@ func GetNamespaceListForDisable(t *testing.T) (expected []did.Namespace) {
 	result, _ := queryTendermint([]byte(fnName), paramJSON)
 	resultObj, _ := result.(ResponseQuery)
 	resultString, _ := base64.StdEncoding.DecodeString(resultObj.Result.Response.Value)
-	var res []did.Namespace
+	var res struct {
+		NamespaceList []did.Namespace `json:"namespace_list"`
+	}
 	err := json.Unmarshal(resultString, &res)
 	if err != nil {
 		log.Fatal(err.Error())
 	}
-	return res
+	return res.NamespaceList
 }
 
 func GetNamespaceListExpectString(t *testing.T, expected string) {
@@ -602,12 +860,14 @@ func GetServiceListForDisable(t *testing.T) (expected []did.ServiceDetail) {
 	result, _ := queryTendermint([]byte(fnName), paramJSON)
 	resultObj, _ := result.(ResponseQuery)
 	resultString, _ := base64.StdEncoding.DecodeString(resultObj.Result.Response.Value)
-	var res []did.ServiceDetail
+	var res struct {
+		ServiceList []did.ServiceDetail `json:"service_list"`
+	}
 	err := json.Unmarshal(resultString, &res)
 	if err != nil {
 		log.Fatal(err.Error())
 	}
-	return res
+	return res.ServiceList
 }
 
 func GetNodeInfo(t *testing.T, param did.GetNodeInfoParam, expected string) {
@@ -879,3 +1139,164 @@ func IsInitEnded(t *testing.T, expected bool) {
 	}
 	t.Logf("PASS: %s", fnName)
 }
+
+func GetProposal(t *testing.T, param did.GetProposalParam, expectedStatus string) {
+	fnName := "GetProposal"
+	paramJSON, err := json.Marshal(param)
+	if err != nil {
+		fmt.Println("error:", err)
+	}
+	result, _ := queryTendermint([]byte(fnName), paramJSON)
+	resultObj, _ := result.(ResponseQuery)
+	resultString, _ := base64.StdEncoding.DecodeString(resultObj.Result.Response.Value)
+	var res did.GetProposalResult
+	err = json.Unmarshal(resultString, &res)
+	if err != nil {
+		log.Fatal(err.Error())
+	}
+	if actual := res.Status; actual != expectedStatus {
+		t.Fatalf("FAIL: %s\nExpected: %#v\nActual: %#v", fnName, expectedStatus, actual)
+	}
+	t.Logf("PASS: %s", fnName)
+}
+
+func GetNodePublicKeyHistory(t *testing.T, param did.GetNodePublicKeyHistoryParam, expectedPublicKeys []string) {
+	fnName := "GetNodePublicKeyHistory"
+	paramJSON, err := json.Marshal(param)
+	if err != nil {
+		fmt.Println("error:", err)
+	}
+	result, _ := queryTendermint([]byte(fnName), paramJSON)
+	resultObj, _ := result.(ResponseQuery)
+	resultString, _ := base64.StdEncoding.DecodeString(resultObj.Result.Response.Value)
+	var res did.GetNodePublicKeyHistoryResult
+	err = json.Unmarshal(resultString, &res)
+	if err != nil {
+		log.Fatal(err.Error())
+	}
+	actualPublicKeys := make([]string, 0)
+	for index, key := range res.History {
+		actualPublicKeys = append(actualPublicKeys, key.PublicKey)
+		// Each key must be revoked at the block the next key is activated
+		if index < len(res.History)-1 && key.RevocationBlock != res.History[index+1].ActivationBlock {
+			t.Fatalf("FAIL: %s\nKey %d revocation block %d does not match next activation block %d", fnName, index, key.RevocationBlock, res.History[index+1].ActivationBlock)
+		}
+	}
+	if len(res.History) > 0 && res.History[len(res.History)-1].RevocationBlock != 0 {
+		t.Fatalf("FAIL: %s\nLatest key must not be revoked", fnName)
+	}
+	if actual := actualPublicKeys; !reflect.DeepEqual(actual, expectedPublicKeys) {
+		t.Fatalf("FAIL: %s\nExpected: %#v\nActual: %#v", fnName, expectedPublicKeys, actual)
+	}
+	t.Logf("PASS: %s", fnName)
+}
+
+func GetMasterKeyRecovery(t *testing.T, param did.GetMasterKeyRecoveryParam, expectedStatus string) {
+	fnName := "GetMasterKeyRecovery"
+	paramJSON, err := json.Marshal(param)
+	if err != nil {
+		fmt.Println("error:", err)
+	}
+	result, _ := queryTendermint([]byte(fnName), paramJSON)
+	resultObj, _ := result.(ResponseQuery)
+	resultString, _ := base64.StdEncoding.DecodeString(resultObj.Result.Response.Value)
+	var res did.GetMasterKeyRecoveryResult
+	err = json.Unmarshal(resultString, &res)
+	if err != nil {
+		log.Fatal(err.Error())
+	}
+	if actual := res.Status; actual != expectedStatus {
+		t.Fatalf("FAIL: %s\nExpected: %#v\nActual: %#v", fnName, expectedStatus, actual)
+	}
+	t.Logf("PASS: %s", fnName)
+}
+
+func GetABCIInfoAppVersion(t *testing.T, expected string) {
+	fnName := "ABCIInfo"
+	info := getABCIInfo()
+	var data struct {
+		AppVersion string `json:"app_version"`
+	}
+	err := json.Unmarshal([]byte(info.Result.Response.Data), &data)
+	if err != nil {
+		t.Fatalf("FAIL: %s\n%s", fnName, err.Error())
+	}
+	if actual := data.AppVersion; actual != expected {
+		t.Fatalf("FAIL: %s\nExpected: %#v\nActual: %#v", fnName, expected, actual)
+	}
+	t.Logf("PASS: %s", fnName)
+}
+
+func GetUpgradeSchedule(t *testing.T, expected []did.ScheduleUpgradeParam) {
+	fnName := "GetUpgradeSchedule"
+	result, _ := queryTendermint([]byte(fnName), []byte("{}"))
+	resultObj, _ := result.(ResponseQuery)
+	resultString, _ := base64.StdEncoding.DecodeString(resultObj.Result.Response.Value)
+	var res did.GetUpgradeScheduleResult
+	err := json.Unmarshal(resultString, &res)
+	if err != nil {
+		log.Fatal(err.Error())
+	}
+	actual := make([]did.ScheduleUpgradeParam, 0)
+	for _, upgrade := range res.Upgrades {
+		actual = append(actual, did.ScheduleUpgradeParam{Version: upgrade.Version, Height: upgrade.Height})
+	}
+	if !reflect.DeepEqual(actual, expected) {
+		t.Fatalf("FAIL: %s\nExpected: %#v\nActual: %#v", fnName, expected, actual)
+	}
+	t.Logf("PASS: %s", fnName)
+}
+
+func GetTokenTransferWhitelist(t *testing.T, param did.GetTokenTransferWhitelistParam, expected did.GetTokenTransferWhitelistResult) {
+	fnName := "GetTokenTransferWhitelist"
+	paramJSON, err := json.Marshal(param)
+	if err != nil {
+		fmt.Println("error:", err)
+	}
+	result, _ := queryTendermint([]byte(fnName), paramJSON)
+	resultObj, _ := result.(ResponseQuery)
+	resultString, _ := base64.StdEncoding.DecodeString(resultObj.Result.Response.Value)
+	var res did.GetTokenTransferWhitelistResult
+	err = json.Unmarshal(resultString, &res)
+	if err != nil {
+		log.Fatal(err.Error())
+	}
+	if actual := res; !reflect.DeepEqual(actual, expected) {
+		t.Fatalf("FAIL: %s\nExpected: %#v\nActual: %#v", fnName, expected, actual)
+	}
+	t.Logf("PASS: %s", fnName)
+}
+
+// GetTokenLedger reads every page of the ledger from param.Cursor and
+// compares method, amount and request ID of the entries
+func GetTokenLedger(t *testing.T, param did.GetTokenLedgerParam, expected []did.TokenLedgerEntry) {
+	fnName := "GetTokenLedger"
+	actual := make([]did.TokenLedgerEntry, 0)
+	for {
+		paramJSON, err := json.Marshal(param)
+		if err != nil {
+			fmt.Println("error:", err)
+		}
+		result, _ := queryTendermint([]byte(fnName), paramJSON)
+		resultObj, _ := result.(ResponseQuery)
+		resultString, _ := base64.StdEncoding.DecodeString(resultObj.Result.Response.Value)
+		var res did.GetTokenLedgerResult
+		err = json.Unmarshal(resultString, &res)
+		if err != nil {
+			log.Fatal(err.Error())
+		}
+		for _, entry := range res.Entries {
+			entry.Height = 0
+			entry.TxIndex = 0
+			actual = append(actual, entry)
+		}
+		if res.NextCursor == "" {
+			break
+		}
+		param.Cursor = res.NextCursor
+	}
+	if !reflect.DeepEqual(actual, expected) {
+		t.Fatalf("FAIL: %s\nExpected: %#v\nActual: %#v", fnName, expected, actual)
+	}
+	t.Logf("PASS: %s", fnName)
+}
@@ -32,7 +32,6 @@ import (
 	"testing"
 
 	did "github.com/ndidplatform/smart-contract/abci/did/v1"
-	"github.com/tendermint/tendermint/libs/common"
 )
 
 func SetDataReceived(t *testing.T, param did.SetDataReceivedParam, expected string, nodeID string) {
@@ -42,7 +41,7 @@ func SetDataReceived(t *testing.T, param did.SetDataReceivedParam, expected stri
 	}
 	rpKey := getPrivateKeyFromString(rpPrivK)
 	rpNodeID := []byte(nodeID)
-	nonce := base64.StdEncoding.EncodeToString([]byte(common.RandStr(12)))
+	nonce := newNonce()
 	fnName := "SetDataReceived"
 	tempPSSmessage := append([]byte(fnName), paramJSON...)
 	tempPSSmessage = append(tempPSSmessage, []byte(nonce)...)
@@ -69,7 +68,7 @@ func CloseRequest(t *testing.T, param did.CloseRequestParam, nodeID string) {
 	}
 	rpKey := getPrivateKeyFromString(rpPrivK)
 	rpNodeID := []byte(nodeID)
-	nonce := base64.StdEncoding.EncodeToString([]byte(common.RandStr(12)))
+	nonce := newNonce()
 	fnName := "CloseRequest"
 	tempPSSmessage := append([]byte(fnName), paramJSON...)
 	tempPSSmessage = append(tempPSSmessage, []byte(nonce)...)
@@ -97,7 +96,7 @@ func TimeOutRequest(t *testing.T, param did.TimeOutRequestParam, nodeID string)
 	}
 	rpKey := getPrivateKeyFromString(rpPrivK)
 	rpNodeID := []byte(nodeID)
-	nonce := base64.StdEncoding.EncodeToString([]byte(common.RandStr(12)))
+	nonce := newNonce()
 	fnName := "TimeOutRequest"
 	tempPSSmessage := append([]byte(fnName), paramJSON...)
 	tempPSSmessage = append(tempPSSmessage, []byte(nonce)...)
@@ -125,7 +124,7 @@ func CloseRequestByIdP(t *testing.T, param did.CloseRequestParam, nodeID string)
 	}
 	rpKey := getPrivateKeyFromString(idpPrivK)
 	rpNodeID := []byte(nodeID)
-	nonce := base64.StdEncoding.EncodeToString([]byte(common.RandStr(12)))
+	nonce := newNonce()
 	fnName := "CloseRequest"
 	tempPSSmessage := append([]byte(fnName), paramJSON...)
 	tempPSSmessage = append(tempPSSmessage, []byte(nonce)...)
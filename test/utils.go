@@ -25,6 +25,7 @@ package test
 import (
 	"crypto/rsa"
 	"crypto/x509"
+	"encoding/base64"
 	"encoding/hex"
 	"encoding/json"
 	"encoding/pem"
@@ -39,11 +40,23 @@ import (
 	"time"
 
 	"github.com/gogo/protobuf/proto"
+	"github.com/ndidplatform/smart-contract/abci/did/didtest"
 	protoTm "github.com/ndidplatform/smart-contract/protos/tendermint"
+	"github.com/tendermint/tendermint/libs/common"
 )
 
 var tendermintAddr = getEnv("TENDERMINT_ADDRESS", "http://localhost:45000")
 
+// harness runs the app in process instead of calling tendermintAddr
+// when ABCI_IN_PROCESS is set to true
+var harness *didtest.Harness
+
+func init() {
+	if getEnv("ABCI_IN_PROCESS", "false") == "true" {
+		harness = didtest.New()
+	}
+}
+
 func getEnv(key, defaultValue string) string {
 	value, exists := os.LookupEnv(key)
 	if !exists {
@@ -62,7 +75,7 @@ func getPrivateKeyFromString(privK string) *rsa.PrivateKey {
 	return privateKey
 }
 
-func generatePublicKey(publicKey *rsa.PublicKey) ([]byte, error) {
+func generatePublicKey(publicKey interface{}) ([]byte, error) {
 	pubKeyBytes, err := x509.MarshalPKIXPublicKey(publicKey)
 	if err != nil {
 		return nil, err
@@ -84,12 +97,31 @@ func callTendermint(fnName []byte, param []byte, nonce []byte, signature []byte,
 	tx.Nonce = nonce
 	tx.Signature = signature
 	tx.NodeId = string(nodeID)
+	return broadcastTx(tx)
+}
+
+func callTendermintProtobuf(fnName []byte, encodedParams []byte, nonce []byte, signature []byte, nodeID []byte) (interface{}, error) {
+
+	var tx protoTm.Tx
+	tx.Method = string(fnName)
+	tx.Encoding = protoTm.Encoding_PROTOBUF
+	tx.EncodedParams = encodedParams
+	tx.Nonce = nonce
+	tx.Signature = signature
+	tx.NodeId = string(nodeID)
+	return broadcastTx(tx)
+}
 
+func broadcastTx(tx protoTm.Tx) (interface{}, error) {
 	txByte, err := proto.Marshal(&tx)
 	if err != nil {
 		log.Printf("err: %s", err.Error())
 	}
 
+	if harness != nil {
+		return broadcastTxInProcess(txByte), nil
+	}
+
 	txEncoded := hex.EncodeToString(txByte)
 
 	var URL *url.URL
@@ -127,7 +159,28 @@ func callTendermint(fnName []byte, param []byte, nonce []byte, signature []byte,
 	return body, nil
 }
 
+func getTagValue(resultObj ResponseTx, key string) string {
+	for _, tag := range resultObj.Result.DeliverTx.Tags {
+		if string(tag.Key) == key {
+			return string(tag.Value)
+		}
+	}
+	return ""
+}
+
 func queryTendermint(fnName []byte, param []byte) (interface{}, error) {
+	return abciQuery(fnName, param, 0, false)
+}
+
+func queryTendermintWithProof(fnName []byte, param []byte) (interface{}, error) {
+	return abciQuery(fnName, param, 0, true)
+}
+
+func queryTendermintAtHeight(fnName []byte, param []byte, height int64) (interface{}, error) {
+	return abciQuery(fnName, param, height, false)
+}
+
+func abciQuery(fnName []byte, param []byte, height int64, prove bool) (interface{}, error) {
 	// var path string
 	// path += string(fnName)
 	// path += "|"
@@ -142,6 +195,10 @@ func queryTendermint(fnName []byte, param []byte) (interface{}, error) {
 		log.Printf("err: %s", err.Error())
 	}
 
+	if harness != nil {
+		return abciQueryInProcess(data.Method, []byte(data.Params), height, prove), nil
+	}
+
 	dataEncoded := hex.EncodeToString(dataByte)
 
 	var URL *url.URL
@@ -152,6 +209,12 @@ func queryTendermint(fnName []byte, param []byte) (interface{}, error) {
 	URL.Path += "/abci_query"
 	parameters := url.Values{}
 	parameters.Add("data", `0x`+dataEncoded)
+	if height > 0 {
+		parameters.Add("height", strconv.FormatInt(height, 10))
+	}
+	if prove {
+		parameters.Add("prove", "true")
+	}
 	URL.RawQuery = parameters.Encode()
 	encodedURL := URL.String()
 	req, err := http.NewRequest("GET", encodedURL, nil)
@@ -179,7 +242,42 @@ func queryTendermint(fnName []byte, param []byte) (interface{}, error) {
 	return body, nil
 }
 
+func broadcastTxInProcess(txByte []byte) ResponseTx {
+	checkTx, deliverTx, _ := harness.BroadcastTxCommit(txByte)
+	var body ResponseTx
+	body.Result.CheckTx.Code = int(checkTx.Code)
+	body.Result.CheckTx.Log = checkTx.Log
+	if checkTx.Code == 0 {
+		body.Result.Height = int(harness.Height())
+		body.Result.DeliverTx.Log = deliverTx.Log
+		body.Result.DeliverTx.Tags = deliverTx.Tags
+	}
+	return body
+}
+
+func abciQueryInProcess(method string, params []byte, height int64, prove bool) ResponseQuery {
+	res := harness.Query(method, params, height, prove)
+	var body ResponseQuery
+	body.Result.Response.Log = res.Log
+	body.Result.Response.Value = base64.StdEncoding.EncodeToString(res.Value)
+	body.Result.Response.Proof = base64.StdEncoding.EncodeToString(res.Proof)
+	body.Result.Response.Height = strconv.FormatInt(res.Height, 10)
+	return body
+}
+
+// waitForNextBlock lets the chain move past the block of the last transaction
+func waitForNextBlock() {
+	if harness != nil {
+		harness.Block()
+		return
+	}
+	time.Sleep(2 * time.Second)
+}
+
 func getValidatorPubkey() string {
+	if harness != nil {
+		return base64.StdEncoding.EncodeToString(harness.ValidatorPubKey)
+	}
 	var URL *url.URL
 	URL, err := url.Parse(tendermintAddr)
 	if err != nil {
@@ -214,6 +312,42 @@ func getValidatorPubkey() string {
 	return body.Result.ValidatorInfo.PubKey.Value
 }
 
+// newNonce returns a nonce bound to the latest committed block height
+func newNonce() string {
+	height := getABCIInfo().Result.Response.LastBlockHeight
+	if height == "" {
+		height = "0"
+	}
+	return height + ":" + base64.StdEncoding.EncodeToString([]byte(common.RandStr(12)))
+}
+
+func getABCIInfo() ResponseABCIInfo {
+	if harness != nil {
+		info := harness.Info()
+		var body ResponseABCIInfo
+		body.Result.Response.Data = info.Data
+		body.Result.Response.Version = info.Version
+		body.Result.Response.LastBlockHeight = strconv.FormatInt(info.LastBlockHeight, 10)
+		body.Result.Response.LastBlockAppHash = base64.StdEncoding.EncodeToString(info.LastBlockAppHash)
+		return body
+	}
+	var URL *url.URL
+	URL, err := url.Parse(tendermintAddr)
+	if err != nil {
+		panic("boom")
+	}
+	URL.Path += "/abci_info"
+	resp, err := http.Get(URL.String())
+	if err != nil {
+		return ResponseABCIInfo{}
+	}
+	defer resp.Body.Close()
+
+	var body ResponseABCIInfo
+	json.NewDecoder(resp.Body).Decode(&body)
+	return body
+}
+
 var letterRunes = []rune("abcdefghijklmnopqrstuvwxyzABCDEFGHIJKLMNOPQRSTUVWXYZ")
 
 func RandStringRunes(n int) string {